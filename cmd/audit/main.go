@@ -0,0 +1,25 @@
+// Command audit checks the audit log of the task manager against tampering. It reads the same configs as the
+// server, so run it from the root of the repository:
+//
+//	go run ./cmd/audit verify
+package main
+
+import (
+	"github.com/MGajendra22/GoFr/handler/audit"
+
+	auditServicePkg "github.com/MGajendra22/GoFr/service/audit"
+	auditStorePkg "github.com/MGajendra22/GoFr/store/audit"
+	"gofr.dev/pkg/gofr"
+)
+
+func main() {
+	app := gofr.NewCMD()
+
+	auditStore := auditStorePkg.NewStore()
+	auditService := auditServicePkg.NewService(auditStore)
+	auditHandler := audit.NewHandler(auditService)
+
+	app.SubCommand("verify", auditHandler.Verify)
+
+	app.Run()
+}
@@ -0,0 +1,63 @@
+package apitoken
+
+import (
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"strconv"
+)
+
+type handler struct {
+	svc APITokenServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s APITokenServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Issue creates an API token for the user with the name, scopes and expiry in the body. The response is the only
+// time the token is shown.
+func (h *handler) Issue(c *gofr.Context) (any, error) {
+	userID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var t apitoken.Token
+
+	if err := c.Bind(&t); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.svc.Issue(c, userID, t)
+}
+
+// List returns the API tokens of the user, without the tokens themselves.
+func (h *handler) List(c *gofr.Context) (any, error) {
+	userID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.List(c, userID)
+}
+
+// Revoke deletes an API token of the user.
+func (h *handler) Revoke(c *gofr.Context) (any, error) {
+	userID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	id, err := strconv.Atoi(c.PathParam("token"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"token"}}
+	}
+
+	if err := h.svc.Revoke(c, userID, id); err != nil {
+		return nil, err
+	}
+
+	return apitoken.Token{}, nil
+}
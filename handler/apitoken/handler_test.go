@@ -0,0 +1,143 @@
+package apitoken
+
+import (
+	"bytes"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func request(method, userID, id, body string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, "/user/"+userID+"/tokens/"+id, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	req = mux.SetURLVars(req, map[string]string{"id": userID, "token": id})
+
+	return gofrHttp.NewRequest(req)
+}
+
+func Test_Issue(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	input := apitoken.Token{Name: "CI", Scopes: []apitoken.Scope{apitoken.ScopeTasksRead}}
+	issued := apitoken.Issued{Token: apitoken.Token{ID: 4, UserID: 3, Name: "CI", Prefix: "gft_0123456789ab",
+		Scopes: input.Scopes}, Secret: "gft_0123456789ab_secret"}
+
+	tests := []struct {
+		name   string
+		userID string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "3", `{"name":"CI","scopes":["tasks:read"]}`, true, nil, issued, nil},
+		{"Invalid user id", "abc", `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Binding Error", "3", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Denied", "3", `{"name":"CI","scopes":["tasks:read"]}`, true, errs.Forbidden{Permission: "token:issue"},
+			apitoken.Issued{}, errs.Forbidden{Permission: "token:issue"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAPITokenServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, tt.userID, "", tt.body)
+
+			if tt.ifMock {
+				res := issued
+				if tt.svcErr != nil {
+					res = apitoken.Issued{}
+				}
+
+				mock.EXPECT().Issue(gomock.Any(), 3, input).Return(res, tt.svcErr)
+			}
+
+			res, err := h.Issue(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
+
+func Test_List(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tokens := []apitoken.Token{{ID: 4, UserID: 3, Name: "CI"}}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockAPITokenServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	ctx.Request = request(http.MethodGet, "3", "", "")
+	mock.EXPECT().List(gomock.Any(), 3).Return(tokens, nil)
+
+	res, err := h.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, tokens, res)
+
+	ctx.Request = request(http.MethodGet, "abc", "", "")
+
+	_, err = h.List(ctx)
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}, err)
+}
+
+func Test_Revoke(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		id     string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "3", "4", true, nil, apitoken.Token{}, nil},
+		{"Invalid user id", "abc", "4", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Invalid token id", "3", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"token"}}},
+		{"Not Found", "3", "4", true, errs.NotFound{Entity: "api token", ID: 4}, nil,
+			errs.NotFound{Entity: "api token", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAPITokenServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, tt.userID, tt.id, "")
+
+			if tt.ifMock {
+				mock.EXPECT().Revoke(gomock.Any(), 3, 4).Return(tt.svcErr)
+			}
+
+			res, err := h.Revoke(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
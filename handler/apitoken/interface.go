@@ -0,0 +1,12 @@
+package apitoken
+
+import (
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"gofr.dev/pkg/gofr"
+)
+
+type APITokenServiceInterface interface {
+	Issue(c *gofr.Context, userID int, t apitoken.Token) (apitoken.Issued, error)
+	List(c *gofr.Context, userID int) ([]apitoken.Token, error)
+	Revoke(c *gofr.Context, userID, id int) error
+}
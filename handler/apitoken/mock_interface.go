@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=apitoken
+//
+
+// Package apitoken is a generated GoMock package.
+package apitoken
+
+import (
+	reflect "reflect"
+
+	apitoken "github.com/MGajendra22/GoFr/model/apitoken"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAPITokenServiceInterface is a mock of APITokenServiceInterface interface.
+type MockAPITokenServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPITokenServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPITokenServiceInterfaceMockRecorder is the mock recorder for MockAPITokenServiceInterface.
+type MockAPITokenServiceInterfaceMockRecorder struct {
+	mock *MockAPITokenServiceInterface
+}
+
+// NewMockAPITokenServiceInterface creates a new mock instance.
+func NewMockAPITokenServiceInterface(ctrl *gomock.Controller) *MockAPITokenServiceInterface {
+	mock := &MockAPITokenServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAPITokenServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPITokenServiceInterface) EXPECT() *MockAPITokenServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockAPITokenServiceInterface) Issue(c *gofr.Context, userID int, t apitoken.Token) (apitoken.Issued, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", c, userID, t)
+	ret0, _ := ret[0].(apitoken.Issued)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockAPITokenServiceInterfaceMockRecorder) Issue(c, userID, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockAPITokenServiceInterface)(nil).Issue), c, userID, t)
+}
+
+// List mocks base method.
+func (m *MockAPITokenServiceInterface) List(c *gofr.Context, userID int) ([]apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", c, userID)
+	ret0, _ := ret[0].([]apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPITokenServiceInterfaceMockRecorder) List(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPITokenServiceInterface)(nil).List), c, userID)
+}
+
+// Revoke mocks base method.
+func (m *MockAPITokenServiceInterface) Revoke(c *gofr.Context, userID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", c, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPITokenServiceInterfaceMockRecorder) Revoke(c, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPITokenServiceInterface)(nil).Revoke), c, userID, id)
+}
@@ -0,0 +1,125 @@
+package attachment
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type handler struct {
+	svc AttachmentServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s AttachmentServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// upload is the multipart form of an upload, with the file in its "file" part.
+type upload struct {
+	File *multipart.FileHeader `file:"file"`
+}
+
+// Upload attaches the file in the "file" part of the multipart body to the task.
+func (h *handler) Upload(c *gofr.Context) (any, error) {
+	taskID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var form upload
+
+	err = c.Bind(&form)
+	if tooLarge := new(http.MaxBytesError); errors.As(err, &tooLarge) {
+		return nil, errs.Validation{Field: "file", Reason: "is too large"}
+	}
+
+	if err != nil || form.File == nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"file"}}
+	}
+
+	f, err := form.File.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return h.svc.Upload(c, taskID, form.File.Filename, f)
+}
+
+// List returns the metadata of the attachments of the task.
+func (h *handler) List(c *gofr.Context) (any, error) {
+	taskID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.List(c, taskID)
+}
+
+// Download returns the content of the attachment, with the content type it was stored with.
+func (h *handler) Download(c *gofr.Context) (any, error) {
+	taskID, id, err := pathParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	a, content, err := h.svc.Download(c, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.File{Content: content, ContentType: a.ContentType}, nil
+}
+
+// Delete removes the attachment and its content.
+func (h *handler) Delete(c *gofr.Context) (any, error) {
+	taskID, id, err := pathParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.Delete(c, taskID, id); err != nil {
+		return nil, err
+	}
+
+	return attachment.Attachment{}, nil
+}
+
+// PurgeTrash returns the cron job that removes the attachments of the tasks trashed for longer than retention. It runs
+// before the tasks are purged, which keeps the tasks that still have attachments.
+func (h *handler) PurgeTrash(retention time.Duration) gofr.CronFunc {
+	return func(c *gofr.Context) {
+		n, err := h.svc.Purge(c, retention)
+		if err != nil {
+			c.Errorf("purging attachments of trashed tasks: %v", err)
+
+			return
+		}
+
+		c.Infof("purged %d attachments of tasks trashed for longer than %v", n, retention)
+	}
+}
+
+// pathParams parses the ids of the task and of the attachment in the path.
+func pathParams(c *gofr.Context) (taskID, id int, err error) {
+	taskID, err = strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	id, err = strconv.Atoi(c.PathParam("attachment"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"attachment"}}
+	}
+
+	return taskID, id, nil
+}
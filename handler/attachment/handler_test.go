@@ -0,0 +1,283 @@
+package attachment
+
+import (
+	"bytes"
+	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func request(method, taskID, id string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, "/task/"+taskID+"/attachments/"+id, http.NoBody)
+	req = mux.SetURLVars(req, map[string]string{"id": taskID, "attachment": id})
+
+	return gofrHttp.NewRequest(req)
+}
+
+// uploadRequest builds a multipart upload with content in the part named field
+func uploadRequest(t *testing.T, taskID, field, filename, content string) *gofrHttp.Request {
+	t.Helper()
+
+	return gofrHttp.NewRequest(multipartRequest(t, taskID, field, filename, content))
+}
+
+// multipartRequest builds the HTTP request of a multipart upload, as it reaches the middlewares
+func multipartRequest(t *testing.T, taskID, field, filename, content string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = part.Write([]byte(content))
+	_ = w.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/task/"+taskID+"/attachments", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return mux.SetURLVars(req, map[string]string{"id": taskID})
+}
+
+func Test_Upload(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	uploaded := attachment.Attachment{ID: 4, TaskID: 1, Filename: "app.log", Size: 5, ContentType: "text/plain"}
+
+	tests := []struct {
+		name   string
+		taskID string
+		field  string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "1", "file", true, nil, uploaded, nil},
+		{"Invalid task id", "abc", "file", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing file", "1", "other", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"file"}}},
+		{"Too large", "1", "file", true, errs.Validation{Field: "file", Reason: "must be at most 4 bytes"}, attachment.Attachment{},
+			errs.Validation{Field: "file", Reason: "must be at most 4 bytes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAttachmentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = uploadRequest(t, tt.taskID, tt.field, "app.log", "hello")
+
+			if tt.ifMock {
+				mock.EXPECT().Upload(gomock.Any(), 1, "app.log", gomock.Any()).DoAndReturn(
+					func(_ *gofr.Context, _ int, _ string, r io.Reader) (attachment.Attachment, error) {
+						content, err := io.ReadAll(r)
+						assert.NoError(t, err)
+						assert.Equal(t, "hello", string(content))
+
+						if tt.svcErr != nil {
+							return attachment.Attachment{}, tt.svcErr
+						}
+
+						return uploaded, nil
+					})
+			}
+
+			val, err := h.Upload(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_List(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockAttachmentServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	attachments := []attachment.Attachment{{ID: 4, TaskID: 1, Filename: "app.log"}}
+
+	ctx.Request = request(http.MethodGet, "abc", "")
+
+	_, err := h.List(ctx)
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}, err)
+
+	ctx.Request = request(http.MethodGet, "1", "")
+
+	mock.EXPECT().List(gomock.Any(), 1).Return(attachments, nil)
+
+	val, err := h.List(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, attachments, val)
+}
+
+func Test_Download(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name   string
+		id     string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "4", true, nil, response.File{Content: []byte("hello"), ContentType: "text/plain"}, nil},
+		{"Invalid attachment id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"attachment"}}},
+		{"Not found", "4", true, errs.NotFound{Entity: "attachment", ID: 4}, nil, errs.NotFound{Entity: "attachment", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAttachmentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, "1", tt.id)
+
+			if tt.ifMock {
+				mock.EXPECT().Download(gomock.Any(), 1, 4).
+					Return(attachment.Attachment{ID: 4, TaskID: 1, ContentType: "text/plain"}, []byte("hello"), tt.svcErr)
+			}
+
+			val, err := h.Download(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name   string
+		taskID string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "1", true, nil, attachment.Attachment{}, nil},
+		{"Invalid task id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Service error", "1", true, errors.New("Delete failed"), nil, errors.New("Delete failed")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAttachmentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, tt.taskID, "4")
+
+			if tt.ifMock {
+				mock.EXPECT().Delete(gomock.Any(), 1, 4).Return(tt.svcErr)
+			}
+
+			val, err := h.Delete(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_UploadTooLarge(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// the limit leaves room for the multipart framing, so the file must be well past it
+	content := strings.Repeat("a", 128<<10)
+
+	tests := []struct {
+		name      string
+		length    int64
+		expStatus int
+		expErr    error
+	}{
+		{"Announced", 0, http.StatusRequestEntityTooLarge, nil},
+		{"Unknown length", -1, http.StatusOK, errs.Validation{Field: "file", Reason: "is too large"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := NewHandler(NewMockAttachmentServiceInterface(ctrl))
+
+			req := multipartRequest(t, "1", "file", "app.log", content)
+			if tt.length != 0 {
+				req.ContentLength = tt.length
+			}
+
+			var err error
+
+			rec := httptest.NewRecorder()
+
+			middleware.LimitUploads(16)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				ctx.Request = gofrHttp.NewRequest(r)
+				_, err = h.Upload(ctx)
+			})).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expStatus, rec.Code)
+			assert.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func Test_PurgeTrash(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockAttachmentServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	mock.EXPECT().Purge(ctx, 720*time.Hour).Return(2, nil)
+	mock.EXPECT().Purge(ctx, 720*time.Hour).Return(0, errors.New("purge failed"))
+
+	job := h.PurgeTrash(720 * time.Hour)
+
+	job(ctx)
+	job(ctx)
+}
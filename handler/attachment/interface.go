@@ -0,0 +1,16 @@
+package attachment
+
+import (
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"time"
+)
+
+type AttachmentServiceInterface interface {
+	Upload(c *gofr.Context, taskID int, filename string, r io.Reader) (attachment.Attachment, error)
+	List(c *gofr.Context, taskID int) ([]attachment.Attachment, error)
+	Download(c *gofr.Context, taskID, id int) (attachment.Attachment, []byte, error)
+	Delete(c *gofr.Context, taskID, id int) error
+	Purge(c *gofr.Context, retention time.Duration) (int, error)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=attachment
+//
+
+// Package attachment is a generated GoMock package.
+package attachment
+
+import (
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	attachment "github.com/MGajendra22/GoFr/model/attachment"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAttachmentServiceInterface is a mock of AttachmentServiceInterface interface.
+type MockAttachmentServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentServiceInterfaceMockRecorder is the mock recorder for MockAttachmentServiceInterface.
+type MockAttachmentServiceInterfaceMockRecorder struct {
+	mock *MockAttachmentServiceInterface
+}
+
+// NewMockAttachmentServiceInterface creates a new mock instance.
+func NewMockAttachmentServiceInterface(ctrl *gomock.Controller) *MockAttachmentServiceInterface {
+	mock := &MockAttachmentServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentServiceInterface) EXPECT() *MockAttachmentServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentServiceInterface) Delete(c *gofr.Context, taskID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, taskID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) Delete(c, taskID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).Delete), c, taskID, id)
+}
+
+// Download mocks base method.
+func (m *MockAttachmentServiceInterface) Download(c *gofr.Context, taskID, id int) (attachment.Attachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", c, taskID, id)
+	ret0, _ := ret[0].(attachment.Attachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) Download(c, taskID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).Download), c, taskID, id)
+}
+
+// List mocks base method.
+func (m *MockAttachmentServiceInterface) List(c *gofr.Context, taskID int) ([]attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", c, taskID)
+	ret0, _ := ret[0].([]attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) List(c, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).List), c, taskID)
+}
+
+// Purge mocks base method.
+func (m *MockAttachmentServiceInterface) Purge(c *gofr.Context, retention time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", c, retention)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) Purge(c, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).Purge), c, retention)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentServiceInterface) Upload(c *gofr.Context, taskID int, filename string, r io.Reader) (attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", c, taskID, filename, r)
+	ret0, _ := ret[0].(attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) Upload(c, taskID, filename, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).Upload), c, taskID, filename, r)
+}
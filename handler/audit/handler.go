@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"strconv"
+)
+
+type handler struct {
+	svc AuditServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s AuditServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// All returns a page of the audit log, oldest first unless order is desc. It filters on the entity, entity_id,
+// actor_id and action query parameters and takes the limit, offset, cursor and order ones.
+func (h *handler) All(c *gofr.Context) (any, error) {
+	q, err := page.NewQuery(c.Param("limit"), c.Param("offset"), c.Param("cursor"), "", c.Param("order"), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	f := audit.Filter{Entity: c.Param("entity"), Action: c.Param("action")}
+
+	if f.Entity != "" && f.Entity != audit.EntityUser && f.Entity != audit.EntityTask {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"entity"}}
+	}
+
+	if f.EntityID, err = positiveParam(c, "entity_id"); err != nil {
+		return nil, err
+	}
+
+	if f.ActorID, err = positiveParam(c, "actor_id"); err != nil {
+		return nil, err
+	}
+
+	return h.svc.All(c, f, q)
+}
+
+// Verify walks the whole audit log, for the verify command. A broken chain is reported as an error naming the
+// first broken entry.
+func (h *handler) Verify(c *gofr.Context) (any, error) {
+	v, err := h.svc.Verify(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if !v.Valid {
+		return nil, fmt.Errorf("audit log broken at entry %d: %s (%d entries checked)", *v.BrokenAt, v.Reason, v.Entries)
+	}
+
+	return fmt.Sprintf("audit log intact: %d entries verified", v.Entries), nil
+}
+
+// positiveParam parses an optional id query parameter, 0 standing for its absence.
+func positiveParam(c *gofr.Context, name string) (int, error) {
+	raw := c.Param(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(raw)
+	if err != nil || id < 1 {
+		return 0, gofrHttp.ErrorInvalidParam{Params: []string{name}}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_All(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	entries := page.Page[audit.Entry]{Items: []audit.Entry{{ID: 1, Entity: audit.EntityTask, EntityID: 4}}, Total: 1, Limit: 20}
+
+	tests := []struct {
+		name   string
+		query  string
+		expF   audit.Filter
+		expQ   *page.Query
+		expRes any
+		expErr error
+	}{
+		{"Whole log", "", audit.Filter{}, &page.Query{Limit: 20, Sort: "id", Order: "asc"}, entries, nil},
+		{"Filtered", "?entity=task&entity_id=4&actor_id=7&action=deleted&order=desc&limit=5",
+			audit.Filter{Entity: "task", EntityID: 4, ActorID: 7, Action: "deleted"}, &page.Query{Limit: 5, Sort: "id", Order: "desc"},
+			entries, nil},
+		{"Invalid entity", "?entity=comment", audit.Filter{}, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"entity"}}},
+		{"Invalid entity_id", "?entity_id=abc", audit.Filter{}, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"entity_id"}}},
+		{"Invalid actor_id", "?actor_id=0", audit.Filter{}, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"actor_id"}}},
+		{"Invalid limit", "?limit=0", audit.Filter{}, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAuditServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = gofrHttp.NewRequest(httptest.NewRequest(http.MethodGet, "/audit"+tt.query, http.NoBody))
+
+			if tt.expQ != nil {
+				mock.EXPECT().All(gomock.Any(), tt.expF, *tt.expQ).Return(entries, nil)
+			}
+
+			val, err := h.All(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Verify(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	brokenAt := 3
+
+	tests := []struct {
+		name   string
+		v      audit.Verification
+		svcErr error
+		expRes any
+		expErr string
+	}{
+		{"Intact", audit.Verification{Entries: 12, Valid: true}, nil, "audit log intact: 12 entries verified", ""},
+		{"Broken", audit.Verification{Entries: 3, BrokenAt: &brokenAt, Reason: "hash does not match the content of the entry"}, nil,
+			nil, "audit log broken at entry 3: hash does not match the content of the entry (3 entries checked)"},
+		{"Unreadable", audit.Verification{}, errors.New("no connection"), nil, "no connection"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAuditServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			mock.EXPECT().Verify(gomock.Any()).Return(tt.v, tt.svcErr)
+
+			val, err := h.Verify(ctx)
+
+			if tt.expErr != "" {
+				assert.EqualError(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
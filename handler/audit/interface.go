@@ -0,0 +1,12 @@
+package audit
+
+import (
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"gofr.dev/pkg/gofr"
+)
+
+type AuditServiceInterface interface {
+	All(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error)
+	Verify(c *gofr.Context) (audit.Verification, error)
+}
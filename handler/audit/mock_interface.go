@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=audit
+//
+
+// Package audit is a generated GoMock package.
+package audit
+
+import (
+	reflect "reflect"
+
+	audit "github.com/MGajendra22/GoFr/model/audit"
+	page "github.com/MGajendra22/GoFr/model/page"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAuditServiceInterface is a mock of AuditServiceInterface interface.
+type MockAuditServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceInterfaceMockRecorder is the mock recorder for MockAuditServiceInterface.
+type MockAuditServiceInterfaceMockRecorder struct {
+	mock *MockAuditServiceInterface
+}
+
+// NewMockAuditServiceInterface creates a new mock instance.
+func NewMockAuditServiceInterface(ctrl *gomock.Controller) *MockAuditServiceInterface {
+	mock := &MockAuditServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServiceInterface) EXPECT() *MockAuditServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockAuditServiceInterface) All(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c, f, q)
+	ret0, _ := ret[0].(page.Page[audit.Entry])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockAuditServiceInterfaceMockRecorder) All(c, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockAuditServiceInterface)(nil).All), c, f, q)
+}
+
+// Verify mocks base method.
+func (m *MockAuditServiceInterface) Verify(c *gofr.Context) (audit.Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", c)
+	ret0, _ := ret[0].(audit.Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockAuditServiceInterfaceMockRecorder) Verify(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAuditServiceInterface)(nil).Verify), c)
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"github.com/MGajendra22/GoFr/model/auth"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+type handler struct {
+	svc AuthServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s AuthServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Login issues a pair of tokens to the user whose email and password are in the body.
+func (h *handler) Login(c *gofr.Context) (any, error) {
+	var cr auth.Credentials
+
+	if err := c.Bind(&cr); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.svc.Login(c, cr)
+}
+
+// Refresh exchanges the refresh token in the body for a new pair of tokens.
+func (h *handler) Refresh(c *gofr.Context) (any, error) {
+	var r auth.RefreshRequest
+
+	if err := c.Bind(&r); err != nil || r.RefreshToken == "" {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"refresh_token"}}
+	}
+
+	return h.svc.Refresh(c, r.RefreshToken)
+}
+
+// Logout revokes the refresh tokens of the user making the request, logging them out everywhere once their access
+// tokens expire.
+func (h *handler) Logout(c *gofr.Context) (any, error) {
+	return nil, h.svc.Logout(c)
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func request(path, body string) *gofrHttp.Request {
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	return gofrHttp.NewRequest(req)
+}
+
+var tokens = auth.Tokens{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", ExpiresIn: 900}
+
+func Test_Login(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	invalid := errs.Unauthorized{Reason: "invalid email or password"}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", `{"email":"alice@example.com","password":"correct horse"}`, true, nil, tokens, nil},
+		{"Wrong Password", `{"email":"alice@example.com","password":"correct horse"}`, true, invalid, auth.Tokens{}, invalid},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAuthServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request("/auth/login", tt.body)
+
+			if tt.ifMock {
+				res := tokens
+				if tt.svcErr != nil {
+					res = auth.Tokens{}
+				}
+
+				mock.EXPECT().Login(gomock.Any(), auth.Credentials{Email: "alice@example.com", Password: "correct horse"}).
+					Return(res, tt.svcErr)
+			}
+
+			res, err := h.Login(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
+
+func Test_Refresh(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	invalid := errs.Unauthorized{Reason: "invalid refresh token"}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", `{"refresh_token":"refresh"}`, true, nil, tokens, nil},
+		{"Expired Token", `{"refresh_token":"refresh"}`, true, invalid, auth.Tokens{}, invalid},
+		{"Missing Token", `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"refresh_token"}}},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"refresh_token"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockAuthServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request("/auth/refresh", tt.body)
+
+			if tt.ifMock {
+				res := tokens
+				if tt.svcErr != nil {
+					res = auth.Tokens{}
+				}
+
+				mock.EXPECT().Refresh(gomock.Any(), "refresh").Return(res, tt.svcErr)
+			}
+
+			res, err := h.Refresh(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
+
+func Test_Logout(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	for _, svcErr := range []error{nil, errors.New("db down")} {
+		ctrl := gomock.NewController(t)
+		mock := NewMockAuthServiceInterface(ctrl)
+		h := NewHandler(mock)
+
+		mock.EXPECT().Logout(ctx).Return(svcErr)
+
+		res, err := h.Logout(ctx)
+
+		assert.Equal(t, svcErr, err)
+		assert.Nil(t, res)
+	}
+}
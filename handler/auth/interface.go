@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"github.com/MGajendra22/GoFr/model/auth"
+	"gofr.dev/pkg/gofr"
+)
+
+type AuthServiceInterface interface {
+	Login(c *gofr.Context, cr auth.Credentials) (auth.Tokens, error)
+	Refresh(c *gofr.Context, token string) (auth.Tokens, error)
+	Logout(c *gofr.Context) error
+}
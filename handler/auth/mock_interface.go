@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=auth
+//
+
+// Package auth is a generated GoMock package.
+package auth
+
+import (
+	reflect "reflect"
+
+	auth "github.com/MGajendra22/GoFr/model/auth"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAuthServiceInterface is a mock of AuthServiceInterface interface.
+type MockAuthServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthServiceInterfaceMockRecorder is the mock recorder for MockAuthServiceInterface.
+type MockAuthServiceInterfaceMockRecorder struct {
+	mock *MockAuthServiceInterface
+}
+
+// NewMockAuthServiceInterface creates a new mock instance.
+func NewMockAuthServiceInterface(ctrl *gomock.Controller) *MockAuthServiceInterface {
+	mock := &MockAuthServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockAuthServiceInterface) Login(c *gofr.Context, cr auth.Credentials) (auth.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", c, cr)
+	ret0, _ := ret[0].(auth.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceInterfaceMockRecorder) Login(c, cr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceInterface)(nil).Login), c, cr)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceInterface) Logout(c *gofr.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceInterfaceMockRecorder) Logout(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceInterface)(nil).Logout), c)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthServiceInterface) Refresh(c *gofr.Context, token string) (auth.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", c, token)
+	ret0, _ := ret[0].(auth.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthServiceInterfaceMockRecorder) Refresh(c, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthServiceInterface)(nil).Refresh), c, token)
+}
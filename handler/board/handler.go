@@ -0,0 +1,141 @@
+package board
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"strconv"
+)
+
+type handler struct {
+	svc BoardServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s BoardServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Create adds the board in the body, along with its columns.
+func (h *handler) Create(c *gofr.Context) (any, error) {
+	var b board.Board
+
+	if err := c.Bind(&b); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	b, err := h.svc.Create(c, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(b), nil
+}
+
+func (h *handler) Get(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	b, err := h.svc.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(b), nil
+}
+
+// All returns every board of the workspace.
+func (h *handler) All(c *gofr.Context) (any, error) {
+	return h.svc.All(c)
+}
+
+// Update replaces the name, project and columns of the board with the body, if If-Match holds its current ETag.
+func (h *handler) Update(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var b board.Board
+
+	if err := c.Bind(&b); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	b, err = h.svc.Update(c, id, ver, b)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(b), nil
+}
+
+// Delete removes the board, if If-Match holds its current ETag. The tasks it shows are left as they are.
+func (h *handler) Delete(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.Delete(c, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return board.Board{}, nil
+}
+
+// View returns the board along with the tasks of each of its columns, in rank order.
+func (h *handler) View(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.View(c, id)
+}
+
+// Move moves the task in the path to the board column in the body, between the tasks after and before, and returns
+// the task.
+func (h *handler) Move(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var m board.Move
+
+	if err := c.Bind(&m); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	if m.ColumnID == 0 {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"column_id"}}
+	}
+
+	t, err := h.svc.Move(c, id, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Response{Data: t, Headers: map[string]string{"ETag": version.ETag(t.Version)}}, nil
+}
+
+// withETag returns the board along with its ETag header.
+func withETag(b board.Board) response.Response {
+	return response.Response{Data: b, Headers: map[string]string{"ETag": version.ETag(b.Version)}}
+}
@@ -0,0 +1,302 @@
+package board
+
+import (
+	"bytes"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
+func request(method, target, ifMatch, body string, vars map[string]string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	req = mux.SetURLVars(ifMatched(req), vars)
+
+	return gofrHttp.NewRequest(req)
+}
+
+var columns = []board.Column{{Name: "To do", Status: task.StatusTodo}}
+
+func Test_Create(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	created := board.Board{ID: 4, Name: "Sprint", Columns: []board.Column{{ID: 10, Name: "To do", Status: task.StatusTodo}}, Version: 1}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", `{"name":"Sprint","columns":[{"name":"To do","status":"todo"}]}`, true, nil, withETag(created), nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Not allowed", `{"name":"Sprint","columns":[{"name":"To do","status":"todo"}]}`, true,
+			errs.Forbidden{Permission: "board:manage"}, nil, errs.Forbidden{Permission: "board:manage"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, "/board", "", tt.body, nil)
+
+			if tt.ifMock {
+				mock.EXPECT().Create(gomock.Any(), board.Board{Name: "Sprint", Columns: columns}).Return(created, tt.svcErr)
+			}
+
+			val, err := h.Create(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	b := board.Board{ID: 4, Name: "Sprint", Version: 2}
+
+	tests := []struct {
+		name   string
+		id     string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "4", true, nil, withETag(b), nil},
+		{"Invalid id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Not found", "4", true, errs.NotFound{Entity: "board", ID: 4}, nil, errs.NotFound{Entity: "board", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, "/board/"+tt.id, "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Get(gomock.Any(), 4).Return(b, tt.svcErr)
+			}
+
+			val, err := h.Get(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	updated := board.Board{ID: 4, Name: "Kanban", Version: 3}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", "4", `"2"`, `{"name":"Kanban","columns":[{"name":"To do","status":"todo"}]}`, true, nil, withETag(updated), nil},
+		{"Invalid id", "abc", `"2"`, `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing If-Match", "4", "", `{}`, false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Binding Error", "4", `"2"`, `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Stale version", "4", `"2"`, `{"name":"Kanban","columns":[{"name":"To do","status":"todo"}]}`, true, version.ErrMismatch, nil,
+			version.ErrPreconditionFailed{IfMatch: `"2"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPut, "/board/"+tt.id, tt.ifMatch, tt.body, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Update(gomock.Any(), 4, 2, board.Board{Name: "Kanban", Columns: columns}).Return(updated, tt.svcErr)
+			}
+
+			val, err := h.Update(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		ver     int
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", `"2"`, 2, nil, board.Board{}, nil},
+		{"Any version", "*", version.Any, nil, board.Board{}, nil},
+		{"Not found", "*", version.Any, errs.NotFound{Entity: "board", ID: 4}, nil, errs.NotFound{Entity: "board", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, "/board/4", tt.ifMatch, "", map[string]string{"id": "4"})
+
+			mock.EXPECT().Delete(gomock.Any(), 4, tt.ver).Return(tt.svcErr)
+
+			val, err := h.Delete(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_View(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	view := board.View{Board: board.Board{ID: 4}, Columns: []board.Lane{{Column: board.Column{ID: 10}, Tasks: []task.Task{{ID: 1}}}}}
+
+	tests := []struct {
+		name   string
+		id     string
+		ifMock bool
+		expRes any
+		expErr error
+	}{
+		{"Success", "4", true, view, nil},
+		{"Invalid id", "abc", false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, "/board/"+tt.id+"/view", "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().View(gomock.Any(), 4).Return(view, nil)
+			}
+
+			val, err := h.View(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+
+			if tt.expErr == nil {
+				assert.Equal(t, tt.expRes, val)
+			}
+		})
+	}
+}
+
+func Test_Move(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	moved := task.Task{ID: 7, Status: task.StatusDone, Version: 3}
+	after, before := 5, 6
+
+	tests := []struct {
+		name   string
+		id     string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "7", `{"column_id":11,"after":5,"before":6}`, true, nil,
+			response.Response{Data: moved, Headers: map[string]string{"ETag": `"3"`}}, nil},
+		{"Invalid id", "abc", `{"column_id":11}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Binding Error", "7", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Missing column", "7", `{"after":5}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"column_id"}}},
+		{"Stale neighbours", "7", `{"column_id":11,"after":5,"before":6}`, true,
+			errs.Conflict{Entity: "task", ID: 7, Reason: "after and before are no longer next to each other, reload the board"}, nil,
+			errs.Conflict{Entity: "task", ID: 7, Reason: "after and before are no longer next to each other, reload the board"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockBoardServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, "/task/"+tt.id+"/move", "", tt.body, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Move(gomock.Any(), 7, board.Move{ColumnID: 11, After: &after, Before: &before}).Return(moved, tt.svcErr)
+			}
+
+			val, err := h.Move(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package board
+
+import (
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+)
+
+type BoardServiceInterface interface {
+	Create(c *gofr.Context, b board.Board) (board.Board, error)
+	Get(c *gofr.Context, id int) (board.Board, error)
+	All(c *gofr.Context) ([]board.Board, error)
+	Update(c *gofr.Context, id, ver int, b board.Board) (board.Board, error)
+	Delete(c *gofr.Context, id, ver int) error
+	View(c *gofr.Context, id int) (board.View, error)
+	Move(c *gofr.Context, id int, m board.Move) (task.Task, error)
+}
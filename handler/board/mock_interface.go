@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=board
+//
+
+// Package board is a generated GoMock package.
+package board
+
+import (
+	reflect "reflect"
+
+	board "github.com/MGajendra22/GoFr/model/board"
+	task "github.com/MGajendra22/GoFr/model/task"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockBoardServiceInterface is a mock of BoardServiceInterface interface.
+type MockBoardServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardServiceInterfaceMockRecorder is the mock recorder for MockBoardServiceInterface.
+type MockBoardServiceInterfaceMockRecorder struct {
+	mock *MockBoardServiceInterface
+}
+
+// NewMockBoardServiceInterface creates a new mock instance.
+func NewMockBoardServiceInterface(ctrl *gomock.Controller) *MockBoardServiceInterface {
+	mock := &MockBoardServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockBoardServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardServiceInterface) EXPECT() *MockBoardServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockBoardServiceInterface) All(c *gofr.Context) ([]board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c)
+	ret0, _ := ret[0].([]board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockBoardServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockBoardServiceInterface)(nil).All), c)
+}
+
+// Create mocks base method.
+func (m *MockBoardServiceInterface) Create(c *gofr.Context, b board.Board) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, b)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardServiceInterfaceMockRecorder) Create(c, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardServiceInterface)(nil).Create), c, b)
+}
+
+// Delete mocks base method.
+func (m *MockBoardServiceInterface) Delete(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardServiceInterfaceMockRecorder) Delete(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardServiceInterface)(nil).Delete), c, id, ver)
+}
+
+// Get mocks base method.
+func (m *MockBoardServiceInterface) Get(c *gofr.Context, id int) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBoardServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoardServiceInterface)(nil).Get), c, id)
+}
+
+// Move mocks base method.
+func (m_2 *MockBoardServiceInterface) Move(c *gofr.Context, id int, m board.Move) (task.Task, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Move", c, id, m)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockBoardServiceInterfaceMockRecorder) Move(c, id, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockBoardServiceInterface)(nil).Move), c, id, m)
+}
+
+// Update mocks base method.
+func (m *MockBoardServiceInterface) Update(c *gofr.Context, id, ver int, b board.Board) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, id, ver, b)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBoardServiceInterfaceMockRecorder) Update(c, id, ver, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardServiceInterface)(nil).Update), c, id, ver, b)
+}
+
+// View mocks base method.
+func (m *MockBoardServiceInterface) View(c *gofr.Context, id int) (board.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", c, id)
+	ret0, _ := ret[0].(board.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// View indicates an expected call of View.
+func (mr *MockBoardServiceInterfaceMockRecorder) View(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockBoardServiceInterface)(nil).View), c, id)
+}
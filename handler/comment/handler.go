@@ -0,0 +1,115 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"strconv"
+)
+
+type handler struct {
+	svc CommentServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s CommentServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Create adds the comment in the body to the task, as a reply if it has a parent_id.
+func (h *handler) Create(c *gofr.Context) (any, error) {
+	taskID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var cm comment.Comment
+
+	if err := c.Bind(&cm); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	cm, err = h.svc.Create(c, taskID, cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(cm), nil
+}
+
+// List returns the comment threads of the task.
+func (h *handler) List(c *gofr.Context) (any, error) {
+	taskID, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.List(c, taskID)
+}
+
+// Update replaces the body of the comment, if If-Match holds its current ETag.
+func (h *handler) Update(c *gofr.Context) (any, error) {
+	taskID, id, err := pathParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var cm comment.Comment
+
+	if err := c.Bind(&cm); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	cm, err = h.svc.Update(c, taskID, id, ver, cm)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(cm), nil
+}
+
+// Delete removes the comment and its replies, if If-Match holds its current ETag.
+func (h *handler) Delete(c *gofr.Context) (any, error) {
+	taskID, id, err := pathParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.Delete(c, taskID, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return comment.Comment{}, nil
+}
+
+// pathParams parses the ids of the task and of the comment in the path.
+func pathParams(c *gofr.Context) (taskID, id int, err error) {
+	taskID, err = strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	id, err = strconv.Atoi(c.PathParam("comment"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"comment"}}
+	}
+
+	return taskID, id, nil
+}
+
+func withETag(cm comment.Comment) response.Response {
+	return response.Response{Data: cm, Headers: map[string]string{"ETag": version.ETag(cm.Version)}}
+}
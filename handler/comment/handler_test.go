@@ -0,0 +1,192 @@
+package comment
+
+import (
+	"bytes"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
+func request(method, taskID, id, ifMatch, body string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, "/task/"+taskID+"/comments/"+id, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	req = mux.SetURLVars(ifMatched(req), map[string]string{"id": taskID, "comment": id})
+
+	return gofrHttp.NewRequest(req)
+}
+
+func Test_Create(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	author := 3
+	created := comment.Comment{ID: 7, TaskID: 1, AuthorID: &author, Author: "Alice", Body: "Looks good", Version: 1}
+
+	tests := []struct {
+		name   string
+		taskID string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "1", `{"author_id":3,"body":"Looks good"}`, true, nil, withETag(created), nil},
+		{"Invalid task id", "abc", `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Binding Error", "1", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Author not found", "1", `{"author_id":3,"body":"Looks good"}`, true, errs.DependencyMissing{Entity: "user", ID: 3}, nil,
+			errs.DependencyMissing{Entity: "user", ID: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockCommentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, tt.taskID, "", "", tt.body)
+
+			if tt.ifMock {
+				mock.EXPECT().Create(gomock.Any(), 1, comment.Comment{AuthorID: &author, Body: "Looks good"}).Return(created, tt.svcErr)
+			}
+
+			val, err := h.Create(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_List(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockCommentServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	threads := []comment.Comment{{ID: 7, TaskID: 1, Body: "Question", Replies: []comment.Comment{{ID: 8, TaskID: 1, Body: "Answer"}}}}
+
+	ctx.Request = request(http.MethodGet, "1", "", "", "")
+
+	mock.EXPECT().List(gomock.Any(), 1).Return(threads, nil)
+
+	val, err := h.List(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, threads, val)
+}
+
+func Test_Update(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	updated := comment.Comment{ID: 7, TaskID: 1, Body: "Looks great", Version: 3}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", "7", `"2"`, `{"body":"Looks great"}`, true, nil, withETag(updated), nil},
+		{"Invalid comment id", "abc", `"2"`, `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"comment"}}},
+		{"Missing If-Match", "7", "", `{"body":"Looks great"}`, false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Binding Error", "7", `"2"`, `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Stale version", "7", `"2"`, `{"body":"Looks great"}`, true, version.ErrMismatch, nil,
+			version.ErrPreconditionFailed{IfMatch: `"2"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockCommentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPut, "1", tt.id, tt.ifMatch, tt.body)
+
+			if tt.ifMock {
+				mock.EXPECT().Update(gomock.Any(), 1, 7, 2, comment.Comment{Body: "Looks great"}).Return(updated, tt.svcErr)
+			}
+
+			val, err := h.Update(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		ver     int
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", `"2"`, 2, nil, comment.Comment{}, nil},
+		{"Any version", "*", version.Any, nil, comment.Comment{}, nil},
+		{"Not found", "*", version.Any, errs.NotFound{Entity: "comment", ID: 7}, nil, errs.NotFound{Entity: "comment", ID: 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockCommentServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, "1", "7", tt.ifMatch, "")
+
+			mock.EXPECT().Delete(gomock.Any(), 1, 7, tt.ver).Return(tt.svcErr)
+
+			val, err := h.Delete(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/model/comment"
+	"gofr.dev/pkg/gofr"
+)
+
+type CommentServiceInterface interface {
+	Create(c *gofr.Context, taskID int, cm comment.Comment) (comment.Comment, error)
+	List(c *gofr.Context, taskID int) ([]comment.Comment, error)
+	Update(c *gofr.Context, taskID, id, ver int, cm comment.Comment) (comment.Comment, error)
+	Delete(c *gofr.Context, taskID, id, ver int) error
+}
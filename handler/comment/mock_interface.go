@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=comment
+//
+
+// Package comment is a generated GoMock package.
+package comment
+
+import (
+	reflect "reflect"
+
+	comment "github.com/MGajendra22/GoFr/model/comment"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockCommentServiceInterface is a mock of CommentServiceInterface interface.
+type MockCommentServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentServiceInterfaceMockRecorder is the mock recorder for MockCommentServiceInterface.
+type MockCommentServiceInterfaceMockRecorder struct {
+	mock *MockCommentServiceInterface
+}
+
+// NewMockCommentServiceInterface creates a new mock instance.
+func NewMockCommentServiceInterface(ctrl *gomock.Controller) *MockCommentServiceInterface {
+	mock := &MockCommentServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCommentServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentServiceInterface) EXPECT() *MockCommentServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCommentServiceInterface) Create(c *gofr.Context, taskID int, cm comment.Comment) (comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, taskID, cm)
+	ret0, _ := ret[0].(comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentServiceInterfaceMockRecorder) Create(c, taskID, cm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentServiceInterface)(nil).Create), c, taskID, cm)
+}
+
+// Delete mocks base method.
+func (m *MockCommentServiceInterface) Delete(c *gofr.Context, taskID, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, taskID, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentServiceInterfaceMockRecorder) Delete(c, taskID, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentServiceInterface)(nil).Delete), c, taskID, id, ver)
+}
+
+// List mocks base method.
+func (m *MockCommentServiceInterface) List(c *gofr.Context, taskID int) ([]comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", c, taskID)
+	ret0, _ := ret[0].([]comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentServiceInterfaceMockRecorder) List(c, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentServiceInterface)(nil).List), c, taskID)
+}
+
+// Update mocks base method.
+func (m *MockCommentServiceInterface) Update(c *gofr.Context, taskID, id, ver int, cm comment.Comment) (comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, taskID, id, ver, cm)
+	ret0, _ := ret[0].(comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentServiceInterfaceMockRecorder) Update(c, taskID, id, ver, cm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentServiceInterface)(nil).Update), c, taskID, id, ver, cm)
+}
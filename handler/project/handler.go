@@ -0,0 +1,170 @@
+package project
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"strconv"
+)
+
+type handler struct {
+	svc ProjectServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s ProjectServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Create adds the project in the body.
+func (h *handler) Create(c *gofr.Context) (any, error) {
+	var p project.Project
+
+	if err := c.Bind(&p); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	p, err := h.svc.Create(c, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(p), nil
+}
+
+func (h *handler) Get(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	p, err := h.svc.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(p), nil
+}
+
+// All returns every project of the workspace.
+func (h *handler) All(c *gofr.Context) (any, error) {
+	return h.svc.All(c)
+}
+
+// Update replaces the name and description of the project with the body, if If-Match holds its current ETag.
+func (h *handler) Update(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var p project.Project
+
+	if err := c.Bind(&p); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	p, err = h.svc.Update(c, id, ver, p)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(p), nil
+}
+
+// Delete removes the project, if If-Match holds its current ETag. Its tasks are kept, in no project.
+func (h *handler) Delete(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.Delete(c, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return project.Project{}, nil
+}
+
+// Members returns the members of the project.
+func (h *handler) Members(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.Members(c, id)
+}
+
+// AddMember makes the user in the path a member of the project and returns its members.
+func (h *handler) AddMember(c *gofr.Context) (any, error) {
+	id, userID, err := memberParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.AddMember(c, id, userID)
+}
+
+// RemoveMember removes the user in the path from the members of the project and returns its members.
+func (h *handler) RemoveMember(c *gofr.Context) (any, error) {
+	id, userID, err := memberParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.RemoveMember(c, id, userID)
+}
+
+// Tasks returns a page of the tasks of the project, filtered by the status and q (text contained in the description)
+// query parameters and sorted by sort/order, along with the open and done counts of all of its tasks. Pages are
+// selected with limit plus either offset or cursor.
+func (h *handler) Tasks(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	q, err := page.NewQuery(c.Param("limit"), c.Param("offset"), c.Param("cursor"), c.Param("sort"), c.Param("order"),
+		task.SortKeys...)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.Tasks(c, id, task.Filter{Status: task.Status(c.Param("status")), Text: c.Param("q")}, q)
+}
+
+// memberParams parses the ids of the project and of the user in the path.
+func memberParams(c *gofr.Context) (id, userID int, err error) {
+	id, err = strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	userID, err = strconv.Atoi(c.PathParam("user"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"user"}}
+	}
+
+	return id, userID, nil
+}
+
+// withETag returns the project along with its ETag header.
+func withETag(p project.Project) response.Response {
+	return response.Response{Data: p, Headers: map[string]string{"ETag": version.ETag(p.Version)}}
+}
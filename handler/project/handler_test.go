@@ -0,0 +1,320 @@
+package project
+
+import (
+	"bytes"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
+func request(method, target, ifMatch, body string, vars map[string]string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	req = mux.SetURLVars(ifMatched(req), vars)
+
+	return gofrHttp.NewRequest(req)
+}
+
+func Test_Create(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	created := project.Project{ID: 4, Name: "Website", Description: "Relaunch", Version: 1}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", `{"name":"Website","description":"Relaunch"}`, true, nil, withETag(created), nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Not allowed", `{"name":"Website","description":"Relaunch"}`, true, errs.Forbidden{Permission: "project:manage"}, nil,
+			errs.Forbidden{Permission: "project:manage"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, "/project", "", tt.body, nil)
+
+			if tt.ifMock {
+				mock.EXPECT().Create(gomock.Any(), project.Project{Name: "Website", Description: "Relaunch"}).Return(created, tt.svcErr)
+			}
+
+			val, err := h.Create(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	p := project.Project{ID: 4, Name: "Website", Version: 2}
+
+	tests := []struct {
+		name   string
+		id     string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "4", true, nil, withETag(p), nil},
+		{"Invalid id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Not found", "4", true, errs.NotFound{Entity: "project", ID: 4}, nil, errs.NotFound{Entity: "project", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, "/project/"+tt.id, "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Get(gomock.Any(), 4).Return(p, tt.svcErr)
+			}
+
+			val, err := h.Get(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	updated := project.Project{ID: 4, Name: "Web", Version: 3}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", "4", `"2"`, `{"name":"Web"}`, true, nil, withETag(updated), nil},
+		{"Invalid id", "abc", `"2"`, `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing If-Match", "4", "", `{"name":"Web"}`, false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Binding Error", "4", `"2"`, `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Stale version", "4", `"2"`, `{"name":"Web"}`, true, version.ErrMismatch, nil,
+			version.ErrPreconditionFailed{IfMatch: `"2"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPut, "/project/"+tt.id, tt.ifMatch, tt.body, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Update(gomock.Any(), 4, 2, project.Project{Name: "Web"}).Return(updated, tt.svcErr)
+			}
+
+			val, err := h.Update(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		ver     int
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", `"2"`, 2, nil, project.Project{}, nil},
+		{"Any version", "*", version.Any, nil, project.Project{}, nil},
+		{"Not found", "*", version.Any, errs.NotFound{Entity: "project", ID: 4}, nil, errs.NotFound{Entity: "project", ID: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, "/project/4", tt.ifMatch, "", map[string]string{"id": "4"})
+
+			mock.EXPECT().Delete(gomock.Any(), 4, tt.ver).Return(tt.svcErr)
+
+			val, err := h.Delete(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Members(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	members := []user.User{{ID: 3, Name: "Ann"}}
+
+	tests := []struct {
+		name   string
+		method string
+		user   string
+		expRes any
+		expErr error
+	}{
+		{"List", http.MethodGet, "", members, nil},
+		{"Add", http.MethodPut, "3", members, nil},
+		{"Remove", http.MethodDelete, "3", members, nil},
+		{"Invalid user", http.MethodPut, "ann", nil, gofrHttp.ErrorInvalidParam{Params: []string{"user"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(tt.method, "/project/4/members/"+tt.user, "", "", map[string]string{"id": "4", "user": tt.user})
+
+			var (
+				val any
+				err error
+			)
+
+			switch tt.method {
+			case http.MethodGet:
+				mock.EXPECT().Members(gomock.Any(), 4).Return(members, nil)
+
+				val, err = h.Members(ctx)
+			case http.MethodDelete:
+				mock.EXPECT().RemoveMember(gomock.Any(), 4, 3).Return(members, nil)
+
+				val, err = h.RemoveMember(ctx)
+			default:
+				if tt.expErr == nil {
+					mock.EXPECT().AddMember(gomock.Any(), 4, 3).Return(members, nil)
+				}
+
+				val, err = h.AddMember(ctx)
+			}
+
+			assert.Equal(t, tt.expErr, err)
+
+			if tt.expErr == nil {
+				assert.Equal(t, tt.expRes, val)
+			}
+		})
+	}
+}
+
+func Test_Tasks(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tasks := project.Tasks{Page: page.Page[task.Task]{Items: []task.Task{{ID: 1}}, Total: 1, Limit: 5},
+		Stats: project.Stats{Total: 1, Open: 1}}
+
+	tests := []struct {
+		name   string
+		target string
+		id     string
+		ifMock bool
+		filter task.Filter
+		query  page.Query
+		expErr error
+	}{
+		{"Success", "/project/4/tasks?status=todo&q=docs&limit=5", "4", true,
+			task.Filter{Status: task.StatusTodo, Text: "docs"}, page.Query{Limit: 5, Sort: "id", Order: "asc"}, nil},
+		{"Invalid id", "/project/abc/tasks", "abc", false, task.Filter{}, page.Query{},
+			gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Invalid limit", "/project/4/tasks?limit=1000", "4", false, task.Filter{}, page.Query{},
+			gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockProjectServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, tt.target, "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Tasks(gomock.Any(), 4, tt.filter, tt.query).Return(tasks, nil)
+			}
+
+			val, err := h.Tasks(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+
+			if tt.expErr == nil {
+				assert.Equal(t, tasks, val)
+			}
+		})
+	}
+}
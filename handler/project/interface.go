@@ -0,0 +1,21 @@
+package project
+
+import (
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+type ProjectServiceInterface interface {
+	Create(c *gofr.Context, p project.Project) (project.Project, error)
+	Get(c *gofr.Context, id int) (project.Project, error)
+	All(c *gofr.Context) ([]project.Project, error)
+	Update(c *gofr.Context, id, ver int, p project.Project) (project.Project, error)
+	Delete(c *gofr.Context, id, ver int) error
+	Members(c *gofr.Context, id int) ([]user.User, error)
+	AddMember(c *gofr.Context, id, userID int) ([]user.User, error)
+	RemoveMember(c *gofr.Context, id, userID int) ([]user.User, error)
+	Tasks(c *gofr.Context, id int, f task.Filter, q page.Query) (project.Tasks, error)
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=project
+//
+
+// Package project is a generated GoMock package.
+package project
+
+import (
+	reflect "reflect"
+
+	page "github.com/MGajendra22/GoFr/model/page"
+	project "github.com/MGajendra22/GoFr/model/project"
+	task "github.com/MGajendra22/GoFr/model/task"
+	user "github.com/MGajendra22/GoFr/model/user"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockProjectServiceInterface is a mock of ProjectServiceInterface interface.
+type MockProjectServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectServiceInterfaceMockRecorder is the mock recorder for MockProjectServiceInterface.
+type MockProjectServiceInterfaceMockRecorder struct {
+	mock *MockProjectServiceInterface
+}
+
+// NewMockProjectServiceInterface creates a new mock instance.
+func NewMockProjectServiceInterface(ctrl *gomock.Controller) *MockProjectServiceInterface {
+	mock := &MockProjectServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectServiceInterface) EXPECT() *MockProjectServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockProjectServiceInterface) AddMember(c *gofr.Context, id, userID int) ([]user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", c, id, userID)
+	ret0, _ := ret[0].([]user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockProjectServiceInterfaceMockRecorder) AddMember(c, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockProjectServiceInterface)(nil).AddMember), c, id, userID)
+}
+
+// All mocks base method.
+func (m *MockProjectServiceInterface) All(c *gofr.Context) ([]project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c)
+	ret0, _ := ret[0].([]project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockProjectServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockProjectServiceInterface)(nil).All), c)
+}
+
+// Create mocks base method.
+func (m *MockProjectServiceInterface) Create(c *gofr.Context, p project.Project) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, p)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectServiceInterfaceMockRecorder) Create(c, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectServiceInterface)(nil).Create), c, p)
+}
+
+// Delete mocks base method.
+func (m *MockProjectServiceInterface) Delete(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectServiceInterfaceMockRecorder) Delete(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectServiceInterface)(nil).Delete), c, id, ver)
+}
+
+// Get mocks base method.
+func (m *MockProjectServiceInterface) Get(c *gofr.Context, id int) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectServiceInterface)(nil).Get), c, id)
+}
+
+// Members mocks base method.
+func (m *MockProjectServiceInterface) Members(c *gofr.Context, id int) ([]user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", c, id)
+	ret0, _ := ret[0].([]user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockProjectServiceInterfaceMockRecorder) Members(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockProjectServiceInterface)(nil).Members), c, id)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectServiceInterface) RemoveMember(c *gofr.Context, id, userID int) ([]user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", c, id, userID)
+	ret0, _ := ret[0].([]user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectServiceInterfaceMockRecorder) RemoveMember(c, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectServiceInterface)(nil).RemoveMember), c, id, userID)
+}
+
+// Tasks mocks base method.
+func (m *MockProjectServiceInterface) Tasks(c *gofr.Context, id int, f task.Filter, q page.Query) (project.Tasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tasks", c, id, f, q)
+	ret0, _ := ret[0].(project.Tasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tasks indicates an expected call of Tasks.
+func (mr *MockProjectServiceInterfaceMockRecorder) Tasks(c, id, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockProjectServiceInterface)(nil).Tasks), c, id, f, q)
+}
+
+// Update mocks base method.
+func (m *MockProjectServiceInterface) Update(c *gofr.Context, id, ver int, p project.Project) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, id, ver, p)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectServiceInterfaceMockRecorder) Update(c, id, ver, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectServiceInterface)(nil).Update), c, id, ver, p)
+}
@@ -0,0 +1,24 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+// Bulk applies the operations of the body, and reports how each went. The request itself succeeds even if operations
+// fail, unless it is invalid as a whole.
+func (h *handler) Bulk(c *gofr.Context) (any, error) {
+	var b task.Bulk
+
+	if err := c.Bind(&b); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	report, err := h.svc.Bulk(c, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
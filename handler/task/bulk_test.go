@@ -0,0 +1,62 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"testing"
+)
+
+func Test_Bulk(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	body := `{"mode":"best_effort","operations":[{"action":"complete","id":4},{"action":"delete","id":6,"version":7}]}`
+	b := task.Bulk{Mode: task.BulkBestEffort, Operations: []task.Operation{
+		{Action: task.BulkComplete, ID: 4}, {Action: task.BulkDelete, ID: 6, Version: 7}}}
+	report := task.BulkReport{Mode: task.BulkBestEffort, Succeeded: 1, Failed: 1, Results: []task.BulkResult{
+		{Action: task.BulkComplete, ID: 4, Status: http.StatusOK, Task: &task.Task{ID: 4, Status: task.StatusDone}},
+		{Action: task.BulkDelete, ID: 6, Status: http.StatusNotFound, Error: "task with id 6 not found"}}}
+	tooMany := errs.Validation{Field: "operations", Reason: "must have between 1 and 100 operations"}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", body, true, nil, report, nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Invalid Bulk", body, true, tooMany, nil, tooMany},
+		{"Store Down", body, true, errors.New("no connection"), nil, errors.New("no connection")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = recurrenceRequest(http.MethodPost, "/task/bulk", "", tt.body, nil)
+
+			if tt.ifMock {
+				mock.EXPECT().Bulk(gomock.Any(), b).Return(report, tt.svcErr)
+			}
+
+			val, err := h.Bulk(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
@@ -1,10 +1,16 @@
 package task
 
 import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
 	"gofr.dev/pkg/gofr"
 	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type handler struct {
@@ -47,26 +53,92 @@ func (h *handler) GetTask(c *gofr.Context) (any, error) {
 		return task1, err
 	}
 
-	return task1, nil
+	return withETag(task1), nil
 
 }
 
-func (h *handler) Complete(c *gofr.Context) (any, error) {
+// Update replaces the description and assignee of the task with the body, if If-Match holds its current ETag.
+func (h *handler) Update(c *gofr.Context) (any, error) {
 	id, err := strconv.Atoi(c.PathParam("id"))
 	if err != nil {
 		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
 	}
 
-	if err := h.svc.Complete(c, id); err != nil {
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
 		return nil, err
 	}
 
-	return task.Task{}, nil
+	var t task.Task
+
+	if err := c.Bind(&t); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	task1, err := h.svc.Update(c, id, ver, t)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(task1), nil
+}
+
+// Patch applies the body to the task as a JSON merge patch, if If-Match holds its current ETag.
+func (h *handler) Patch(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var p map[string]any
+
+	if err := c.Bind(&p); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	task1, err := h.svc.Patch(c, id, ver, p)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(task1), nil
+}
+
+// Transition moves the task to the status given in the body, if the workflow allows it and If-Match
+// holds its current ETag.
+func (h *handler) Transition(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var tr task.Transition
+
+	if err := c.Bind(&tr); err != nil || tr.Status == "" {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"status"}}
+	}
+
+	task1, err := h.svc.Transition(c, id, ver, tr.Status)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
 
+	return withETag(task1), nil
 }
 
+// GetTasksByUserID returns the tasks of the user, most urgent first.
 func (h *handler) GetTasksByUserID(c *gofr.Context) (any, error) {
-	userid, err := strconv.Atoi(c.PathParam("userid"))
+	userid, err := strconv.Atoi(c.PathParam("id"))
 	if err != nil {
 		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
 	}
@@ -79,25 +151,278 @@ func (h *handler) GetTasksByUserID(c *gofr.Context) (any, error) {
 	return tasks, nil
 }
 
+// Next returns the open task the user should work on next, ranked by priority, then due date, then age.
+func (h *handler) Next(c *gofr.Context) (any, error) {
+	userid, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	t, err := h.svc.Next(c, userid)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(t), nil
+}
+
+// Delete moves the task to the trash, if If-Match holds its current ETag.
 func (h *handler) Delete(c *gofr.Context) (any, error) {
 	id, err := strconv.Atoi(c.PathParam("id"))
 	if err != nil {
 		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
 	}
 
-	if err := h.svc.Delete(c, id); err != nil {
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
 		return nil, err
 	}
 
+	if err := h.svc.Delete(c, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
 	return task.Task{}, nil
 }
 
+// All returns a page of tasks, filtered by the status, userid, project_id, q (text contained in the description),
+// overdue, due_within (a duration such as 24h) and tags (comma separated, matching any of them unless tag_match=all)
+// query parameters and sorted by sort/order. Pages are selected with limit plus either offset or cursor.
 func (h *handler) All(c *gofr.Context) (any, error) {
+	return h.list(c, false)
+}
+
+// Trash returns a page of the tasks in the trash, taking the same query parameters as All.
+func (h *handler) Trash(c *gofr.Context) (any, error) {
+	return h.list(c, true)
+}
+
+func (h *handler) list(c *gofr.Context, trashed bool) (any, error) {
+	q, err := page.NewQuery(c.Param("limit"), c.Param("offset"), c.Param("cursor"), c.Param("sort"), c.Param("order"),
+		task.SortKeys...)
+	if err != nil {
+		return nil, err
+	}
+
+	f := task.Filter{Status: task.Status(c.Param("status")), Text: c.Param("q"), Trashed: trashed}
+
+	if userid := c.Param("userid"); userid != "" {
+		f.Userid, err = strconv.Atoi(userid)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"userid"}}
+		}
+	}
 
-	tasks, err := h.svc.All(c)
+	if projectID := c.Param("project_id"); projectID != "" {
+		f.ProjectID, err = strconv.Atoi(projectID)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"project_id"}}
+		}
+	}
+
+	if overdue := c.Param("overdue"); overdue != "" {
+		f.Overdue, err = strconv.ParseBool(overdue)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"overdue"}}
+		}
+	}
+
+	if within := c.Param("due_within"); within != "" {
+		f.DueWithin, err = time.ParseDuration(within)
+		if err != nil || f.DueWithin <= 0 {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"due_within"}}
+		}
+	}
+
+	if tags := c.Param("tags"); tags != "" {
+		f.Tags = strings.Split(tags, ",")
+	}
+
+	switch c.Param("tag_match") {
+	case "", "any":
+	case "all":
+		f.AllTags = true
+	default:
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"tag_match"}}
+	}
+
+	tasks, err := h.svc.All(c, f, q)
 	if err != nil {
 		return nil, err
 	}
 
 	return tasks, nil
 }
+
+// Restore takes the task out of the trash.
+func (h *handler) Restore(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	t, err := h.svc.Restore(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(t), nil
+}
+
+// PurgeTrash returns the cron job that permanently removes the tasks trashed for longer than retention.
+func (h *handler) PurgeTrash(retention time.Duration) gofr.CronFunc {
+	return func(c *gofr.Context) {
+		n, err := h.svc.Purge(c, retention)
+		if err != nil {
+			c.Errorf("purging trashed tasks: %v", err)
+
+			return
+		}
+
+		c.Infof("purged %d tasks trashed for longer than %v", n, retention)
+	}
+}
+
+// Tags returns the tags of the task.
+func (h *handler) Tags(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.Tags(c, id)
+}
+
+// AttachTag attaches the tag in the path to the task, creating the tag on first use.
+func (h *handler) AttachTag(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.AttachTag(c, id, c.PathParam("tag"))
+}
+
+// DetachTag removes the tag in the path from the task.
+func (h *handler) DetachTag(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.DetachTag(c, id, c.PathParam("tag"))
+}
+
+// AllTags returns every tag with the number of tasks carrying it.
+func (h *handler) AllTags(c *gofr.Context) (any, error) {
+	return h.svc.AllTags(c)
+}
+
+// Children returns the direct subtasks of the task.
+func (h *handler) Children(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.Children(c, id)
+}
+
+// Subtree returns the task with all of its subtasks, nested.
+func (h *handler) Subtree(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.Subtree(c, id)
+}
+
+// Blockers returns the tasks blocking the task.
+func (h *handler) Blockers(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.Blockers(c, id)
+}
+
+// AddBlocker records that the task is blocked by the task in the path.
+func (h *handler) AddBlocker(c *gofr.Context) (any, error) {
+	id, blocker, err := blockerParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.AddBlocker(c, id, blocker)
+}
+
+// RemoveBlocker removes the link between the task and the blocker in the path.
+func (h *handler) RemoveBlocker(c *gofr.Context) (any, error) {
+	id, blocker, err := blockerParams(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.RemoveBlocker(c, id, blocker)
+}
+
+func blockerParams(c *gofr.Context) (id, blocker int, err error) {
+	id, err = strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	blocker, err = strconv.Atoi(c.PathParam("blocker"))
+	if err != nil {
+		return 0, 0, gofrHttp.ErrorInvalidParam{Params: []string{"blocker"}}
+	}
+
+	return id, blocker, nil
+}
+
+// CriticalPath returns the chain of open blockers of the task with the largest total estimate.
+func (h *handler) CriticalPath(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return h.svc.CriticalPath(c, id)
+}
+
+// History returns a page of the changes made to the task, oldest first unless order is desc. It takes the limit,
+// offset, cursor and order query parameters.
+func (h *handler) History(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	q, err := page.NewQuery(c.Param("limit"), c.Param("offset"), c.Param("cursor"), "", c.Param("order"), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.History(c, id, q)
+}
+
+// Remind returns the cron job that sends the reminders of tasks passing their due date.
+func (h *handler) Remind() gofr.CronFunc {
+	return func(c *gofr.Context) {
+		n, err := h.svc.Remind(c)
+		if err != nil {
+			c.Errorf("sending task reminders: %v", err)
+		}
+
+		if n > 0 {
+			c.Infof("sent %d task reminders", n)
+		}
+	}
+}
+
+// withETag returns the task along with its ETag header.
+func withETag(t task.Task) response.Response {
+	return response.Response{Data: t, Headers: map[string]string{"ETag": version.ETag(t.Version)}}
+}
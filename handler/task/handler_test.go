@@ -4,18 +4,35 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
 	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
 // Test_NewHandler : To test that interface is correctly implemented or not
 func Test_NewHandler(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -54,18 +71,18 @@ func Test_CreateTasK(t *testing.T) {
 		expectedResponse gofrResponse
 		ifMock           bool
 	}{
-		{"Success Create", "application/json", task.Task{1, "Working", false, 1}, gofrResponse{result: task.Task{1, "Working", false, 1}, err: nil}, true},
+		{"Success Create", "application/json", task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}, gofrResponse{result: task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}, err: nil}, true},
 		{"Binding Error", "application/json", 10, gofrResponse{
 			result: nil,
 			err:    gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
 		}, false},
-		{"User id not found", "application/json", task.Task{1, "", false, 100}, gofrResponse{
+		{"User id not found", "application/json", task.Task{ID: 1, Desc: "", Status: task.StatusTodo, Userid: 100}, gofrResponse{
 			result: nil,
-			err:    gofrHttp.ErrorInvalidParam{Params: []string{"task.desc"}},
+			err:    errs.Validation{Field: "desc", Reason: "must not be empty"},
 		}, false},
 		{name: "Creation Failure",
 			contentType: "application/json",
-			input:       task.Task{1, "Working", false, 1},
+			input:       task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1},
 			expectedResponse: gofrResponse{
 				result: task.Task{},
 				err:    errors.New("simulated create user error"),
@@ -132,7 +149,7 @@ func Test_GetTasK(t *testing.T) {
 		ifMock           bool
 	}{
 		{"Success Get", "1", gofrResponse{
-			result: task.Task{1, "Working", false, 1},
+			result: task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1, Version: 2},
 			err:    nil,
 		}, true},
 		{"Invalid user id", "abc", gofrResponse{
@@ -164,7 +181,12 @@ func Test_GetTasK(t *testing.T) {
 			val, err := svc.GetTask(ctx)
 			response := gofrResponse{val, err}
 
-			assert.Equal(t, tt.expectedResponse.result, response.result)
+			want := tt.expectedResponse.result
+			if tt.expectedResponse.err == nil {
+				want = withETag(want.(task.Task))
+			}
+
+			assert.Equal(t, want, response.result)
 
 			if tt.expectedResponse.err != nil {
 				assert.Error(t, response.err)
@@ -197,7 +219,7 @@ func Test_GetTasksByUserID(t *testing.T) {
 		ifMock           bool
 	}{
 		{"Success Get", "1", gofrResponse{
-			result: []task.Task{{1, "Working", false, 1}},
+			result: []task.Task{{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}},
 			err:    nil,
 		}, true},
 		{"Invalid user id", "abc", gofrResponse{
@@ -218,7 +240,7 @@ func Test_GetTasksByUserID(t *testing.T) {
 			svc := NewHandler(mock)
 
 			req := httptest.NewRequest(http.MethodGet, "/task/user/"+tt.userid, nil)
-			req = mux.SetURLVars(req, map[string]string{"userid": tt.userid})
+			req = mux.SetURLVars(req, map[string]string{"id": tt.userid})
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 
@@ -243,8 +265,165 @@ func Test_GetTasksByUserID(t *testing.T) {
 
 }
 
-// Test_CompleteTask : Tests assigned id's task is completed or not
-func Test_CompleteTask(t *testing.T) {
+// Test_NextTask : Tests the next task of a user is retrieved or not
+func Test_NextTask(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	next := task.Task{ID: 8, Desc: "Fix outage", Status: task.StatusInProgress, Userid: 3, Priority: task.PriorityP0, Version: 4}
+
+	tests := []struct {
+		name    string
+		userid  string
+		ifMock  bool
+		mockErr error
+		expRes  any
+		expErr  error
+	}{
+		{"Next task", "3", true, nil, response.Response{Data: next, Headers: map[string]string{"ETag": `"4"`}}, nil},
+		{"Invalid user id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"No open task", "3", true, errs.NotFound{Entity: "open task for user", ID: 3}, nil,
+			errs.NotFound{Entity: "open task for user", ID: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/task/user/"+tt.userid+"/next", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.userid})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				mock.EXPECT().Next(gomock.Any(), 3).Return(next, tt.mockErr)
+			}
+
+			val, err := svc.Next(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+// Test_UpdateTask : Tests task is replaced with the body or not
+func Test_UpdateTask(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   nil,
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		result  any
+		svcErr  error
+		err     error
+	}{
+		{"Success Update", "1", `"3"`, `{"desc":"Rework","userid":2}`, true,
+			task.Task{ID: 1, Desc: "Rework", Status: task.StatusTodo, Userid: 2, Version: 4}, nil, nil},
+		{"Invalid task id", "abc", `"3"`, `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing If-Match", "1", "", `{"desc":"Rework","userid":2}`, false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Binding Error", "1", `"3"`, `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Task not found", "9", `"3"`, `{"desc":"Rework","userid":2}`, true, nil,
+			gofrHttp.ErrorEntityNotFound{Name: "id", Value: "9"}, gofrHttp.ErrorEntityNotFound{Name: "id", Value: "9"}},
+		{"Stale version", "1", `"3"`, `{"desc":"Rework","userid":2}`, true, nil,
+			version.ErrMismatch, version.ErrPreconditionFailed{IfMatch: `"3"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPut, "/task/"+tt.id, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", tt.ifMatch)
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				out, _ := tt.result.(task.Task)
+				mock.EXPECT().Update(gomock.Any(), gomock.Any(), 3, task.Task{Desc: "Rework", Userid: 2}).Return(out, tt.svcErr)
+			}
+
+			val, err := svc.Update(ctx)
+
+			if out, ok := tt.result.(task.Task); ok {
+				assert.Equal(t, withETag(out), val)
+			} else {
+				assert.Nil(t, val)
+			}
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+// Test_PatchTask : Tests the body is applied to the task as a merge patch or not
+func Test_PatchTask(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   nil,
+	}
+
+	tests := []struct {
+		name   string
+		id     string
+		body   string
+		ifMock bool
+		result any
+		err    error
+	}{
+		{"Success Patch", "1", `{"desc":"Rework","userid":null}`, true,
+			task.Task{ID: 1, Desc: "Rework", Status: task.StatusTodo, Userid: 2, Version: 2}, nil},
+		{"Invalid task id", "abc", `{}`, false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Not an object", "1", `"desc"`, false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Service Error", "1", `{"desc":"Rework","userid":null}`, true, nil, errors.New("simulated patch error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPatch, "/task/"+tt.id, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", "*")
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				out, _ := tt.result.(task.Task)
+				mock.EXPECT().Patch(gomock.Any(), 1, version.Any, map[string]any{"desc": "Rework", "userid": nil}).Return(out, tt.err)
+			}
+
+			val, err := svc.Patch(ctx)
+
+			if out, ok := tt.result.(task.Task); ok {
+				assert.Equal(t, withETag(out), val)
+			} else {
+				assert.Nil(t, val)
+			}
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+// Test_TransitionTask : Tests task is moved to the requested status or not
+func Test_TransitionTask(t *testing.T) {
 	type gofrResponse struct {
 		result any
 		err    error
@@ -259,20 +438,29 @@ func Test_CompleteTask(t *testing.T) {
 	tests := []struct {
 		name             string
 		id               string
+		body             string
 		expectedResponse gofrResponse
 		ifMock           bool
 	}{
-		{"Success Update", "1", gofrResponse{
-			result: task.Task{Status: true},
+		{"Success Transition", "1", `{"status":"done"}`, gofrResponse{
+			result: withETag(task.Task{ID: 1, Desc: "Working", Status: task.StatusDone, Userid: 1, Version: 3}),
 			err:    nil,
 		}, true},
-		{"Invalid user id", "abc", gofrResponse{
+		{"Invalid task id", "abc", `{"status":"done"}`, gofrResponse{
 			result: nil,
 			err:    gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
 		}, false},
-		{"Id not found", "99", gofrResponse{
+		{"Missing status", "1", `{}`, gofrResponse{
 			result: nil,
-			err:    errors.New("simulated get user error"),
+			err:    gofrHttp.ErrorInvalidParam{Params: []string{"status"}},
+		}, false},
+		{"Illegal transition", "1", `{"status":"in_review"}`, gofrResponse{
+			result: nil,
+			err:    errors.New("illegal transition"),
+		}, true},
+		{"Stale version", "1", `{"status":"done"}`, gofrResponse{
+			result: nil,
+			err:    version.ErrPreconditionFailed{IfMatch: `W/"2"`},
 		}, true},
 	}
 
@@ -283,17 +471,31 @@ func Test_CompleteTask(t *testing.T) {
 			mock := NewMockTaskServiceInterface(ctrl)
 			svc := NewHandler(mock)
 
-			req := httptest.NewRequest(http.MethodPut, "/task/"+tt.id, nil)
-			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			req := httptest.NewRequest(http.MethodPost, "/task/"+tt.id+"/transition", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", `W/"2"`)
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 
 			if tt.ifMock {
-				mock.EXPECT().Complete(gomock.Any(), gomock.Any()).Return(tt.expectedResponse.err)
+				var out task.Task
+				if tt.expectedResponse.result != nil {
+					out = tt.expectedResponse.result.(response.Response).Data.(task.Task)
+				}
+
+				svcErr := tt.expectedResponse.err
+				if errors.As(svcErr, &version.ErrPreconditionFailed{}) {
+					svcErr = version.ErrMismatch
+				}
+
+				mock.EXPECT().Transition(gomock.Any(), gomock.Any(), 2, gomock.Any()).Return(out, svcErr)
 			}
 
-			_, err := svc.Complete(ctx)
-			response := gofrResponse{nil, err}
+			val, err := svc.Transition(ctx)
+			response := gofrResponse{val, err}
+
+			assert.Equal(t, tt.expectedResponse.result, response.result)
 
 			if tt.expectedResponse.err != nil {
 				assert.Error(t, response.err)
@@ -306,8 +508,7 @@ func Test_CompleteTask(t *testing.T) {
 	}
 }
 
-// Test_AllTasks : Tests all tasks are retrieved or not
-
+// Test_AllTasks : Tests a page of tasks is retrieved with the requested filters or not
 func Test_AllTasks(t *testing.T) {
 	type gofrResponse struct {
 		result any
@@ -320,14 +521,42 @@ func Test_AllTasks(t *testing.T) {
 		Request:   nil,
 	}
 
+	tasks := page.Page[task.Task]{Items: []task.Task{{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}}, Total: 1, Limit: 20}
+
 	tests := []struct {
 		name             string
-		input            []task.Task
+		query            string
+		filter           task.Filter
+		pageQuery        page.Query
 		expectedResponse gofrResponse
 		ifMock           bool
 	}{
-		{"Successfully Get", []task.Task{{1, "Working", false, 1}}, gofrResponse{result: []task.Task{{1, "Working", false, 1}}, err: nil}, true},
-		{"Unable to fetch user data", []task.Task{{1, "Working", false, 1}}, gofrResponse{nil, errors.New("Failed to fetch user's data")}, true},
+		{"Successfully Get", "", task.Filter{}, page.Query{Limit: 20, Sort: "id", Order: "asc"},
+			gofrResponse{result: tasks, err: nil}, true},
+		{"Filtered and sorted", "?status=todo&userid=1&q=work&sort=desc&order=desc&limit=5&offset=10",
+			task.Filter{Status: task.StatusTodo, Userid: 1, Text: "work"},
+			page.Query{Limit: 5, Offset: 10, Sort: "desc", Order: "desc"},
+			gofrResponse{result: tasks, err: nil}, true},
+		{"Overdue and due soon", "?overdue=true&due_within=48h", task.Filter{Overdue: true, DueWithin: 48 * time.Hour},
+			page.Query{Limit: 20, Sort: "id", Order: "asc"}, gofrResponse{result: tasks, err: nil}, true},
+		{"Tagged", "?tags=backend,bug&tag_match=all", task.Filter{Tags: []string{"backend", "bug"}, AllTags: true},
+			page.Query{Limit: 20, Sort: "id", Order: "asc"}, gofrResponse{result: tasks, err: nil}, true},
+		{"Invalid tag_match", "?tags=bug&tag_match=some", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"tag_match"}}}, false},
+		{"Invalid overdue", "?overdue=maybe", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"overdue"}}}, false},
+		{"Invalid due_within", "?due_within=-1h", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"due_within"}}}, false},
+		{"Invalid userid", "?userid=abc", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"userid"}}}, false},
+		{"In project", "?project_id=4", task.Filter{ProjectID: 4}, page.Query{Limit: 20, Sort: "id", Order: "asc"},
+			gofrResponse{result: tasks, err: nil}, true},
+		{"Invalid project_id", "?project_id=web", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"project_id"}}}, false},
+		{"Invalid limit", "?limit=1000", task.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}}, false},
+		{"Unable to fetch user data", "", task.Filter{}, page.Query{Limit: 20, Sort: "id", Order: "asc"},
+			gofrResponse{nil, errors.New("Failed to fetch user's data")}, true},
 	}
 
 	for _, tt := range tests {
@@ -337,11 +566,16 @@ func Test_AllTasks(t *testing.T) {
 			mock := NewMockTaskServiceInterface(ctrl)
 			svc := NewHandler(mock)
 
-			req := httptest.NewRequest(http.MethodGet, "/task/", nil)
+			req := httptest.NewRequest(http.MethodGet, "/task"+tt.query, nil)
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 			if tt.ifMock {
-				mock.EXPECT().All(gomock.Any()).Return(tt.expectedResponse.result, tt.expectedResponse.err)
+				var out page.Page[task.Task]
+				if tt.expectedResponse.result != nil {
+					out = tt.expectedResponse.result.(page.Page[task.Task])
+				}
+
+				mock.EXPECT().All(gomock.Any(), tt.filter, tt.pageQuery).Return(out, tt.expectedResponse.err)
 			}
 
 			val, err := svc.All(ctx)
@@ -352,6 +586,7 @@ func Test_AllTasks(t *testing.T) {
 				assert.Contains(t, response.err.Error(), tt.expectedResponse.err.Error())
 			} else {
 				assert.NoError(t, response.err)
+				assert.Equal(t, tt.expectedResponse.result, response.result)
 			}
 
 		})
@@ -389,6 +624,10 @@ func Test_DeleteTask(t *testing.T) {
 			result: nil,
 			err:    errors.New("simulated get user error"),
 		}, true},
+		{"Malformed If-Match", "1", gofrResponse{
+			result: nil,
+			err:    version.ErrPreconditionFailed{IfMatch: "5"},
+		}, false},
 	}
 
 	for _, tt := range tests {
@@ -399,12 +638,18 @@ func Test_DeleteTask(t *testing.T) {
 			svc := NewHandler(mock)
 
 			req := httptest.NewRequest(http.MethodDelete, "/task/"+tt.id, nil)
-			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			req.Header.Set("If-Match", `"5"`)
+
+			if !tt.ifMock {
+				req.Header.Set("If-Match", "5")
+			}
+
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 
 			if tt.ifMock {
-				mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.expectedResponse.err)
+				mock.EXPECT().Delete(gomock.Any(), gomock.Any(), 5).Return(tt.expectedResponse.err)
 			}
 
 			_, err := svc.Delete(ctx)
@@ -420,3 +665,356 @@ func Test_DeleteTask(t *testing.T) {
 
 	}
 }
+
+func Test_TrashTasks(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	svc := NewHandler(mock)
+
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	trash := page.Page[task.Task]{Items: []task.Task{{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1, Version: 2,
+		DeletedAt: &deletedAt}}, Total: 1, Limit: 20}
+
+	ctx.Request = gofrHttp.NewRequest(httptest.NewRequest(http.MethodGet, "/task/trash?userid=1", nil))
+
+	mock.EXPECT().All(gomock.Any(), task.Filter{Userid: 1, Trashed: true}, page.Query{Limit: 20, Sort: "id", Order: "asc"}).
+		Return(trash, nil)
+
+	val, err := svc.Trash(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, trash, val)
+}
+
+func Test_RestoreTask(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	restored := task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1, Version: 3}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMock  bool
+		mockErr error
+		expRes  any
+		expErr  error
+	}{
+		{"Restored", "1", true, nil, response.Response{Data: restored, Headers: map[string]string{"ETag": `"3"`}}, nil},
+		{"Invalid id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Not in trash", "1", true, errs.NotFound{Entity: "task", ID: 1}, nil, errs.NotFound{Entity: "task", ID: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/task/"+tt.id+"/restore", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				mock.EXPECT().Restore(gomock.Any(), 1).Return(restored, tt.mockErr)
+			}
+
+			val, err := svc.Restore(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_PurgeTrash(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	svc := NewHandler(mock)
+
+	mock.EXPECT().Purge(ctx, 720*time.Hour).Return(int64(2), nil)
+	mock.EXPECT().Purge(ctx, 720*time.Hour).Return(int64(0), errors.New("purge failed"))
+
+	job := svc.PurgeTrash(720 * time.Hour)
+
+	job(ctx)
+	job(ctx)
+}
+
+func Test_Remind(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	svc := NewHandler(mock)
+
+	mock.EXPECT().Remind(ctx).Return(3, nil)
+	mock.EXPECT().Remind(ctx).Return(1, errors.New("notify failed"))
+
+	job := svc.Remind()
+
+	job(ctx)
+	job(ctx)
+}
+
+func Test_TaskTags(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		id     string
+		call   func(h *handler, c *gofr.Context) (any, error)
+		expect func(m *MockTaskServiceInterface)
+		expRes any
+		expErr error
+	}{
+		{"List", http.MethodGet, "1", (*handler).Tags,
+			func(m *MockTaskServiceInterface) { m.EXPECT().Tags(gomock.Any(), 1).Return([]string{"bug"}, nil) },
+			[]string{"bug"}, nil},
+		{"Attach", http.MethodPut, "1", (*handler).AttachTag,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().AttachTag(gomock.Any(), 1, "bug").Return([]string{"backend", "bug"}, nil)
+			},
+			[]string{"backend", "bug"}, nil},
+		{"Detach", http.MethodDelete, "1", (*handler).DetachTag,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().DetachTag(gomock.Any(), 1, "bug").Return([]string{}, nil)
+			},
+			[]string{}, nil},
+		{"Task not found", http.MethodPut, "9", (*handler).AttachTag,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().AttachTag(gomock.Any(), 9, "bug").Return(nil, errs.NotFound{Entity: "task", ID: 9})
+			},
+			[]string(nil), errs.NotFound{Entity: "task", ID: 9}},
+		{"Invalid id", http.MethodDelete, "abc", (*handler).DetachTag,
+			func(*MockTaskServiceInterface) {}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(tt.method, "/task/"+tt.id+"/tags/bug", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id, "tag": "bug"})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			tt.expect(mock)
+
+			val, err := tt.call(svc, ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_AllTags(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	svc := NewHandler(mock)
+
+	ctx.Request = gofrHttp.NewRequest(httptest.NewRequest(http.MethodGet, "/tags", nil))
+
+	mock.EXPECT().AllTags(gomock.Any()).Return([]task.Tag{{Name: "bug", Tasks: 2}}, nil)
+
+	val, err := svc.AllTags(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []task.Tag{{Name: "bug", Tasks: 2}}, val)
+}
+
+func Test_Hierarchy(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	parentID := 1
+	child := task.Task{ID: 2, Desc: "Story", Status: task.StatusTodo, ParentID: &parentID}
+	tree := task.Node{Task: task.Task{ID: 1, Desc: "Epic"}, Children: []task.Node{{Task: child, Children: []task.Node{}}}}
+
+	tests := []struct {
+		name   string
+		path   string
+		id     string
+		call   func(h *handler, c *gofr.Context) (any, error)
+		expect func(m *MockTaskServiceInterface)
+		expRes any
+		expErr error
+	}{
+		{"Children", "children", "1", (*handler).Children,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().Children(gomock.Any(), 1).Return([]task.Task{child}, nil)
+			},
+			[]task.Task{child}, nil},
+		{"Subtree", "subtree", "1", (*handler).Subtree,
+			func(m *MockTaskServiceInterface) { m.EXPECT().Subtree(gomock.Any(), 1).Return(tree, nil) },
+			tree, nil},
+		{"Task not found", "subtree", "9", (*handler).Subtree,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().Subtree(gomock.Any(), 9).Return(task.Node{}, errs.NotFound{Entity: "task", ID: 9})
+			},
+			task.Node{}, errs.NotFound{Entity: "task", ID: 9}},
+		{"Invalid id", "children", "abc", (*handler).Children,
+			func(*MockTaskServiceInterface) {}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/task/"+tt.id+"/"+tt.path, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			tt.expect(mock)
+
+			val, err := tt.call(svc, ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Dependencies(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	estimate := 3
+	blocker := task.Task{ID: 1, Desc: "Design", Status: task.StatusTodo, Estimate: &estimate}
+	path := task.Path{Tasks: []task.Task{blocker, {ID: 2, Desc: "Build"}}, Estimate: 3}
+
+	tests := []struct {
+		name    string
+		method  string
+		id      string
+		blocker string
+		call    func(h *handler, c *gofr.Context) (any, error)
+		expect  func(m *MockTaskServiceInterface)
+		expRes  any
+		expErr  error
+	}{
+		{"List", http.MethodGet, "2", "", (*handler).Blockers,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().Blockers(gomock.Any(), 2).Return([]task.Task{blocker}, nil)
+			},
+			[]task.Task{blocker}, nil},
+		{"Add", http.MethodPut, "2", "1", (*handler).AddBlocker,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().AddBlocker(gomock.Any(), 2, 1).Return([]task.Task{blocker}, nil)
+			},
+			[]task.Task{blocker}, nil},
+		{"Cycle", http.MethodPut, "1", "2", (*handler).AddBlocker,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().AddBlocker(gomock.Any(), 1, 2).
+					Return(nil, errs.Validation{Field: "blocker", Reason: "task 2 already waits on task 1"})
+			},
+			[]task.Task(nil), errs.Validation{Field: "blocker", Reason: "task 2 already waits on task 1"}},
+		{"Remove", http.MethodDelete, "2", "1", (*handler).RemoveBlocker,
+			func(m *MockTaskServiceInterface) {
+				m.EXPECT().RemoveBlocker(gomock.Any(), 2, 1).Return([]task.Task{}, nil)
+			},
+			[]task.Task{}, nil},
+		{"Invalid blocker", http.MethodPut, "2", "abc", (*handler).AddBlocker,
+			func(*MockTaskServiceInterface) {}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"blocker"}}},
+		{"Critical path", http.MethodGet, "2", "", (*handler).CriticalPath,
+			func(m *MockTaskServiceInterface) { m.EXPECT().CriticalPath(gomock.Any(), 2).Return(path, nil) },
+			path, nil},
+		{"Invalid id", http.MethodGet, "abc", "", (*handler).CriticalPath,
+			func(*MockTaskServiceInterface) {}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(tt.method, "/task/"+tt.id+"/blockers/"+tt.blocker, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id, "blocker": tt.blocker})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			tt.expect(mock)
+
+			val, err := tt.call(svc, ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_History(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	history := page.Page[task.Event]{Items: []task.Event{{ID: 1, TaskID: 1, Type: task.EventCreated}}, Total: 1, Limit: 20}
+
+	tests := []struct {
+		name   string
+		id     string
+		query  string
+		expQ   *page.Query
+		expRes any
+		expErr error
+	}{
+		{"Oldest first", "1", "", &page.Query{Limit: 20, Sort: "id", Order: "asc"}, history, nil},
+		{"Newest first", "1", "?order=desc&limit=5", &page.Query{Limit: 5, Sort: "id", Order: "desc"}, history, nil},
+		{"Invalid id", "abc", "", nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Invalid order", "1", "?order=up", nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"order"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			svc := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/task/"+tt.id+"/history"+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.expQ != nil {
+				mock.EXPECT().History(gomock.Any(), 1, *tt.expQ).Return(history, nil)
+			}
+
+			val, err := svc.History(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
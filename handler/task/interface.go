@@ -1,15 +1,43 @@
 package task
 
 import (
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
 	"gofr.dev/pkg/gofr"
+	"time"
 )
 
 type TaskServiceInterface interface {
 	Create(c *gofr.Context, t task.Task) (task.Task, error)
 	GetTask(c *gofr.Context, id int) (task.Task, error)
-	Complete(c *gofr.Context, id int) error
-	Delete(c *gofr.Context, id int) error
-	All(c *gofr.Context) ([]task.Task, error)
+	Update(c *gofr.Context, id, ver int, t task.Task) (task.Task, error)
+	Patch(c *gofr.Context, id, ver int, patch map[string]any) (task.Task, error)
+	Transition(c *gofr.Context, id, ver int, to task.Status) (task.Task, error)
+	Delete(c *gofr.Context, id, ver int) error
+	Restore(c *gofr.Context, id int) (task.Task, error)
+	Purge(c *gofr.Context, retention time.Duration) (int64, error)
+	Remind(c *gofr.Context) (int, error)
+	All(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error)
 	GetTasksByUserID(c *gofr.Context, userId int) ([]task.Task, error)
+	Next(c *gofr.Context, userid int) (task.Task, error)
+	Tags(c *gofr.Context, id int) ([]string, error)
+	AttachTag(c *gofr.Context, id int, name string) ([]string, error)
+	DetachTag(c *gofr.Context, id int, name string) ([]string, error)
+	AllTags(c *gofr.Context) ([]task.Tag, error)
+	Children(c *gofr.Context, id int) ([]task.Task, error)
+	Subtree(c *gofr.Context, id int) (task.Node, error)
+	Blockers(c *gofr.Context, id int) ([]task.Task, error)
+	AddBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error)
+	RemoveBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error)
+	CriticalPath(c *gofr.Context, id int) (task.Path, error)
+	History(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error)
+	CreateRecurrence(c *gofr.Context, r task.Recurrence) (task.Recurrence, error)
+	GetRecurrence(c *gofr.Context, id int) (task.Recurrence, error)
+	AllRecurrences(c *gofr.Context) ([]task.Recurrence, error)
+	UpdateRecurrence(c *gofr.Context, id, ver int, r task.Recurrence) (task.Recurrence, error)
+	DeleteRecurrence(c *gofr.Context, id, ver int) error
+	Occurrences(c *gofr.Context, id, n int) ([]time.Time, error)
+	PreviewRecurrence(r task.Recurrence, n int) ([]time.Time, error)
+	Recur(c *gofr.Context) (int, error)
+	Bulk(c *gofr.Context, b task.Bulk) (task.BulkReport, error)
 }
@@ -11,7 +11,9 @@ package task
 
 import (
 	reflect "reflect"
+	time "time"
 
+	page "github.com/MGajendra22/GoFr/model/page"
 	task "github.com/MGajendra22/GoFr/model/task"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
@@ -41,33 +43,124 @@ func (m *MockTaskServiceInterface) EXPECT() *MockTaskServiceInterfaceMockRecorde
 	return m.recorder
 }
 
-// All mocks base method.
-func (m *MockTaskServiceInterface) All(c *gofr.Context) ([]task.Task, error) {
+// AddBlocker mocks base method.
+func (m *MockTaskServiceInterface) AddBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "All", c)
+	ret := m.ctrl.Call(m, "AddBlocker", c, id, blocker)
 	ret0, _ := ret[0].([]task.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// AddBlocker indicates an expected call of AddBlocker.
+func (mr *MockTaskServiceInterfaceMockRecorder) AddBlocker(c, id, blocker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlocker", reflect.TypeOf((*MockTaskServiceInterface)(nil).AddBlocker), c, id, blocker)
+}
+
+// All mocks base method.
+func (m *MockTaskServiceInterface) All(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c, f, q)
+	ret0, _ := ret[0].(page.Page[task.Task])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // All indicates an expected call of All.
-func (mr *MockTaskServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+func (mr *MockTaskServiceInterfaceMockRecorder) All(c, f, q any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockTaskServiceInterface)(nil).All), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockTaskServiceInterface)(nil).All), c, f, q)
 }
 
-// Complete mocks base method.
-func (m *MockTaskServiceInterface) Complete(c *gofr.Context, id int) error {
+// AllRecurrences mocks base method.
+func (m *MockTaskServiceInterface) AllRecurrences(c *gofr.Context) ([]task.Recurrence, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Complete", c, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AllRecurrences", c)
+	ret0, _ := ret[0].([]task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllRecurrences indicates an expected call of AllRecurrences.
+func (mr *MockTaskServiceInterfaceMockRecorder) AllRecurrences(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllRecurrences", reflect.TypeOf((*MockTaskServiceInterface)(nil).AllRecurrences), c)
+}
+
+// AllTags mocks base method.
+func (m *MockTaskServiceInterface) AllTags(c *gofr.Context) ([]task.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllTags", c)
+	ret0, _ := ret[0].([]task.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllTags indicates an expected call of AllTags.
+func (mr *MockTaskServiceInterfaceMockRecorder) AllTags(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllTags", reflect.TypeOf((*MockTaskServiceInterface)(nil).AllTags), c)
+}
+
+// AttachTag mocks base method.
+func (m *MockTaskServiceInterface) AttachTag(c *gofr.Context, id int, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", c, id, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockTaskServiceInterfaceMockRecorder) AttachTag(c, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockTaskServiceInterface)(nil).AttachTag), c, id, name)
+}
+
+// Blockers mocks base method.
+func (m *MockTaskServiceInterface) Blockers(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blockers", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blockers indicates an expected call of Blockers.
+func (mr *MockTaskServiceInterfaceMockRecorder) Blockers(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blockers", reflect.TypeOf((*MockTaskServiceInterface)(nil).Blockers), c, id)
+}
+
+// Bulk mocks base method.
+func (m *MockTaskServiceInterface) Bulk(c *gofr.Context, b task.Bulk) (task.BulkReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", c, b)
+	ret0, _ := ret[0].(task.BulkReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockTaskServiceInterfaceMockRecorder) Bulk(c, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockTaskServiceInterface)(nil).Bulk), c, b)
+}
+
+// Children mocks base method.
+func (m *MockTaskServiceInterface) Children(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Children", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Complete indicates an expected call of Complete.
-func (mr *MockTaskServiceInterfaceMockRecorder) Complete(c, id any) *gomock.Call {
+// Children indicates an expected call of Children.
+func (mr *MockTaskServiceInterfaceMockRecorder) Children(c, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTaskServiceInterface)(nil).Complete), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Children", reflect.TypeOf((*MockTaskServiceInterface)(nil).Children), c, id)
 }
 
 // Create mocks base method.
@@ -85,18 +178,92 @@ func (mr *MockTaskServiceInterfaceMockRecorder) Create(c, t any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskServiceInterface)(nil).Create), c, t)
 }
 
+// CreateRecurrence mocks base method.
+func (m *MockTaskServiceInterface) CreateRecurrence(c *gofr.Context, r task.Recurrence) (task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurrence", c, r)
+	ret0, _ := ret[0].(task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurrence indicates an expected call of CreateRecurrence.
+func (mr *MockTaskServiceInterfaceMockRecorder) CreateRecurrence(c, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurrence", reflect.TypeOf((*MockTaskServiceInterface)(nil).CreateRecurrence), c, r)
+}
+
+// CriticalPath mocks base method.
+func (m *MockTaskServiceInterface) CriticalPath(c *gofr.Context, id int) (task.Path, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CriticalPath", c, id)
+	ret0, _ := ret[0].(task.Path)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CriticalPath indicates an expected call of CriticalPath.
+func (mr *MockTaskServiceInterfaceMockRecorder) CriticalPath(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CriticalPath", reflect.TypeOf((*MockTaskServiceInterface)(nil).CriticalPath), c, id)
+}
+
 // Delete mocks base method.
-func (m *MockTaskServiceInterface) Delete(c *gofr.Context, id int) error {
+func (m *MockTaskServiceInterface) Delete(c *gofr.Context, id, ver int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret := m.ctrl.Call(m, "Delete", c, id, ver)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTaskServiceInterfaceMockRecorder) Delete(c, id any) *gomock.Call {
+func (mr *MockTaskServiceInterfaceMockRecorder) Delete(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskServiceInterface)(nil).Delete), c, id, ver)
+}
+
+// DeleteRecurrence mocks base method.
+func (m *MockTaskServiceInterface) DeleteRecurrence(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurrence", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurrence indicates an expected call of DeleteRecurrence.
+func (mr *MockTaskServiceInterfaceMockRecorder) DeleteRecurrence(c, id, ver any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskServiceInterface)(nil).Delete), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurrence", reflect.TypeOf((*MockTaskServiceInterface)(nil).DeleteRecurrence), c, id, ver)
+}
+
+// DetachTag mocks base method.
+func (m *MockTaskServiceInterface) DetachTag(c *gofr.Context, id int, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", c, id, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockTaskServiceInterfaceMockRecorder) DetachTag(c, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockTaskServiceInterface)(nil).DetachTag), c, id, name)
+}
+
+// GetRecurrence mocks base method.
+func (m *MockTaskServiceInterface) GetRecurrence(c *gofr.Context, id int) (task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurrence", c, id)
+	ret0, _ := ret[0].(task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurrence indicates an expected call of GetRecurrence.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetRecurrence(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurrence", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetRecurrence), c, id)
 }
 
 // GetTask mocks base method.
@@ -128,3 +295,228 @@ func (mr *MockTaskServiceInterfaceMockRecorder) GetTasksByUserID(c, userId any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByUserID", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTasksByUserID), c, userId)
 }
+
+// History mocks base method.
+func (m *MockTaskServiceInterface) History(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", c, id, q)
+	ret0, _ := ret[0].(page.Page[task.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockTaskServiceInterfaceMockRecorder) History(c, id, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockTaskServiceInterface)(nil).History), c, id, q)
+}
+
+// Next mocks base method.
+func (m *MockTaskServiceInterface) Next(c *gofr.Context, userid int) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", c, userid)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockTaskServiceInterfaceMockRecorder) Next(c, userid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockTaskServiceInterface)(nil).Next), c, userid)
+}
+
+// Occurrences mocks base method.
+func (m *MockTaskServiceInterface) Occurrences(c *gofr.Context, id, n int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occurrences", c, id, n)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occurrences indicates an expected call of Occurrences.
+func (mr *MockTaskServiceInterfaceMockRecorder) Occurrences(c, id, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occurrences", reflect.TypeOf((*MockTaskServiceInterface)(nil).Occurrences), c, id, n)
+}
+
+// Patch mocks base method.
+func (m *MockTaskServiceInterface) Patch(c *gofr.Context, id, ver int, patch map[string]any) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", c, id, ver, patch)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockTaskServiceInterfaceMockRecorder) Patch(c, id, ver, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockTaskServiceInterface)(nil).Patch), c, id, ver, patch)
+}
+
+// PreviewRecurrence mocks base method.
+func (m *MockTaskServiceInterface) PreviewRecurrence(r task.Recurrence, n int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewRecurrence", r, n)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewRecurrence indicates an expected call of PreviewRecurrence.
+func (mr *MockTaskServiceInterfaceMockRecorder) PreviewRecurrence(r, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewRecurrence", reflect.TypeOf((*MockTaskServiceInterface)(nil).PreviewRecurrence), r, n)
+}
+
+// Purge mocks base method.
+func (m *MockTaskServiceInterface) Purge(c *gofr.Context, retention time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", c, retention)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTaskServiceInterfaceMockRecorder) Purge(c, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTaskServiceInterface)(nil).Purge), c, retention)
+}
+
+// Recur mocks base method.
+func (m *MockTaskServiceInterface) Recur(c *gofr.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recur", c)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recur indicates an expected call of Recur.
+func (mr *MockTaskServiceInterfaceMockRecorder) Recur(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recur", reflect.TypeOf((*MockTaskServiceInterface)(nil).Recur), c)
+}
+
+// Remind mocks base method.
+func (m *MockTaskServiceInterface) Remind(c *gofr.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remind", c)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remind indicates an expected call of Remind.
+func (mr *MockTaskServiceInterfaceMockRecorder) Remind(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remind", reflect.TypeOf((*MockTaskServiceInterface)(nil).Remind), c)
+}
+
+// RemoveBlocker mocks base method.
+func (m *MockTaskServiceInterface) RemoveBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlocker", c, id, blocker)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBlocker indicates an expected call of RemoveBlocker.
+func (mr *MockTaskServiceInterfaceMockRecorder) RemoveBlocker(c, id, blocker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlocker", reflect.TypeOf((*MockTaskServiceInterface)(nil).RemoveBlocker), c, id, blocker)
+}
+
+// Restore mocks base method.
+func (m *MockTaskServiceInterface) Restore(c *gofr.Context, id int) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", c, id)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskServiceInterfaceMockRecorder) Restore(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskServiceInterface)(nil).Restore), c, id)
+}
+
+// Subtree mocks base method.
+func (m *MockTaskServiceInterface) Subtree(c *gofr.Context, id int) (task.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subtree", c, id)
+	ret0, _ := ret[0].(task.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subtree indicates an expected call of Subtree.
+func (mr *MockTaskServiceInterfaceMockRecorder) Subtree(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subtree", reflect.TypeOf((*MockTaskServiceInterface)(nil).Subtree), c, id)
+}
+
+// Tags mocks base method.
+func (m *MockTaskServiceInterface) Tags(c *gofr.Context, id int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags", c, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockTaskServiceInterfaceMockRecorder) Tags(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockTaskServiceInterface)(nil).Tags), c, id)
+}
+
+// Transition mocks base method.
+func (m *MockTaskServiceInterface) Transition(c *gofr.Context, id, ver int, to task.Status) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", c, id, ver, to)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockTaskServiceInterfaceMockRecorder) Transition(c, id, ver, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockTaskServiceInterface)(nil).Transition), c, id, ver, to)
+}
+
+// Update mocks base method.
+func (m *MockTaskServiceInterface) Update(c *gofr.Context, id, ver int, t task.Task) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, id, ver, t)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskServiceInterfaceMockRecorder) Update(c, id, ver, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskServiceInterface)(nil).Update), c, id, ver, t)
+}
+
+// UpdateRecurrence mocks base method.
+func (m *MockTaskServiceInterface) UpdateRecurrence(c *gofr.Context, id, ver int, r task.Recurrence) (task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurrence", c, id, ver, r)
+	ret0, _ := ret[0].(task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurrence indicates an expected call of UpdateRecurrence.
+func (mr *MockTaskServiceInterfaceMockRecorder) UpdateRecurrence(c, id, ver, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurrence", reflect.TypeOf((*MockTaskServiceInterface)(nil).UpdateRecurrence), c, id, ver, r)
+}
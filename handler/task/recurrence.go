@@ -0,0 +1,156 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"strconv"
+)
+
+// defaultOccurrences is the number of upcoming occurrences listed when no limit is given.
+const defaultOccurrences = 10
+
+func (h *handler) CreateRecurrence(c *gofr.Context) (any, error) {
+	var r task.Recurrence
+
+	if err := c.Bind(&r); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	created, err := h.svc.CreateRecurrence(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return recurrenceWithETag(created), nil
+}
+
+func (h *handler) GetRecurrence(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	r, err := h.svc.GetRecurrence(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return recurrenceWithETag(r), nil
+}
+
+func (h *handler) AllRecurrences(c *gofr.Context) (any, error) {
+	return h.svc.AllRecurrences(c)
+}
+
+// UpdateRecurrence replaces the template, rule and start of a recurrence with the body, if If-Match holds its
+// current ETag.
+func (h *handler) UpdateRecurrence(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var r task.Recurrence
+
+	if err := c.Bind(&r); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	updated, err := h.svc.UpdateRecurrence(c, id, ver, r)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return recurrenceWithETag(updated), nil
+}
+
+func (h *handler) DeleteRecurrence(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.DeleteRecurrence(c, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return task.Recurrence{}, nil
+}
+
+// Occurrences lists when the next occurrences of a recurrence are due, as many as the limit query parameter asks.
+func (h *handler) Occurrences(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	n, err := occurrencesLimit(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.svc.Occurrences(c, id, n)
+}
+
+// PreviewRecurrence lists when the first occurrences of the recurrence in the body would be due, without creating it.
+func (h *handler) PreviewRecurrence(c *gofr.Context) (any, error) {
+	n, err := occurrencesLimit(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var r task.Recurrence
+
+	if err := c.Bind(&r); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.svc.PreviewRecurrence(r, n)
+}
+
+// occurrencesLimit reads the number of occurrences to list, between 1 and task.MaxOccurrences.
+func occurrencesLimit(c *gofr.Context) (int, error) {
+	s := c.Param("limit")
+	if s == "" {
+		return defaultOccurrences, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > task.MaxOccurrences {
+		return 0, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}
+	}
+
+	return n, nil
+}
+
+// Recur is the cron job creating the next occurrence of the recurrences whose latest one is closed or due.
+func (h *handler) Recur() gofr.CronFunc {
+	return func(c *gofr.Context) {
+		n, err := h.svc.Recur(c)
+		if err != nil {
+			c.Errorf("creating recurring tasks: %v", err)
+		}
+
+		if n > 0 {
+			c.Infof("created %d recurring tasks", n)
+		}
+	}
+}
+
+func recurrenceWithETag(r task.Recurrence) response.Response {
+	return response.Response{Data: r, Headers: map[string]string{"ETag": version.ETag(r.Version)}}
+}
@@ -0,0 +1,249 @@
+package task
+
+import (
+	"bytes"
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func recurrenceRequest(method, target, ifMatch, body string, vars map[string]string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	return gofrHttp.NewRequest(mux.SetURLVars(ifMatched(req), vars))
+}
+
+var (
+	monday = time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	weekly = `{"desc":"Rotate on-call","userid":2,"rule":{"freq":"weekly"},"start":"2026-10-19T09:00:00Z"}`
+	rotate = task.Recurrence{Desc: "Rotate on-call", Userid: 2, Rule: task.Rule{Freq: task.FrequencyWeekly}, Start: monday}
+)
+
+func Test_CreateRecurrence(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	created := rotate
+	created.ID, created.Version, created.NextAt = 5, 1, &monday
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", weekly, true, nil, recurrenceWithETag(created), nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Unknown user", weekly, true, errs.DependencyMissing{Entity: "user", ID: 2}, nil, errs.DependencyMissing{Entity: "user", ID: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = recurrenceRequest(http.MethodPost, "/recurrence", "", tt.body, nil)
+
+			if tt.ifMock {
+				mock.EXPECT().CreateRecurrence(gomock.Any(), rotate).Return(created, tt.svcErr)
+			}
+
+			val, err := h.CreateRecurrence(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_UpdateRecurrence(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	updated := rotate
+	updated.ID, updated.Version = 5, 3
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		ifMock  bool
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", "5", `"2"`, true, nil, recurrenceWithETag(updated), nil},
+		{"Invalid id", "abc", `"2"`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing If-Match", "5", "", false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Stale version", "5", `"2"`, true, version.ErrMismatch, nil, version.ErrPreconditionFailed{IfMatch: `"2"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = recurrenceRequest(http.MethodPut, "/recurrence/"+tt.id, tt.ifMatch, weekly, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().UpdateRecurrence(gomock.Any(), 5, 2, rotate).Return(updated, tt.svcErr)
+			}
+
+			val, err := h.UpdateRecurrence(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_GetAndDeleteRecurrence(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	r := rotate
+	r.ID, r.Version = 5, 2
+
+	ctx.Request = recurrenceRequest(http.MethodGet, "/recurrence/5", "", "", map[string]string{"id": "5"})
+	mock.EXPECT().GetRecurrence(gomock.Any(), 5).Return(r, nil)
+
+	val, err := h.GetRecurrence(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, recurrenceWithETag(r), val)
+
+	ctx.Request = recurrenceRequest(http.MethodGet, "/recurrence", "", "", nil)
+	mock.EXPECT().AllRecurrences(gomock.Any()).Return([]task.Recurrence{r}, nil)
+
+	val, err = h.AllRecurrences(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []task.Recurrence{r}, val)
+
+	ctx.Request = recurrenceRequest(http.MethodDelete, "/recurrence/5", "*", "", map[string]string{"id": "5"})
+	mock.EXPECT().DeleteRecurrence(gomock.Any(), 5, version.Any).Return(errs.NotFound{Entity: "recurrence", ID: 5})
+
+	val, err = h.DeleteRecurrence(ctx)
+
+	assert.Nil(t, val)
+	assert.Equal(t, errs.NotFound{Entity: "recurrence", ID: 5}, err)
+}
+
+func Test_Occurrences(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	dates := []time.Time{monday, monday.AddDate(0, 0, 7)}
+
+	tests := []struct {
+		name   string
+		target string
+		id     string
+		ifMock bool
+		n      int
+		expErr error
+	}{
+		{"Default Limit", "/recurrence/5/occurrences", "5", true, 10, nil},
+		{"Given Limit", "/recurrence/5/occurrences?limit=2", "5", true, 2, nil},
+		{"Invalid id", "/recurrence/abc/occurrences", "abc", false, 0, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Limit Too High", "/recurrence/5/occurrences?limit=101", "5", false, 0, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTaskServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = recurrenceRequest(http.MethodGet, tt.target, "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Occurrences(gomock.Any(), 5, tt.n).Return(dates, nil)
+			}
+
+			val, err := h.Occurrences(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+
+			if tt.expErr == nil {
+				assert.Equal(t, dates, val)
+			}
+		})
+	}
+}
+
+func Test_PreviewRecurrence(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	ctx.Request = recurrenceRequest(http.MethodPost, "/recurrence/preview?limit=3", "", weekly, nil)
+	mock.EXPECT().PreviewRecurrence(rotate, 3).Return([]time.Time{monday}, nil)
+
+	val, err := h.PreviewRecurrence(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{monday}, val)
+
+	ctx.Request = recurrenceRequest(http.MethodPost, "/recurrence/preview", "", `[1]`, nil)
+
+	_, err = h.PreviewRecurrence(ctx)
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}, err)
+}
+
+func Test_Recur(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTaskServiceInterface(ctrl)
+	svc := NewHandler(mock)
+
+	mock.EXPECT().Recur(ctx).Return(2, nil)
+	mock.EXPECT().Recur(ctx).Return(0, errors.New("db down"))
+
+	job := svc.Recur()
+
+	job(ctx)
+	job(ctx)
+}
@@ -0,0 +1,125 @@
+package template
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"strconv"
+)
+
+type handler struct {
+	svc TemplateServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s TemplateServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Create adds the template in the body, along with its tasks.
+func (h *handler) Create(c *gofr.Context) (any, error) {
+	var t template.Template
+
+	if err := c.Bind(&t); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	t, err := h.svc.Create(c, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(t), nil
+}
+
+func (h *handler) Get(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	t, err := h.svc.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return withETag(t), nil
+}
+
+// All returns every template of the workspace.
+func (h *handler) All(c *gofr.Context) (any, error) {
+	return h.svc.All(c)
+}
+
+// Update replaces the name and tasks of the template with the body, if If-Match holds its current ETag.
+func (h *handler) Update(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var t template.Template
+
+	if err := c.Bind(&t); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	t, err = h.svc.Update(c, id, ver, t)
+	if err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return withETag(t), nil
+}
+
+// Delete removes the template, if If-Match holds its current ETag. The tasks created from it are left as they are.
+func (h *handler) Delete(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.Delete(c, id, ver); err != nil {
+		return nil, middleware.Precondition(c, err)
+	}
+
+	return template.Template{}, nil
+}
+
+// Instantiate creates the tasks of the template for the user in the body, all or none of them, and returns them.
+func (h *handler) Instantiate(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var in template.Instantiation
+
+	if err := c.Bind(&in); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	if in.Userid == 0 {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"userid"}}
+	}
+
+	return h.svc.Instantiate(c, id, in)
+}
+
+// withETag returns the template along with its ETag header.
+func withETag(t template.Template) response.Response {
+	return response.Response{Data: t, Headers: map[string]string{"ETag": version.ETag(t.Version)}}
+}
@@ -0,0 +1,283 @@
+package template
+
+import (
+	"bytes"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
+func request(method, target, ifMatch, body string, vars map[string]string) *gofrHttp.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	req = mux.SetURLVars(ifMatched(req), vars)
+
+	return gofrHttp.NewRequest(req)
+}
+
+var (
+	onboarding = `{"name":"Onboarding","tasks":[{"desc":"Set up a laptop for {{user.name}}"}]}`
+	items      = []template.Item{{Desc: "Set up a laptop for {{user.name}}"}}
+)
+
+func Test_Create(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	created := template.Template{ID: 3, Name: "Onboarding", Tasks: items, Version: 1}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", onboarding, true, nil, withETag(created), nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Not allowed", onboarding, true, errs.Forbidden{Permission: "template:manage"}, nil,
+			errs.Forbidden{Permission: "template:manage"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTemplateServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, "/template", "", tt.body, nil)
+
+			if tt.ifMock {
+				mock.EXPECT().Create(gomock.Any(), template.Template{Name: "Onboarding", Tasks: items}).Return(created, tt.svcErr)
+			}
+
+			val, err := h.Create(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tpl := template.Template{ID: 3, Name: "Onboarding", Version: 2}
+
+	tests := []struct {
+		name   string
+		id     string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", "3", true, nil, withETag(tpl), nil},
+		{"Invalid id", "abc", false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Not found", "3", true, errs.NotFound{Entity: "template", ID: 3}, nil, errs.NotFound{Entity: "template", ID: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTemplateServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodGet, "/template/"+tt.id, "", "", map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Get(gomock.Any(), 3).Return(tpl, tt.svcErr)
+			}
+
+			val, err := h.Get(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_All(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockTemplateServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	templates := []template.Template{{ID: 3, Name: "Onboarding", Version: 1}}
+
+	ctx.Request = request(http.MethodGet, "/template", "", "", nil)
+	mock.EXPECT().All(gomock.Any()).Return(templates, nil)
+
+	val, err := h.All(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, templates, val)
+}
+
+func Test_Update(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	updated := template.Template{ID: 3, Name: "Onboarding", Tasks: items, Version: 3}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", "3", `"2"`, onboarding, true, nil, withETag(updated), nil},
+		{"Invalid id", "abc", `"2"`, `{}`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Missing If-Match", "3", "", `{}`, false, nil, nil, version.ErrPreconditionRequired{}},
+		{"Binding Error", "3", `"2"`, `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Stale version", "3", `"2"`, onboarding, true, version.ErrMismatch, nil, version.ErrPreconditionFailed{IfMatch: `"2"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTemplateServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPut, "/template/"+tt.id, tt.ifMatch, tt.body, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Update(gomock.Any(), 3, 2, template.Template{Name: "Onboarding", Tasks: items}).Return(updated, tt.svcErr)
+			}
+
+			val, err := h.Update(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		ver     int
+		svcErr  error
+		expRes  any
+		expErr  error
+	}{
+		{"Success", `"2"`, 2, nil, template.Template{}, nil},
+		{"Any version", "*", version.Any, nil, template.Template{}, nil},
+		{"Not found", "*", version.Any, errs.NotFound{Entity: "template", ID: 3}, nil, errs.NotFound{Entity: "template", ID: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTemplateServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodDelete, "/template/3", tt.ifMatch, "", map[string]string{"id": "3"})
+
+			mock.EXPECT().Delete(gomock.Any(), 3, tt.ver).Return(tt.svcErr)
+
+			val, err := h.Delete(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, val)
+		})
+	}
+}
+
+func Test_Instantiate(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	project := 4
+	created := []task.Task{{ID: 7, Desc: "Set up a laptop for Alice", Userid: 10, Version: 1}}
+
+	tests := []struct {
+		name   string
+		id     string
+		body   string
+		ifMock bool
+		svcErr error
+		expErr error
+	}{
+		{"Success", "3", `{"userid":10,"project_id":4}`, true, nil, nil},
+		{"Invalid id", "abc", `{"userid":10}`, false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		{"Binding Error", "3", `[1]`, false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Missing user", "3", `{"project_id":4}`, false, nil, gofrHttp.ErrorInvalidParam{Params: []string{"userid"}}},
+		{"Unknown user", "3", `{"userid":10,"project_id":4}`, true, errs.DependencyMissing{Entity: "user", ID: 10},
+			errs.DependencyMissing{Entity: "user", ID: 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockTemplateServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			ctx.Request = request(http.MethodPost, "/template/"+tt.id+"/instantiate", "", tt.body, map[string]string{"id": tt.id})
+
+			if tt.ifMock {
+				mock.EXPECT().Instantiate(gomock.Any(), 3, template.Instantiation{Userid: 10, ProjectID: &project}).
+					Return(created, tt.svcErr)
+			}
+
+			val, err := h.Instantiate(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+
+			if tt.expErr == nil {
+				assert.Equal(t, created, val)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package template
+
+import (
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"gofr.dev/pkg/gofr"
+)
+
+type TemplateServiceInterface interface {
+	Create(c *gofr.Context, t template.Template) (template.Template, error)
+	Get(c *gofr.Context, id int) (template.Template, error)
+	All(c *gofr.Context) ([]template.Template, error)
+	Update(c *gofr.Context, id, ver int, t template.Template) (template.Template, error)
+	Delete(c *gofr.Context, id, ver int) error
+	Instantiate(c *gofr.Context, id int, in template.Instantiation) ([]task.Task, error)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=template
+//
+
+// Package template is a generated GoMock package.
+package template
+
+import (
+	reflect "reflect"
+
+	task "github.com/MGajendra22/GoFr/model/task"
+	template "github.com/MGajendra22/GoFr/model/template"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockTemplateServiceInterface is a mock of TemplateServiceInterface interface.
+type MockTemplateServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTemplateServiceInterfaceMockRecorder is the mock recorder for MockTemplateServiceInterface.
+type MockTemplateServiceInterfaceMockRecorder struct {
+	mock *MockTemplateServiceInterface
+}
+
+// NewMockTemplateServiceInterface creates a new mock instance.
+func NewMockTemplateServiceInterface(ctrl *gomock.Controller) *MockTemplateServiceInterface {
+	mock := &MockTemplateServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTemplateServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateServiceInterface) EXPECT() *MockTemplateServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockTemplateServiceInterface) All(c *gofr.Context) ([]template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c)
+	ret0, _ := ret[0].([]template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockTemplateServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockTemplateServiceInterface)(nil).All), c)
+}
+
+// Create mocks base method.
+func (m *MockTemplateServiceInterface) Create(c *gofr.Context, t template.Template) (template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, t)
+	ret0, _ := ret[0].(template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTemplateServiceInterfaceMockRecorder) Create(c, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTemplateServiceInterface)(nil).Create), c, t)
+}
+
+// Delete mocks base method.
+func (m *MockTemplateServiceInterface) Delete(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTemplateServiceInterfaceMockRecorder) Delete(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateServiceInterface)(nil).Delete), c, id, ver)
+}
+
+// Get mocks base method.
+func (m *MockTemplateServiceInterface) Get(c *gofr.Context, id int) (template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTemplateServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTemplateServiceInterface)(nil).Get), c, id)
+}
+
+// Instantiate mocks base method.
+func (m *MockTemplateServiceInterface) Instantiate(c *gofr.Context, id int, in template.Instantiation) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Instantiate", c, id, in)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Instantiate indicates an expected call of Instantiate.
+func (mr *MockTemplateServiceInterfaceMockRecorder) Instantiate(c, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Instantiate", reflect.TypeOf((*MockTemplateServiceInterface)(nil).Instantiate), c, id, in)
+}
+
+// Update mocks base method.
+func (m *MockTemplateServiceInterface) Update(c *gofr.Context, id, ver int, t template.Template) (template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, id, ver, t)
+	ret0, _ := ret[0].(template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTemplateServiceInterfaceMockRecorder) Update(c, id, ver, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplateServiceInterface)(nil).Update), c, id, ver, t)
+}
@@ -2,9 +2,13 @@ package user
 
 import (
 	"fmt"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
 	"gofr.dev/pkg/gofr"
 	gofrHttp "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
 	"strconv"
 )
 
@@ -45,31 +49,133 @@ func (h *UserHandler) Get(c *gofr.Context) (any, error) {
 		return user.User{}, err
 	}
 
-	return user1, nil
+	return withETag(user1), nil
 
 }
 
+// Update replaces the name and email of the user with the body, if If-Match holds its current ETag.
+func (h *UserHandler) Update(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	var u user.User
+
+	if err := c.Bind(&u); err != nil {
+		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"User"}}
+	}
+
+	user1, err := h.Service.Update(c, id, ver, u)
+	if err != nil {
+		return user.User{}, middleware.Precondition(c, err)
+	}
+
+	return withETag(user1), nil
+}
+
+// Patch applies the body to the user as a JSON merge patch, if If-Match holds its current ETag.
+func (h *UserHandler) Patch(c *gofr.Context) (any, error) {
+	id, err := strconv.Atoi(c.PathParam("id"))
+	if err != nil {
+		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}}
+	}
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	var p map[string]any
+
+	if err := c.Bind(&p); err != nil {
+		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"User"}}
+	}
+
+	user1, err := h.Service.Patch(c, id, ver, p)
+	if err != nil {
+		return user.User{}, middleware.Precondition(c, err)
+	}
+
+	return withETag(user1), nil
+}
+
+// Delete removes the user, if If-Match holds its current ETag. The tasks query parameter picks what
+// happens to the user's tasks and recurrences: reject (default), cascade or reassign to the user in reassign_to.
 func (h *UserHandler) Delete(c *gofr.Context) (any, error) {
 	id, err := strconv.Atoi(c.PathParam("id"))
 	if err != nil {
 		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}}
 	}
-	err = h.Service.Delete(c, id)
+
+	ver, err := middleware.ExpectedVersion(c)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	p, err := deletePolicy(c)
 	if err != nil {
 		return user.User{}, err
 	}
 
+	err = h.Service.Delete(c, id, ver, p)
+	if err != nil {
+		return user.User{}, middleware.Precondition(c, err)
+	}
+
 	return fmt.Sprintf("Successfully Deleted user with id %d", id), nil
 
 }
 
+func deletePolicy(c *gofr.Context) (user.DeletePolicy, error) {
+	p := user.DeletePolicy{Tasks: user.OnDelete(c.Param("tasks"))}
+
+	switch p.Tasks {
+	case "":
+		p.Tasks = user.OnDeleteReject
+	case user.OnDeleteReject, user.OnDeleteCascade:
+	case user.OnDeleteReassign:
+		to := c.Param("reassign_to")
+		if to == "" {
+			return p, gofrHttp.ErrorMissingParam{Params: []string{"reassign_to"}}
+		}
+
+		var err error
+
+		p.ReassignTo, err = strconv.Atoi(to)
+		if err != nil {
+			return p, gofrHttp.ErrorInvalidParam{Params: []string{"reassign_to"}}
+		}
+	default:
+		return p, gofrHttp.ErrorInvalidParam{Params: []string{"tasks"}}
+	}
+
+	return p, nil
+}
+
+// All returns a page of users, filtered by the q query parameter (text contained in the name or email)
+// and sorted by sort/order. Pages are selected with limit plus either offset or cursor.
 func (h *UserHandler) All(c *gofr.Context) (any, error) {
+	q, err := page.NewQuery(c.Param("limit"), c.Param("offset"), c.Param("cursor"), c.Param("sort"), c.Param("order"),
+		user.SortKeys...)
+	if err != nil {
+		return nil, err
+	}
 
-	users, err := h.Service.All(c)
+	users, err := h.Service.All(c, user.Filter{Text: c.Param("q")}, q)
 	if err != nil {
 		return nil, err
 	}
 
 	return users, nil
+}
 
+// withETag returns the user along with its ETag header.
+func withETag(u user.User) response.Response {
+	return response.Response{Data: u, Headers: map[string]string{"ETag": version.ETag(u.Version)}}
 }
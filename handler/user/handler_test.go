@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -17,6 +20,17 @@ import (
 	"testing"
 )
 
+// ifMatched runs the IfMatch middleware over req, as the app does before calling a handler
+func ifMatched(req *http.Request) *http.Request {
+	var out *http.Request
+
+	middleware.IfMatch()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return out
+}
+
 // Test_UserHandler : To test that interface is correctly implemented or not
 func Test_UserHandler(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -176,6 +190,10 @@ func Test_GetUseR(t *testing.T) {
 			val, err := svc.Get(ctx)
 			response := gofrResponse{val, err}
 
+			if tt.expectedResponse.err == nil {
+				assert.Equal(t, withETag(user.User{ID: 1}), response.result)
+			}
+
 			if tt.expectedResponse.err != nil {
 				assert.Error(t, response.err)
 				assert.Contains(t, response.err.Error(), tt.expectedResponse.err.Error())
@@ -204,19 +222,41 @@ func Test_DeleteUseR(t *testing.T) {
 		id               string
 		expectedResponse gofrResponse
 		ifMock           bool
+		query            string
+		policy           user.DeletePolicy
 	}{
 		{"Success delete", "1", gofrResponse{
 			result: fmt.Sprintf("Successfully Deleted user with id %d", 1),
 			err:    nil,
-		}, true},
+		}, true, "", user.DeletePolicy{Tasks: user.OnDeleteReject}},
 		{"InValid user id", "abc", gofrResponse{
 			result: user.User{},
 			err:    gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}},
-		}, false},
+		}, false, "", user.DeletePolicy{}},
 		{"Delete error", "99", gofrResponse{
 			result: nil,
 			err:    errors.New("simulated get user error"),
-		}, true},
+		}, true, "?tasks=cascade", user.DeletePolicy{Tasks: user.OnDeleteCascade}},
+		{"Stale version", "1", gofrResponse{
+			result: user.User{},
+			err:    version.ErrPreconditionFailed{IfMatch: `"2"`},
+		}, true, "", user.DeletePolicy{Tasks: user.OnDeleteReject}},
+		{"Reassign", "1", gofrResponse{
+			result: fmt.Sprintf("Successfully Deleted user with id %d", 1),
+			err:    nil,
+		}, true, "?tasks=reassign&reassign_to=4", user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 4}},
+		{"Reassign without target", "1", gofrResponse{
+			result: user.User{},
+			err:    gofrHttp.ErrorMissingParam{Params: []string{"reassign_to"}},
+		}, false, "?tasks=reassign", user.DeletePolicy{}},
+		{"Invalid reassign target", "1", gofrResponse{
+			result: user.User{},
+			err:    gofrHttp.ErrorInvalidParam{Params: []string{"reassign_to"}},
+		}, false, "?tasks=reassign&reassign_to=bob", user.DeletePolicy{}},
+		{"Unknown policy", "1", gofrResponse{
+			result: user.User{},
+			err:    gofrHttp.ErrorInvalidParam{Params: []string{"tasks"}},
+		}, false, "?tasks=orphan", user.DeletePolicy{}},
 	}
 
 	for _, tt := range tests {
@@ -226,13 +266,19 @@ func Test_DeleteUseR(t *testing.T) {
 			mock := NewMockUserServiceInterface(ctrl)
 			svc := NewUserHandler(mock)
 
-			req := httptest.NewRequest(http.MethodDelete, "/user/"+tt.id, nil)
-			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			req := httptest.NewRequest(http.MethodDelete, "/user/"+tt.id+tt.query, nil)
+			req.Header.Set("If-Match", `"2"`)
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 
 			if tt.ifMock {
-				mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.expectedResponse.err)
+				svcErr := tt.expectedResponse.err
+				if errors.As(svcErr, &version.ErrPreconditionFailed{}) {
+					svcErr = version.ErrMismatch
+				}
+
+				mock.EXPECT().Delete(gomock.Any(), gomock.Any(), 2, tt.policy).Return(svcErr)
 			}
 			val, err := svc.Delete(ctx)
 			response := gofrResponse{val, err}
@@ -264,12 +310,20 @@ func Test_GetAllUsers(t *testing.T) {
 
 	tests := []struct {
 		name             string
-		input            []user.User
+		query            string
+		filter           user.Filter
+		pageQuery        page.Query
 		expectedResponse gofrResponse
 		ifMock           bool
 	}{
-		{"Successfully Get", []user.User{{1, "John", "John@gmail.com"}, {2, "John", "John@gmail.com"}}, gofrResponse{result: []user.User{{1, "John", "John@gmail.com"}, {2, "John", "John@gmail.com"}}, err: nil}, true},
-		{"Unable to fetch user data", []user.User{{1, "John", "10"}}, gofrResponse{nil, errors.New("Failed to fetch user's data")}, true},
+		{"Successfully Get", "", user.Filter{}, page.Query{Limit: 20, Sort: "id", Order: "asc"},
+			gofrResponse{result: page.Page[user.User]{Items: []user.User{{ID: 1, Name: "John", Email: "John@gmail.com", Version: 1}, {ID: 2, Name: "John", Email: "John@gmail.com", Version: 1}}, Total: 2, Limit: 20}, err: nil}, true},
+		{"Search and sort", "?q=john&sort=email&order=desc&limit=1", user.Filter{Text: "john"}, page.Query{Limit: 1, Sort: "email", Order: "desc"},
+			gofrResponse{result: page.Page[user.User]{Items: []user.User{{ID: 1, Name: "John", Email: "John@gmail.com", Version: 1}}, Total: 2, Limit: 1, NextCursor: "abc"}, err: nil}, true},
+		{"Invalid sort", "?sort=password", user.Filter{}, page.Query{},
+			gofrResponse{nil, gofrHttp.ErrorInvalidParam{Params: []string{"sort"}}}, false},
+		{"Unable to fetch user data", "", user.Filter{}, page.Query{Limit: 20, Sort: "id", Order: "asc"},
+			gofrResponse{nil, errors.New("Failed to fetch user's data")}, true},
 	}
 
 	for _, tt := range tests {
@@ -279,12 +333,17 @@ func Test_GetAllUsers(t *testing.T) {
 			mock := NewMockUserServiceInterface(ctrl)
 			svc := NewUserHandler(mock)
 
-			req := httptest.NewRequest(http.MethodGet, "/user", nil)
+			req := httptest.NewRequest(http.MethodGet, "/user"+tt.query, nil)
 			request := gofrHttp.NewRequest(req)
 			ctx.Request = request
 
 			if tt.ifMock {
-				mock.EXPECT().All(gomock.Any()).Return(tt.expectedResponse.result, tt.expectedResponse.err)
+				var out page.Page[user.User]
+				if tt.expectedResponse.result != nil {
+					out = tt.expectedResponse.result.(page.Page[user.User])
+				}
+
+				mock.EXPECT().All(gomock.Any(), tt.filter, tt.pageQuery).Return(out, tt.expectedResponse.err)
 			}
 
 			val, err := svc.All(ctx)
@@ -295,8 +354,120 @@ func Test_GetAllUsers(t *testing.T) {
 				assert.Contains(t, response.err.Error(), tt.expectedResponse.err.Error())
 			} else {
 				assert.NoError(t, response.err)
+				assert.Equal(t, tt.expectedResponse.result, response.result)
+			}
+
+		})
+	}
+}
+
+// Test_UpdateUseR : Tests user is replaced with the body or not
+func Test_UpdateUseR(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   nil,
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		ifMatch string
+		body    string
+		ifMock  bool
+		result  any
+		svcErr  error
+		err     error
+	}{
+		{"Success Update", "1", `"1"`, `{"name":"John","email":"john@new.com"}`, true,
+			user.User{ID: 1, Name: "John", Email: "john@new.com", Version: 2}, nil, nil},
+		{"Invalid user id", "abc", `"1"`, `{}`, false, user.User{}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}}},
+		{"Missing If-Match", "1", "", `{"name":"John","email":"john@new.com"}`, false, user.User{}, nil,
+			version.ErrPreconditionRequired{}},
+		{"Binding Failure", "1", `"1"`, `2`, false, user.User{}, nil, gofrHttp.ErrorInvalidParam{Params: []string{"User"}}},
+		{"Update Failure", "1", `"1"`, `{"name":"John","email":"john@new.com"}`, true, user.User{},
+			errors.New("simulated update user error"), errors.New("simulated update user error")},
+		{"Stale version", "1", `"1"`, `{"name":"John","email":"john@new.com"}`, true, user.User{},
+			version.ErrMismatch, version.ErrPreconditionFailed{IfMatch: `"1"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := NewMockUserServiceInterface(ctrl)
+			svc := NewUserHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPut, "/user/"+tt.id, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", tt.ifMatch)
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				mockService.EXPECT().Update(gomock.Any(), 1, 1, user.User{Name: "John", Email: "john@new.com"}).
+					Return(tt.result, tt.svcErr)
+			}
+
+			val, err := svc.Update(ctx)
+
+			if tt.err == nil {
+				assert.Equal(t, withETag(tt.result.(user.User)), val)
+			} else {
+				assert.Equal(t, tt.result, val)
+			}
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+// Test_PatchUseR : Tests the body is applied to the user as a merge patch or not
+func Test_PatchUseR(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   nil,
+	}
+
+	tests := []struct {
+		name   string
+		id     string
+		body   string
+		ifMock bool
+		result any
+		err    error
+	}{
+		{"Success Patch", "1", `{"email":"john@new.com"}`, true, user.User{ID: 1, Name: "John", Email: "john@new.com", Version: 3}, nil},
+		{"Invalid user id", "abc", `{}`, false, user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"UserID"}}},
+		{"Binding Failure", "1", `[]`, false, user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"User"}}},
+		{"Patch Failure", "1", `{"email":"john@new.com"}`, true, user.User{}, errors.New("simulated patch user error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := NewMockUserServiceInterface(ctrl)
+			svc := NewUserHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPatch, "/user/"+tt.id, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", "*")
+			req = mux.SetURLVars(ifMatched(req), map[string]string{"id": tt.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				mockService.EXPECT().Patch(gomock.Any(), 1, version.Any, map[string]any{"email": "john@new.com"}).Return(tt.result, tt.err)
+			}
+
+			val, err := svc.Patch(ctx)
+
+			if tt.err == nil {
+				assert.Equal(t, withETag(tt.result.(user.User)), val)
+			} else {
+				assert.Equal(t, tt.result, val)
 			}
 
+			assert.Equal(t, tt.err, err)
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/user"
 	"gofr.dev/pkg/gofr"
 )
@@ -8,6 +9,8 @@ import (
 type UserServiceInterface interface {
 	Create(c *gofr.Context, u user.User) (user.User, error)
 	Get(c *gofr.Context, id int) (user.User, error)
-	Delete(c *gofr.Context, id int) error
-	All(c *gofr.Context) ([]user.User, error)
+	Update(c *gofr.Context, id, ver int, u user.User) (user.User, error)
+	Patch(c *gofr.Context, id, ver int, patch map[string]any) (user.User, error)
+	Delete(c *gofr.Context, id, ver int, p user.DeletePolicy) error
+	All(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error)
 }
@@ -12,6 +12,7 @@ package user
 import (
 	reflect "reflect"
 
+	page "github.com/MGajendra22/GoFr/model/page"
 	user "github.com/MGajendra22/GoFr/model/user"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
@@ -42,18 +43,18 @@ func (m *MockUserServiceInterface) EXPECT() *MockUserServiceInterfaceMockRecorde
 }
 
 // All mocks base method.
-func (m *MockUserServiceInterface) All(c *gofr.Context) ([]user.User, error) {
+func (m *MockUserServiceInterface) All(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "All", c)
-	ret0, _ := ret[0].([]user.User)
+	ret := m.ctrl.Call(m, "All", c, f, q)
+	ret0, _ := ret[0].(page.Page[user.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // All indicates an expected call of All.
-func (mr *MockUserServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+func (mr *MockUserServiceInterfaceMockRecorder) All(c, f, q any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockUserServiceInterface)(nil).All), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockUserServiceInterface)(nil).All), c, f, q)
 }
 
 // Create mocks base method.
@@ -72,17 +73,17 @@ func (mr *MockUserServiceInterfaceMockRecorder) Create(c, u any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockUserServiceInterface) Delete(c *gofr.Context, id int) error {
+func (m *MockUserServiceInterface) Delete(c *gofr.Context, id, ver int, p user.DeletePolicy) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret := m.ctrl.Call(m, "Delete", c, id, ver, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUserServiceInterfaceMockRecorder) Delete(c, id any) *gomock.Call {
+func (mr *MockUserServiceInterfaceMockRecorder) Delete(c, id, ver, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserServiceInterface)(nil).Delete), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserServiceInterface)(nil).Delete), c, id, ver, p)
 }
 
 // Get mocks base method.
@@ -99,3 +100,33 @@ func (mr *MockUserServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserServiceInterface)(nil).Get), c, id)
 }
+
+// Patch mocks base method.
+func (m *MockUserServiceInterface) Patch(c *gofr.Context, id, ver int, patch map[string]any) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", c, id, ver, patch)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockUserServiceInterfaceMockRecorder) Patch(c, id, ver, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockUserServiceInterface)(nil).Patch), c, id, ver, patch)
+}
+
+// Update mocks base method.
+func (m *MockUserServiceInterface) Update(c *gofr.Context, id, ver int, u user.User) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, id, ver, u)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceInterfaceMockRecorder) Update(c, id, ver, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserServiceInterface)(nil).Update), c, id, ver, u)
+}
@@ -0,0 +1,52 @@
+package workspace
+
+import (
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+type handler struct {
+	svc WorkspaceServiceInterface
+}
+
+// NewHandler : Factory function to implement and return behaviour
+func NewHandler(s WorkspaceServiceInterface) *handler {
+	return &handler{svc: s}
+}
+
+// Create adds the workspace in the body along with its first admin.
+func (h *handler) Create(c *gofr.Context) (any, error) {
+	var sg workspace.Signup
+
+	if err := c.Bind(&sg); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.svc.Create(c, sg)
+}
+
+// Current returns the workspace of the user making the request.
+func (h *handler) Current(c *gofr.Context) (any, error) {
+	return h.svc.Current(c)
+}
+
+// ForEach wraps cron jobs to run them once in every workspace, one workspace after the other and the jobs in order.
+func (h *handler) ForEach(jobs ...gofr.CronFunc) gofr.CronFunc {
+	return func(c *gofr.Context) {
+		all, err := h.svc.All(c)
+		if err != nil {
+			c.Errorf("listing workspaces: %v", err)
+
+			return
+		}
+
+		for _, w := range all {
+			wc := workspace.In(c, w.ID)
+
+			for _, job := range jobs {
+				job(wc)
+			}
+		}
+	}
+}
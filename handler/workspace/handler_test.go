@@ -0,0 +1,126 @@
+package workspace
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	acme := workspace.Signup{Workspace: workspace.Workspace{ID: 2, Name: "Acme"},
+		Admin: user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Role: user.RoleAdmin, Version: 1}}
+
+	tests := []struct {
+		name   string
+		body   string
+		ifMock bool
+		svcErr error
+		expRes any
+		expErr error
+	}{
+		{"Success", `{"name":"Acme","admin":{"name":"Alice","email":"alice@example.com","password":"correct horse"}}`, true,
+			nil, acme, nil},
+		{"Binding Error", `[1]`, false, nil, nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+		{"Invalid", `{"name":""}`, true, errs.Validation{Field: "name", Reason: "must not be empty"}, workspace.Signup{},
+			errs.Validation{Field: "name", Reason: "must not be empty"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockWorkspaceServiceInterface(ctrl)
+			h := NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/workspaces", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			if tt.ifMock {
+				res := acme
+				if tt.svcErr != nil {
+					res = workspace.Signup{}
+				}
+
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ *gofr.Context, sg workspace.Signup) (workspace.Signup, error) {
+						if tt.svcErr == nil {
+							// the admin is read from the body along with the workspace
+							assert.Equal(t, "correct horse", sg.Admin.Password)
+						}
+
+						return res, tt.svcErr
+					})
+			}
+
+			res, err := h.Create(ctx)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
+
+func Test_Current(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockWorkspaceServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	mock.EXPECT().Current(ctx).Return(workspace.Workspace{ID: 1, Name: "Default"}, nil)
+
+	res, err := h.Current(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, workspace.Workspace{ID: 1, Name: "Default"}, res)
+}
+
+func Test_ForEach(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	ctrl := gomock.NewController(t)
+	mock := NewMockWorkspaceServiceInterface(ctrl)
+	h := NewHandler(mock)
+
+	var ran []string
+
+	job := h.ForEach(func(c *gofr.Context) {
+		ran = append(ran, fmt.Sprintf("first in %d", workspace.ID(c)))
+	}, func(c *gofr.Context) {
+		ran = append(ran, fmt.Sprintf("second in %d", workspace.ID(c)))
+	})
+
+	mock.EXPECT().All(ctx).Return([]workspace.Workspace{{ID: 1}, {ID: 2}}, nil)
+
+	job(ctx)
+	assert.Equal(t, []string{"first in 1", "second in 1", "first in 2", "second in 2"}, ran)
+
+	// the job does not run when the workspaces cannot be listed
+	ran = nil
+
+	mock.EXPECT().All(ctx).Return(nil, errors.New("db down"))
+
+	job(ctx)
+	assert.Empty(t, ran)
+}
@@ -0,0 +1,12 @@
+package workspace
+
+import (
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+type WorkspaceServiceInterface interface {
+	Create(c *gofr.Context, sg workspace.Signup) (workspace.Signup, error)
+	Current(c *gofr.Context) (workspace.Workspace, error)
+	All(c *gofr.Context) ([]workspace.Workspace, error)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=workspace
+//
+
+// Package workspace is a generated GoMock package.
+package workspace
+
+import (
+	reflect "reflect"
+
+	workspace "github.com/MGajendra22/GoFr/model/workspace"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockWorkspaceServiceInterface is a mock of WorkspaceServiceInterface interface.
+type MockWorkspaceServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceServiceInterfaceMockRecorder is the mock recorder for MockWorkspaceServiceInterface.
+type MockWorkspaceServiceInterfaceMockRecorder struct {
+	mock *MockWorkspaceServiceInterface
+}
+
+// NewMockWorkspaceServiceInterface creates a new mock instance.
+func NewMockWorkspaceServiceInterface(ctrl *gomock.Controller) *MockWorkspaceServiceInterface {
+	mock := &MockWorkspaceServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceServiceInterface) EXPECT() *MockWorkspaceServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockWorkspaceServiceInterface) All(c *gofr.Context) ([]workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c)
+	ret0, _ := ret[0].([]workspace.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockWorkspaceServiceInterfaceMockRecorder) All(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockWorkspaceServiceInterface)(nil).All), c)
+}
+
+// Create mocks base method.
+func (m *MockWorkspaceServiceInterface) Create(c *gofr.Context, sg workspace.Signup) (workspace.Signup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, sg)
+	ret0, _ := ret[0].(workspace.Signup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkspaceServiceInterfaceMockRecorder) Create(c, sg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceServiceInterface)(nil).Create), c, sg)
+}
+
+// Current mocks base method.
+func (m *MockWorkspaceServiceInterface) Current(c *gofr.Context) (workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", c)
+	ret0, _ := ret[0].(workspace.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockWorkspaceServiceInterfaceMockRecorder) Current(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockWorkspaceServiceInterface)(nil).Current), c)
+}
@@ -0,0 +1,185 @@
+// Package sqlutil holds the helpers shared by the stores.
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"strings"
+)
+
+// VersionChecked turns a conditional write that matched no row into version.ErrMismatch
+func VersionChecked(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return version.ErrMismatch
+	}
+
+	return nil
+}
+
+// Where joins conditions into a WHERE clause, which is empty if there are none
+func Where(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Contains returns the LIKE pattern matching the values containing text, wildcards in text matching themselves
+func Contains(text string) string {
+	return "%" + likeEscaper.Replace(text) + "%"
+}
+
+// Keyset returns the ORDER BY expression sorting rows on col in the order of q, ties broken by id, along with conds
+// and args extended with the condition continuing right after the cursor of q, if it has one
+func Keyset(q page.Query, col string, conds []string, args []any) (string, []string, []any) {
+	dir, cmp := "ASC", ">"
+	if q.Desc() {
+		dir, cmp = "DESC", "<"
+	}
+
+	order := col + " " + dir
+	if col != "id" {
+		order += ", id " + dir
+	}
+
+	if q.Cursor == nil {
+		return order, conds, args
+	}
+
+	if col == "id" {
+		return order, append(conds, "id "+cmp+" ?"), append(args, q.Cursor.ID)
+	}
+
+	return order, append(conds, fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", col, cmp, col, cmp)),
+		append(args, q.Cursor.Value, q.Cursor.Value, q.Cursor.ID)
+}
+
+// NextPage cuts items, read one row past the page size of q to tell whether there is a next page, down to the page
+// and returns them with the cursor of the next page, which is empty if there is none. key gives the sort value and id
+// of an item
+func NextPage[T any](q page.Query, items []T, key func(T) (string, int)) ([]T, string) {
+	if len(items) <= q.Limit {
+		return items, ""
+	}
+
+	items = items[:q.Limit]
+
+	return items, q.NextCursor(key(items[q.Limit-1]))
+}
+
+// Runner runs queries on the database, or within one of its transactions
+type Runner interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+type txKey struct{}
+
+func current(c *gofr.Context) *gofrSQL.Tx {
+	if c.Context == nil {
+		return nil
+	}
+
+	tx, _ := c.Context.Value(txKey{}).(*gofrSQL.Tx)
+
+	return tx
+}
+
+// DB returns what the queries of c run on: the transaction c runs in if it was given by InTx, the database otherwise
+func DB(c *gofr.Context) Runner {
+	if tx := current(c); tx != nil {
+		return tx
+	}
+
+	return c.SQL
+}
+
+// InTx runs fn in one transaction, committed if fn returns nil and rolled back otherwise. fn is given a copy of c
+// running in the transaction, so that the stores it calls make their changes in it. If c already runs in one, fn
+// runs in that one
+func InTx(c *gofr.Context, fn func(c *gofr.Context) error) error {
+	if current(c) != nil {
+		return fn(c)
+	}
+
+	tx, err := c.SQL.Begin()
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cc := *c
+	cc.Context = context.WithValue(ctx, txKey{}, tx)
+
+	if err := fn(&cc); err != nil {
+		Rollback(c, &Tx{Tx: tx})
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Tx is a transaction begun by a store, or the one the context of the store runs in, which the store joins
+type Tx struct {
+	*gofrSQL.Tx
+	joined bool
+}
+
+// Begin begins a transaction, or joins the one c runs in. A joined transaction is committed or rolled back by the
+// InTx running it, not by the store
+func Begin(c *gofr.Context) (*Tx, error) {
+	if tx := current(c); tx != nil {
+		return &Tx{Tx: tx, joined: true}, nil
+	}
+
+	tx, err := c.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx}, nil
+}
+
+// Commit commits a transaction the store began
+func (t *Tx) Commit() error {
+	if t.joined {
+		return nil
+	}
+
+	return t.Tx.Commit()
+}
+
+// Rollback rolls back a transaction given up on, unless it was joined, leaving it to the InTx running it. Its error is
+// logged rather than returned, the caller returning the one it gave up on
+func Rollback(c *gofr.Context, tx *Tx) {
+	if tx.joined {
+		return
+	}
+
+	if err := tx.Tx.Rollback(); err != nil {
+		c.Errorf("rolling back transaction: %v", err)
+	}
+}
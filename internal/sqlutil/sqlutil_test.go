@@ -0,0 +1,113 @@
+package sqlutil
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"strconv"
+	"testing"
+)
+
+func Test_Keyset(t *testing.T) {
+	q, _ := page.NewQuery("2", "", "", "name", "desc", "id", "name")
+
+	order, conds, args := Keyset(q, "name", []string{"workspace_id = ?"}, []any{1})
+
+	assert.Equal(t, "name DESC, id DESC", order)
+	assert.Equal(t, []string{"workspace_id = ?"}, conds)
+	assert.Equal(t, []any{1}, args)
+
+	q.Cursor = &page.Cursor{Value: "Bob", ID: 7}
+
+	_, conds, args = Keyset(q, "name", []string{"workspace_id = ?"}, []any{1})
+
+	assert.Equal(t, []string{"workspace_id = ?", "(name < ? OR (name = ? AND id < ?))"}, conds)
+	assert.Equal(t, []any{1, "Bob", "Bob", 7}, args)
+
+	q.Order = page.OrderAsc
+
+	order, conds, args = Keyset(q, "id", nil, nil)
+
+	assert.Equal(t, "id ASC", order)
+	assert.Equal(t, []string{"id > ?"}, conds)
+	assert.Equal(t, []any{7}, args)
+}
+
+func Test_NextPage(t *testing.T) {
+	q := page.Query{Limit: 2, Sort: "id", Order: page.OrderAsc}
+	key := func(id int) (string, int) { return strconv.Itoa(id), id }
+
+	items, next := NextPage(q, []int{4, 5}, key)
+
+	assert.Equal(t, []int{4, 5}, items)
+	assert.Empty(t, next)
+
+	items, next = NextPage(q, []int{4, 5, 6}, key)
+
+	assert.Equal(t, []int{4, 5}, items)
+	assert.Equal(t, q.NextCursor("5", 5), next)
+}
+
+func Test_WhereAndContains(t *testing.T) {
+	assert.Equal(t, "", Where(nil))
+	assert.Equal(t, " WHERE a = ? AND b = ?", Where([]string{"a = ?", "b = ?"}))
+	assert.Equal(t, `%50\% off\_%`, Contains("50% off_"))
+}
+
+func Test_Rollback(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectRollback().WillReturnError(errors.New("connection lost"))
+
+	tx, err := Begin(ctx)
+	assert.NoError(t, err)
+
+	// the error is logged, the caller goes on returning its own
+	Rollback(ctx, tx)
+
+	assert.NoError(t, mock.SQL.ExpectationsWereMet())
+}
+
+func Test_InTx(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec("UPDATE a SET b = 1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("UPDATE a SET b = 2").WillReturnError(errors.New("db write failed"))
+	mock.SQL.ExpectRollback()
+
+	err := InTx(ctx, func(c *gofr.Context) error {
+		if _, err := DB(c).Exec("UPDATE a SET b = 1"); err != nil {
+			return err
+		}
+
+		// a store beginning its own transaction joins the one running, leaving it to InTx to end
+		tx, err := Begin(c)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec("UPDATE a SET b = 2"); err != nil {
+			Rollback(c, tx)
+
+			return err
+		}
+
+		return tx.Commit()
+	})
+
+	assert.EqualError(t, err, "db write failed")
+	assert.NoError(t, mock.SQL.ExpectationsWereMet())
+}
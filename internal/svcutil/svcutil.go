@@ -0,0 +1,23 @@
+// Package svcutil holds the helpers shared by the services.
+package svcutil
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"time"
+)
+
+// Now is the default clock of the services. Timestamps are kept to the second, as stored.
+func Now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// Missing reports an entity referenced by a request that does not exist as a missing dependency rather than as the
+// entity the request addresses.
+func Missing(err error, entity string, id int) error {
+	if errors.As(err, &errs.NotFound{}) {
+		return errs.DependencyMissing{Entity: entity, ID: id}
+	}
+
+	return err
+}
@@ -2,42 +2,255 @@ package main
 
 import (
 	"fmt"
+	"github.com/MGajendra22/GoFr/handler/apitoken"
+	"github.com/MGajendra22/GoFr/handler/attachment"
+	"github.com/MGajendra22/GoFr/handler/audit"
+	"github.com/MGajendra22/GoFr/handler/auth"
+	"github.com/MGajendra22/GoFr/handler/board"
+	"github.com/MGajendra22/GoFr/handler/comment"
+	"github.com/MGajendra22/GoFr/handler/project"
 	"github.com/MGajendra22/GoFr/handler/task"
+	"github.com/MGajendra22/GoFr/handler/template"
 	"github.com/MGajendra22/GoFr/handler/user"
+	"github.com/MGajendra22/GoFr/handler/workspace"
+	"github.com/MGajendra22/GoFr/middleware"
 	"github.com/MGajendra22/GoFr/migrations"
+	attachmentModel "github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/rbac"
+	"github.com/MGajendra22/GoFr/storage"
 
+	apiTokenServicePkg "github.com/MGajendra22/GoFr/service/apitoken"
+	attachmentServicePkg "github.com/MGajendra22/GoFr/service/attachment"
+	auditServicePkg "github.com/MGajendra22/GoFr/service/audit"
+	authServicePkg "github.com/MGajendra22/GoFr/service/auth"
+	boardServicePkg "github.com/MGajendra22/GoFr/service/board"
+	commentServicePkg "github.com/MGajendra22/GoFr/service/comment"
+	projectServicePkg "github.com/MGajendra22/GoFr/service/project"
 	taskServicePkg "github.com/MGajendra22/GoFr/service/task"
+	templateServicePkg "github.com/MGajendra22/GoFr/service/template"
 	userServicePkg "github.com/MGajendra22/GoFr/service/user"
+	workspaceServicePkg "github.com/MGajendra22/GoFr/service/workspace"
+	apiTokenStorePkg "github.com/MGajendra22/GoFr/store/apitoken"
+	attachmentStorePkg "github.com/MGajendra22/GoFr/store/attachment"
+	auditStorePkg "github.com/MGajendra22/GoFr/store/audit"
+	boardStorePkg "github.com/MGajendra22/GoFr/store/board"
+	commentStorePkg "github.com/MGajendra22/GoFr/store/comment"
+	projectStorePkg "github.com/MGajendra22/GoFr/store/project"
 	taskStorePkg "github.com/MGajendra22/GoFr/store/task"
+	templateStorePkg "github.com/MGajendra22/GoFr/store/template"
 	userStorePkg "github.com/MGajendra22/GoFr/store/user"
+	workspaceStorePkg "github.com/MGajendra22/GoFr/store/workspace"
 	"gofr.dev/pkg/gofr"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func main() {
+	app := gofr.New()
 
 	userStore := userStorePkg.NewUserStore()
-	userService := userServicePkg.NewUserService(userStore)
+	policy := rbac.NewPolicy(userStore)
+
+	auditStore := auditStorePkg.NewStore()
+	auditService := auditServicePkg.NewService(auditStore, auditServicePkg.WithPolicy(policy))
+	auditHandler := audit.NewHandler(auditService)
+
+	// the task store is made before the users, whose deletes hand their tasks over
+	workflow := taskServicePkg.DefaultWorkflow()
+
+	if spec := app.Config.Get("TASK_WORKFLOW"); spec != "" {
+		parsed, err := taskServicePkg.ParseWorkflow(spec)
+		if err != nil {
+			app.Logger().Fatalf("invalid TASK_WORKFLOW: %v", err)
+		}
+
+		workflow = parsed
+	}
+
+	taskStore := taskStorePkg.NewStore(workflow.Closed())
+
+	userService := userServicePkg.NewUserService(userStore, userServicePkg.WithAuditor(auditService),
+		userServicePkg.WithPolicy(policy), userServicePkg.WithTasks(taskStore))
 	userHandler := user.NewUserHandler(userService)
+
+	workspaceStore := workspaceStorePkg.NewStore()
+	workspaceService := workspaceServicePkg.NewService(workspaceStore, userService)
+	workspaceHandler := workspace.NewHandler(workspaceService)
+
+	secret := []byte(app.Config.Get("AUTH_JWT_SECRET"))
+	if len(secret) < 32 {
+		app.Logger().Fatalf("AUTH_JWT_SECRET must be set to at least 32 bytes")
+	}
+
+	accessTTL, err := time.ParseDuration(app.Config.GetOrDefault("AUTH_ACCESS_TTL", "15m"))
+	if err != nil {
+		app.Logger().Fatalf("invalid AUTH_ACCESS_TTL: %v", err)
+	}
+
+	refreshTTL, err := time.ParseDuration(app.Config.GetOrDefault("AUTH_REFRESH_TTL", "168h"))
+	if err != nil {
+		app.Logger().Fatalf("invalid AUTH_REFRESH_TTL: %v", err)
+	}
+
+	authService := authServicePkg.NewService(userService, secret, authServicePkg.WithTTL(accessTTL, refreshTTL))
+	authHandler := auth.NewHandler(authService)
+
+	apiTokenStore := apiTokenStorePkg.NewStore()
+	apiTokenService := apiTokenServicePkg.NewService(apiTokenStore, userService, apiTokenServicePkg.WithPolicy(policy))
+	apiTokenHandler := apitoken.NewHandler(apiTokenService)
+
 	// Init task dependencies
-	taskStore := taskStorePkg.NewStore()
-	taskService := taskServicePkg.NewService(taskStore, userService)
+	projectStore := projectStorePkg.NewStore(workflow.Closed())
+
+	maxBulk, err := strconv.Atoi(app.Config.GetOrDefault("TASK_BULK_MAX_SIZE", "100"))
+	if err != nil || maxBulk <= 0 {
+		app.Logger().Fatalf("invalid TASK_BULK_MAX_SIZE: %v", app.Config.Get("TASK_BULK_MAX_SIZE"))
+	}
+
+	taskService := taskServicePkg.NewService(taskStore, userService, taskServicePkg.WithWorkflow(workflow),
+		taskServicePkg.WithAuditor(auditService), taskServicePkg.WithPolicy(policy), taskServicePkg.WithProjects(projectStore),
+		taskServicePkg.WithMaxBulk(maxBulk))
 	taskHandler := task.NewHandler(taskService)
 
-	app := gofr.New()
+	projectService := projectServicePkg.NewService(projectStore, userService, taskService,
+		projectServicePkg.WithPolicy(policy))
+	projectHandler := project.NewHandler(projectService)
+
+	boardStore := boardStorePkg.NewStore()
+	boardService := boardServicePkg.NewService(boardStore, taskService, projectStore, boardServicePkg.WithPolicy(policy))
+	boardHandler := board.NewHandler(boardService)
+
+	templateStore := templateStorePkg.NewStore()
+	templateService := templateServicePkg.NewService(templateStore, taskService, userService,
+		templateServicePkg.WithPolicy(policy))
+	templateHandler := template.NewHandler(templateService)
+
+	commentStore := commentStorePkg.NewStore()
+	commentService := commentServicePkg.NewService(commentStore, taskService, userService,
+		commentServicePkg.WithPolicy(policy))
+	commentHandler := comment.NewHandler(commentService)
+
+	maxSize, err := strconv.ParseInt(app.Config.GetOrDefault("ATTACHMENT_MAX_SIZE", "10485760"), 10, 64)
+	if err != nil || maxSize <= 0 {
+		app.Logger().Fatalf("invalid ATTACHMENT_MAX_SIZE: %v", app.Config.Get("ATTACHMENT_MAX_SIZE"))
+	}
+
+	limits := attachmentModel.Limits{MaxSize: maxSize}
+
+	for _, ct := range strings.Split(app.Config.GetOrDefault("ATTACHMENT_CONTENT_TYPES", "image/*,text/plain,application/pdf,application/zip"), ",") {
+		if ct = strings.TrimSpace(ct); ct != "" {
+			limits.ContentTypes = append(limits.ContentTypes, ct)
+		}
+	}
+
+	attachmentStore := attachmentStorePkg.NewStore()
+	attachmentService := attachmentServicePkg.NewService(attachmentStore, taskService,
+		storage.NewLocal(app.Config.GetOrDefault("ATTACHMENT_DIR", "attachments")), limits,
+		attachmentServicePkg.WithPolicy(policy))
+	attachmentHandler := attachment.NewHandler(attachmentService)
 
 	app.Migrate(migrations.All())
 
+	// everything but logging in and creating a workspace needs an access token or an API token
+	app.UseMiddlewareWithContainer(middleware.Authenticate(secret, apiTokenService, middleware.PublicRoutes...))
+	app.UseMiddleware(middleware.IfMatch(), middleware.LimitUploads(maxSize))
+
+	retention, err := time.ParseDuration(app.Config.GetOrDefault("TASK_TRASH_RETENTION", "720h"))
+	if err != nil {
+		app.Logger().Fatalf("invalid TASK_TRASH_RETENTION: %v", err)
+	}
+
+	app.AddCronJob(app.Config.GetOrDefault("TASK_TRASH_PURGE_SCHEDULE", "0 3 * * *"), "purge-trashed-tasks",
+		workspaceHandler.ForEach(attachmentHandler.PurgeTrash(retention), taskHandler.PurgeTrash(retention)))
+	app.AddCronJob(app.Config.GetOrDefault("TASK_REMINDER_SCHEDULE", "*/5 * * * *"), "task-reminders",
+		workspaceHandler.ForEach(taskHandler.Remind()))
+	app.AddCronJob(app.Config.GetOrDefault("TASK_RECURRENCE_SCHEDULE", "* * * * *"), "recurring-tasks",
+		workspaceHandler.ForEach(taskHandler.Recur()))
+
+	app.POST("/auth/login", authHandler.Login)
+	app.POST("/auth/refresh", authHandler.Refresh)
+	app.POST("/auth/logout", authHandler.Logout)
+
+	app.POST("/workspaces", workspaceHandler.Create)
+	app.GET("/workspaces/current", workspaceHandler.Current)
+
 	app.POST("/task", taskHandler.Create)
+	app.GET("/task/trash", taskHandler.Trash)
+	app.POST("/task/bulk", taskHandler.Bulk)
 	app.GET("/task/{id}", taskHandler.GetTask)
 	app.GET("/task", taskHandler.All)
-	app.PUT("/task/{id}", taskHandler.Complete)
+	app.PUT("/task/{id}", taskHandler.Update)
+	app.PATCH("/task/{id}", taskHandler.Patch)
+	app.POST("/task/{id}/transition", taskHandler.Transition)
 	app.DELETE("/task/{id}", taskHandler.Delete)
-	app.GET("task/user/{id}", taskHandler.GetTasksByUserID)
+	app.POST("/task/{id}/restore", taskHandler.Restore)
+	app.GET("/task/{id}/tags", taskHandler.Tags)
+	app.PUT("/task/{id}/tags/{tag}", taskHandler.AttachTag)
+	app.DELETE("/task/{id}/tags/{tag}", taskHandler.DetachTag)
+	app.GET("/task/{id}/children", taskHandler.Children)
+	app.GET("/task/{id}/subtree", taskHandler.Subtree)
+	app.GET("/task/{id}/blockers", taskHandler.Blockers)
+	app.PUT("/task/{id}/blockers/{blocker}", taskHandler.AddBlocker)
+	app.DELETE("/task/{id}/blockers/{blocker}", taskHandler.RemoveBlocker)
+	app.GET("/task/{id}/critical-path", taskHandler.CriticalPath)
+	app.GET("/task/{id}/history", taskHandler.History)
+	app.GET("/tags", taskHandler.AllTags)
+	app.POST("/task/{id}/comments", commentHandler.Create)
+	app.GET("/task/{id}/comments", commentHandler.List)
+	app.PUT("/task/{id}/comments/{comment}", commentHandler.Update)
+	app.DELETE("/task/{id}/comments/{comment}", commentHandler.Delete)
+	app.POST("/task/{id}/attachments", attachmentHandler.Upload)
+	app.GET("/task/{id}/attachments", attachmentHandler.List)
+	app.GET("/task/{id}/attachments/{attachment}", attachmentHandler.Download)
+	app.DELETE("/task/{id}/attachments/{attachment}", attachmentHandler.Delete)
+	app.GET("/task/user/{id}", taskHandler.GetTasksByUserID)
+	app.GET("/task/user/{id}/next", taskHandler.Next)
+	app.POST("/task/{id}/move", boardHandler.Move)
+
+	app.POST("/project", projectHandler.Create)
+	app.GET("/project", projectHandler.All)
+	app.GET("/project/{id}", projectHandler.Get)
+	app.PUT("/project/{id}", projectHandler.Update)
+	app.DELETE("/project/{id}", projectHandler.Delete)
+	app.GET("/project/{id}/members", projectHandler.Members)
+	app.PUT("/project/{id}/members/{user}", projectHandler.AddMember)
+	app.DELETE("/project/{id}/members/{user}", projectHandler.RemoveMember)
+	app.GET("/project/{id}/tasks", projectHandler.Tasks)
+
+	app.POST("/board", boardHandler.Create)
+	app.GET("/board", boardHandler.All)
+	app.GET("/board/{id}", boardHandler.Get)
+	app.PUT("/board/{id}", boardHandler.Update)
+	app.DELETE("/board/{id}", boardHandler.Delete)
+	app.GET("/board/{id}/view", boardHandler.View)
+
+	app.POST("/recurrence", taskHandler.CreateRecurrence)
+	app.POST("/recurrence/preview", taskHandler.PreviewRecurrence)
+	app.GET("/recurrence", taskHandler.AllRecurrences)
+	app.GET("/recurrence/{id}", taskHandler.GetRecurrence)
+	app.PUT("/recurrence/{id}", taskHandler.UpdateRecurrence)
+	app.DELETE("/recurrence/{id}", taskHandler.DeleteRecurrence)
+	app.GET("/recurrence/{id}/occurrences", taskHandler.Occurrences)
+
+	app.POST("/template", templateHandler.Create)
+	app.GET("/template", templateHandler.All)
+	app.GET("/template/{id}", templateHandler.Get)
+	app.PUT("/template/{id}", templateHandler.Update)
+	app.DELETE("/template/{id}", templateHandler.Delete)
+	app.POST("/template/{id}/instantiate", templateHandler.Instantiate)
 
 	app.POST("/user", userHandler.Create)
 	app.GET("/user", userHandler.All)
 	app.GET("/user/{id}", userHandler.Get)
+	app.PUT("/user/{id}", userHandler.Update)
+	app.PATCH("/user/{id}", userHandler.Patch)
 	app.DELETE("/user/{id}", userHandler.Delete)
+	app.POST("/user/{id}/tokens", apiTokenHandler.Issue)
+	app.GET("/user/{id}/tokens", apiTokenHandler.List)
+	app.DELETE("/user/{id}/tokens/{token}", apiTokenHandler.Revoke)
+
+	app.GET("/audit", auditHandler.All)
 
 	fmt.Println("Server running at http://localhost:8000")
 	app.AddHTTPService("Task-Manager Http Service", "http://localhost:8000")
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/MGajendra22/GoFr/model/auth"
+	"gofr.dev/pkg/gofr"
+)
+
+// GetActor returns the id of the authenticated user making the request, or nil outside of a request or on a public
+// route, where services attribute the changes they make to nobody.
+func GetActor(c *gofr.Context) *int {
+	if c.Request == nil {
+		return nil
+	}
+
+	id, err := auth.UserID(c.GetAuthInfo().GetClaims())
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
@@ -0,0 +1,201 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/golang-jwt/jwt/v5"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrMiddleware "gofr.dev/pkg/gofr/http/middleware"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidWorkspace = errors.New("invalid " + workspace.Header + " header")
+
+// PublicRoutes are the routes open to anonymous clients: logging in, refreshing tokens and creating a workspace along
+// with its first admin. Users are added to a workspace by those allowed to, so POST /user needs a token.
+var PublicRoutes = []string{"POST /auth/login", "POST /auth/refresh", "POST /workspaces"}
+
+// TokenVerifier looks up the API token a request carries. It returns errs.Unauthorized for a token that is unknown,
+// expired or revoked.
+type TokenVerifier interface {
+	Verify(c *gofr.Context, token string) (apitoken.Token, error)
+}
+
+// Authenticate rejects requests that do not carry a valid access token signed with secret in an
+// "Authorization: Bearer" header, except requests to the public routes, given as "METHOD /path", and to the
+// /.well-known endpoints of gofr. The claims of the token are kept where gofr's own auth middleware keeps them, so
+// handlers read them with c.GetAuthInfo().GetClaims() and the id of the user with GetActor.
+//
+// A bearer token starting with apitoken.Prefix is an API token instead, checked by tokens and only let through to the
+// routes its scopes cover. It acts as its user, with claims made up to look like those of an access token. API tokens
+// are refused when tokens is nil. The middleware is registered with UseMiddlewareWithContainer, since tokens are
+// looked up in the database.
+//
+// Every request is put in a workspace, read by handlers with workspace.ID: the workspace of the user of its token, or
+// for public routes the one named by the workspace.Header, if any, and workspace.Default otherwise. A request naming a
+// workspace other than that of its token is forbidden.
+func Authenticate(secret []byte, tokens TokenVerifier, public ...string) func(*container.Container, http.Handler) http.Handler {
+	open := make(map[string]bool, len(public))
+
+	for _, route := range public {
+		open[route] = true
+	}
+
+	return func(ctr *container.Container, inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested, err := requestedWorkspace(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+
+				return
+			}
+
+			if open[r.Method+" "+r.URL.Path] || strings.HasPrefix(r.URL.Path, "/.well-known/") {
+				if requested == 0 {
+					requested = workspace.Default
+				}
+
+				inner.ServeHTTP(w, r.WithContext(workspace.WithID(r.Context(), requested)))
+
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				unauthorized(w, "missing bearer token")
+
+				return
+			}
+
+			var claims jwt.MapClaims
+
+			if _, ok := apitoken.ParsePrefix(token); ok && tokens != nil {
+				if claims, ok = verifyAPIToken(w, r, ctr, tokens, token); !ok {
+					return
+				}
+			} else if claims, err = auth.Verify(secret, token, auth.TypeAccess, time.Now()); err != nil {
+				unauthorized(w, "invalid or expired access token")
+
+				return
+			}
+
+			ws := auth.WorkspaceID(claims)
+			if requested != 0 && requested != ws {
+				writeError(w, http.StatusForbidden, "token is not valid in workspace "+strconv.Itoa(requested))
+
+				return
+			}
+
+			ctx := workspace.WithID(context.WithValue(r.Context(), gofrMiddleware.JWTClaim, claims), ws)
+
+			inner.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// verifyAPIToken checks an API token and its scopes against the request, and returns the claims standing for it. It
+// responds itself and returns false when the request may not go on.
+func verifyAPIToken(w http.ResponseWriter, r *http.Request, ctr *container.Container, tokens TokenVerifier,
+	token string) (jwt.MapClaims, bool) {
+	c := &gofr.Context{Context: r.Context(), Container: ctr}
+
+	t, err := tokens.Verify(c, token)
+
+	var unauth errs.Unauthorized
+
+	switch {
+	case errors.As(err, &unauth):
+		unauthorized(w, unauth.Error())
+
+		return nil, false
+	case err != nil:
+		c.Errorf("verifying api token: %v", err)
+		writeError(w, http.StatusInternalServerError, "could not verify api token")
+
+		return nil, false
+	}
+
+	scope, ok := requiredScope(r.Method, r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusForbidden, "api tokens cannot be used on this route")
+
+		return nil, false
+	}
+
+	if !t.Has(scope) {
+		writeError(w, http.StatusForbidden, "api token lacks scope "+string(scope))
+
+		return nil, false
+	}
+
+	return jwt.MapClaims{"sub": strconv.Itoa(t.UserID), auth.TypeClaim: auth.TypeAPIToken,
+		workspace.Claim: t.WorkspaceID}, true
+}
+
+// requestedWorkspace returns the id of the workspace named by the workspace.Header of a request, or 0 if it names
+// none.
+func requestedWorkspace(r *http.Request) (int, error) {
+	h := r.Header.Get(workspace.Header)
+	if h == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(h)
+	if err != nil || id <= 0 {
+		return 0, errInvalidWorkspace
+	}
+
+	return id, nil
+}
+
+// requiredScope returns the scope an API token needs for a request, or false for the routes API tokens cannot be
+// used on: managing API tokens, which needs the user to log in, and the audit log.
+func requiredScope(method, path string) (apitoken.Scope, bool) {
+	read := method == http.MethodGet || method == http.MethodHead
+
+	switch {
+	case path == "/task" || strings.HasPrefix(path, "/task/") || path == "/tags" ||
+		path == "/project" || strings.HasPrefix(path, "/project/") ||
+		path == "/board" || strings.HasPrefix(path, "/board/") ||
+		path == "/recurrence" || strings.HasPrefix(path, "/recurrence/") ||
+		path == "/template" || strings.HasPrefix(path, "/template/"):
+		if read {
+			return apitoken.ScopeTasksRead, true
+		}
+
+		return apitoken.ScopeTasksWrite, true
+	case strings.HasPrefix(path, "/user/") && strings.Contains(path, "/tokens"):
+		return "", false
+	case path == "/user" || strings.HasPrefix(path, "/user/"):
+		if read {
+			return apitoken.ScopeUsersRead, true
+		}
+
+		return apitoken.ScopeUsersAdmin, true
+	}
+
+	return "", false
+}
+
+// unauthorized responds 401, asking for a bearer token.
+func unauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeError(w, http.StatusUnauthorized, msg)
+}
+
+// writeError responds with an error body shaped like those gofr writes for handler errors.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]string{"message": msg}})
+}
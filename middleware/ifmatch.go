@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+)
+
+type ctxKey int
+
+const ifMatchKey ctxKey = iota
+
+// IfMatch copies the If-Match request header into the request context, where handlers read it
+// with GetIfMatch, since gofr handlers only see path and query parameters.
+func IfMatch() gofrHttp.Middleware {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if h := r.Header.Get("If-Match"); h != "" {
+				r = r.WithContext(context.WithValue(r.Context(), ifMatchKey, h))
+			}
+
+			inner.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetIfMatch returns the If-Match header of the request, or an empty string if it was not sent.
+func GetIfMatch(c *gofr.Context) string {
+	h, _ := c.Request.Context().Value(ifMatchKey).(string)
+
+	return h
+}
+
+// ExpectedVersion parses the If-Match header of the request into the version the client expects to change.
+func ExpectedVersion(c *gofr.Context) (int, error) {
+	return version.FromIfMatch(GetIfMatch(c))
+}
+
+// Precondition reports a version.ErrMismatch from a conditional write as a failed If-Match precondition.
+func Precondition(c *gofr.Context, err error) error {
+	if errors.Is(err, version.ErrMismatch) {
+		return version.ErrPreconditionFailed{IfMatch: GetIfMatch(c)}
+	}
+
+	return err
+}
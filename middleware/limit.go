@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"mime"
+	"net/http"
+)
+
+// multipartOverhead is how much larger than the file it carries a multipart body may be, for the boundaries and
+// headers of its parts.
+const multipartOverhead = 64 << 10
+
+// LimitUploads refuses multipart bodies too large for a file of at most maxSize bytes before gofr parses them, which
+// would otherwise spill the whole body to disk before handlers get to check the size of the file. A body announced as
+// too large is refused with 413 right away, and reading one of unknown length fails with an *http.MaxBytesError
+// once past the limit.
+func LimitUploads(maxSize int64) gofrHttp.Middleware {
+	limit := maxSize + multipartOverhead
+
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt == "multipart/form-data" {
+				if r.ContentLength > limit {
+					writeError(w, http.StatusRequestEntityTooLarge, "request body is too large")
+
+					return
+				}
+
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+
+			inner.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// The boolean status column becomes a workflow state: true maps to done, false to todo.
+const convertTaskStatusColumnSQL = `
+ALTER TABLE tasks MODIFY COLUMN status VARCHAR(32) NOT NULL DEFAULT 'todo';`
+
+const convertTaskStatusValuesSQL = `
+UPDATE tasks SET status = CASE WHEN status = '1' THEN 'done' ELSE 'todo' END;`
+
+func convertTaskStatus() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(convertTaskStatusColumnSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(convertTaskStatusValuesSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
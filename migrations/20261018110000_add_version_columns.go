@@ -0,0 +1,28 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Every row starts at version 1; each update bumps it so concurrent writers can detect lost updates.
+const addTaskVersionSQL = `
+ALTER TABLE tasks ADD COLUMN version INT NOT NULL DEFAULT 1;`
+
+const addUserVersionSQL = `
+ALTER TABLE users ADD COLUMN version INT NOT NULL DEFAULT 1;`
+
+func addVersionColumns() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskVersionSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addUserVersionSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
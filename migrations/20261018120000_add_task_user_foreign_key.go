@@ -0,0 +1,38 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Tasks left behind by user deletions before this migration point at no user and would make the
+// foreign key fail, so they are removed first.
+const deleteOrphanTasksSQL = `
+DELETE FROM tasks WHERE userid NOT IN (SELECT id FROM users);`
+
+const addTaskUserIndexSQL = `
+CREATE INDEX idx_tasks_userid ON tasks (userid);`
+
+// ON DELETE RESTRICT: what happens to the tasks of a deleted user is decided by DELETE /user/{id}.
+const addTaskUserForeignKeySQL = `
+ALTER TABLE tasks ADD CONSTRAINT fk_tasks_userid FOREIGN KEY (userid) REFERENCES users (id) ON DELETE RESTRICT;`
+
+func addTaskUserForeignKey() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(deleteOrphanTasksSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addTaskUserIndexSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addTaskUserForeignKeySQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// A task with deleted_at set is in the trash until it is restored or purged.
+const addTaskDeletedAtSQL = `
+ALTER TABLE tasks ADD COLUMN deleted_at DATETIME NULL DEFAULT NULL;`
+
+// The purge job looks tasks up by how long they have been trashed.
+const addTaskDeletedAtIndexSQL = `
+CREATE INDEX idx_tasks_deleted_at ON tasks (deleted_at);`
+
+func addTaskDeletedAt() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskDeletedAtSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addTaskDeletedAtIndexSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
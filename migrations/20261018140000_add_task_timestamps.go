@@ -0,0 +1,33 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Timestamps are written by the application in UTC. Existing tasks get the time of the migration.
+const addTaskTimestampsSQL = `
+ALTER TABLE tasks
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN due_at DATETIME NULL DEFAULT NULL,
+    ADD COLUMN reminded_at DATETIME NULL DEFAULT NULL;`
+
+// The reminder job and the overdue filter look tasks up by due date.
+const addTaskDueAtIndexSQL = `
+CREATE INDEX idx_tasks_due_at ON tasks (due_at);`
+
+func addTaskTimestamps() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskTimestampsSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addTaskDueAtIndexSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Priorities are P0 (most urgent) to P3, so they sort in rank order. Existing tasks get the default, P2.
+const addTaskPrioritySQL = `
+ALTER TABLE tasks ADD COLUMN priority CHAR(2) NOT NULL DEFAULT 'P2';`
+
+// The per-user task list and the next task endpoint rank a user's tasks by priority and due date.
+const addTaskUserPriorityIndexSQL = `
+CREATE INDEX idx_tasks_userid_priority ON tasks (userid, priority, due_at);`
+
+func addTaskPriority() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskPrioritySQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addTaskUserPriorityIndexSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+const createTagTableSQL = `
+CREATE TABLE IF NOT EXISTS tags (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(64) NOT NULL UNIQUE
+);`
+
+// Tags go away with their task when it is purged from the trash.
+const createTaskTagTableSQL = `
+CREATE TABLE IF NOT EXISTS task_tags (
+    task_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (task_id, tag_id),
+    INDEX idx_task_tags_tag_id (tag_id),
+    CONSTRAINT fk_task_tags_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_tags_tag_id FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);`
+
+func createTagTables() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createTagTableSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(createTaskTagTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
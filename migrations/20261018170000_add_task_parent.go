@@ -0,0 +1,22 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Subtasks of a task purged from the trash become top-level tasks.
+const addTaskParentSQL = `
+ALTER TABLE tasks
+    ADD COLUMN parent_id INT NULL DEFAULT NULL,
+    ADD CONSTRAINT fk_tasks_parent_id FOREIGN KEY (parent_id) REFERENCES tasks (id) ON DELETE SET NULL;`
+
+func addTaskParent() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskParentSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+const addTaskEstimateSQL = `ALTER TABLE tasks ADD COLUMN estimate INT NULL DEFAULT NULL;`
+
+// task_id is blocked by blocker_id. Links go away with either task when it is purged from the trash.
+const createTaskDependencyTableSQL = `
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id INT NOT NULL,
+    blocker_id INT NOT NULL,
+    PRIMARY KEY (task_id, blocker_id),
+    INDEX idx_task_dependencies_blocker_id (blocker_id),
+    CONSTRAINT fk_task_dependencies_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_dependencies_blocker_id FOREIGN KEY (blocker_id) REFERENCES tasks (id) ON DELETE CASCADE
+);`
+
+func createTaskDependencies() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTaskEstimateSQL)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(createTaskDependencyTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Comments go away with their task when it is purged from the trash, and replies with the comment they answer.
+// Comments of a deleted user stay, without an author.
+const createCommentTableSQL = `
+CREATE TABLE IF NOT EXISTS comments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    task_id INT NOT NULL,
+    parent_id INT NULL DEFAULT NULL,
+    author_id INT NULL,
+    body TEXT NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    INDEX idx_comments_task_id (task_id, created_at),
+    CONSTRAINT fk_comments_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent_id FOREIGN KEY (parent_id) REFERENCES comments (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_author_id FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE SET NULL
+);`
+
+func createCommentTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createCommentTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Attachments go away with their task when it is purged from the trash.
+const createAttachmentTableSQL = `
+CREATE TABLE IF NOT EXISTS attachments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    task_id INT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL,
+    INDEX idx_attachments_task_id (task_id),
+    CONSTRAINT fk_attachments_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+);`
+
+func createAttachmentTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createAttachmentTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// The history outlives its task, so task_events has no foreign keys: purging a task or deleting the actor leaves
+// the events in place.
+const createTaskEventTableSQL = `
+CREATE TABLE IF NOT EXISTS task_events (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    task_id INT NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    actor_id INT NULL,
+    at DATETIME NOT NULL,
+    before_snapshot JSON NULL,
+    after_snapshot JSON NULL,
+    INDEX idx_task_events_task_id (task_id, id)
+);`
+
+// task_events is append-only: the triggers reject any change to a recorded event.
+const (
+	preventTaskEventUpdateSQL = `
+CREATE TRIGGER task_events_no_update BEFORE UPDATE ON task_events FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'task_events is append-only';`
+
+	preventTaskEventDeleteSQL = `
+CREATE TRIGGER task_events_no_delete BEFORE DELETE ON task_events FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'task_events is append-only';`
+)
+
+func createTaskEventTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createTaskEventTableSQL, preventTaskEventUpdateSQL, preventTaskEventDeleteSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
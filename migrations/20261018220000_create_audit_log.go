@@ -0,0 +1,59 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// audit_log entries are numbered from 1 without gaps and chained by hash. data is kept as text rather than JSON so
+// that it reads back byte for byte as it was hashed.
+const createAuditLogTableSQL = `
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INT PRIMARY KEY,
+    entity VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    actor_id INT NULL,
+    at DATETIME NOT NULL,
+    data MEDIUMTEXT NOT NULL,
+    prev_hash CHAR(64) NOT NULL UNIQUE,
+    hash CHAR(64) NOT NULL UNIQUE,
+    INDEX idx_audit_log_entity (entity, entity_id, id),
+    INDEX idx_audit_log_actor_id (actor_id, id)
+);`
+
+// audit_head holds the number and hash of the last entry. Appending locks its only row, which serializes writers
+// so that no two entries link to the same predecessor.
+const (
+	createAuditHeadTableSQL = `
+CREATE TABLE IF NOT EXISTS audit_head (
+    id TINYINT PRIMARY KEY,
+    seq INT NOT NULL,
+    hash CHAR(64) NOT NULL
+);`
+
+	seedAuditHeadSQL = `INSERT IGNORE INTO audit_head (id, seq, hash) VALUES (1, 0, REPEAT('0', 64));`
+)
+
+// audit_log is append-only: the triggers reject any change to a recorded entry.
+const (
+	preventAuditLogUpdateSQL = `
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only';`
+
+	preventAuditLogDeleteSQL = `
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only';`
+)
+
+func createAuditLog() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createAuditLogTableSQL, createAuditHeadTableSQL, seedAuditHeadSQL,
+				preventAuditLogUpdateSQL, preventAuditLogDeleteSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
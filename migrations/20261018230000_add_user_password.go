@@ -0,0 +1,18 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// password_hash is the bcrypt hash of the password of a user. Users created before passwords existed have none and
+// cannot log in until one is set.
+const addUserPasswordSQL = `
+ALTER TABLE users ADD COLUMN password_hash VARCHAR(60) NULL DEFAULT NULL;`
+
+func addUserPassword() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addUserPasswordSQL)
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Users are members unless made managers or admins.
+const addUserRoleSQL = `
+ALTER TABLE users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'member';`
+
+// The oldest existing user becomes admin, so that someone can still manage the others once roles are enforced.
+const promoteFirstUserSQL = `
+UPDATE users SET role = 'admin' ORDER BY id LIMIT 1;`
+
+func addUserRole() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{addUserRoleSQL, promoteFirstUserSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
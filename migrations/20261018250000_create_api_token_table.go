@@ -0,0 +1,33 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// API tokens are looked up by their prefix and go away with their user. Scopes are kept comma separated.
+const createAPITokenTableSQL = `
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(255) NOT NULL,
+    expires_at DATETIME NULL DEFAULT NULL,
+    last_used_at DATETIME NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL,
+    UNIQUE INDEX idx_api_tokens_prefix (prefix),
+    INDEX idx_api_tokens_user_id (user_id),
+    CONSTRAINT fk_api_tokens_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);`
+
+func createAPITokenTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createAPITokenTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Every user, task and API token belongs to a workspace. What exists already moves to the default workspace, with
+// id 1. API tokens keep the workspace of their user, since a token is looked up before the workspace of the request
+// is known.
+const createWorkspaceTableSQL = `
+CREATE TABLE IF NOT EXISTS workspaces (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at DATETIME NOT NULL
+);`
+
+const insertDefaultWorkspaceSQL = `
+INSERT INTO workspaces (id, name, created_at) VALUES (1, 'Default', UTC_TIMESTAMP());`
+
+// Emails are only unique within a workspace, as users log in to one workspace.
+const addUserWorkspaceSQL = `
+ALTER TABLE users ADD COLUMN workspace_id INT NOT NULL DEFAULT 1,
+    DROP INDEX email,
+    ADD UNIQUE INDEX idx_users_workspace_email (workspace_id, email),
+    ADD CONSTRAINT fk_users_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id);`
+
+const addTaskWorkspaceSQL = `
+ALTER TABLE tasks ADD COLUMN workspace_id INT NOT NULL DEFAULT 1,
+    ADD INDEX idx_tasks_workspace_id (workspace_id, deleted_at),
+    ADD CONSTRAINT fk_tasks_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id);`
+
+// The history of a task outlives it, so its events keep the workspace themselves, without a foreign key.
+const addTaskEventWorkspaceSQL = `
+ALTER TABLE task_events ADD COLUMN workspace_id INT NOT NULL DEFAULT 1,
+    DROP INDEX idx_task_events_task_id,
+    ADD INDEX idx_task_events_task_id (workspace_id, task_id, id);`
+
+const addAPITokenWorkspaceSQL = `
+ALTER TABLE api_tokens ADD COLUMN workspace_id INT NOT NULL DEFAULT 1,
+    ADD CONSTRAINT fk_api_tokens_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id);`
+
+func createWorkspaceTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createWorkspaceTableSQL, insertDefaultWorkspaceSQL, addUserWorkspaceSQL,
+				addTaskWorkspaceSQL, addTaskEventWorkspaceSQL, addAPITokenWorkspaceSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
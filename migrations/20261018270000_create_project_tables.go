@@ -0,0 +1,45 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+const createProjectTableSQL = `
+CREATE TABLE IF NOT EXISTS projects (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    workspace_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    INDEX idx_projects_workspace_id (workspace_id),
+    CONSTRAINT fk_projects_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id)
+);`
+
+// Members leave a project when they or the project are deleted.
+const createProjectMemberTableSQL = `
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id INT NOT NULL,
+    user_id INT NOT NULL,
+    PRIMARY KEY (project_id, user_id),
+    CONSTRAINT fk_project_members_project_id FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+    CONSTRAINT fk_project_members_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);`
+
+// Tasks are in no project until given one, and in none again once their project is deleted.
+const addTaskProjectSQL = `
+ALTER TABLE tasks ADD COLUMN project_id INT NULL,
+    ADD CONSTRAINT fk_tasks_project_id FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL;`
+
+func createProjectTables() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createProjectTableSQL, createProjectMemberTableSQL, addTaskProjectSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Boards of a project go with it.
+const createBoardTableSQL = `
+CREATE TABLE IF NOT EXISTS boards (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    workspace_id INT NOT NULL,
+    project_id INT NULL,
+    name VARCHAR(100) NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    INDEX idx_boards_workspace_id (workspace_id),
+    CONSTRAINT fk_boards_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id),
+    CONSTRAINT fk_boards_project_id FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);`
+
+const createBoardColumnTableSQL = `
+CREATE TABLE IF NOT EXISTS board_columns (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    board_id INT NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    UNIQUE KEY uq_board_columns_status (board_id, status),
+    CONSTRAINT fk_board_columns_board_id FOREIGN KEY (board_id) REFERENCES boards (id) ON DELETE CASCADE
+);`
+
+// Ranks are compared byte by byte, whatever the collation of the table.
+const addTaskRankSQL = `
+ALTER TABLE tasks ADD COLUMN board_rank VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '',
+    ADD INDEX idx_tasks_board_rank (workspace_id, status, board_rank);`
+
+// Existing tasks are ranked in id order, by their id in base 36 padded to six digits, trailing zeros excluded as in
+// every rank.
+const rankTasksSQL = `
+UPDATE tasks SET board_rank = TRIM(TRAILING '0' FROM LPAD(LOWER(CONV(id, 10, 36)), 6, '0'));`
+
+func createBoardTables() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createBoardTableSQL, createBoardColumnTableSQL, addTaskRankSQL, rankTasksSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
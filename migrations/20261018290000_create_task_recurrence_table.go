@@ -0,0 +1,48 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Recurrences keep their assignee as tasks do, and lose their project as tasks do. by_weekday holds the weekdays of
+// the rule comma separated. Purging the latest occurrence leaves the recurrence without one, which lets it create
+// the next.
+const createTaskRecurrenceTableSQL = `
+CREATE TABLE IF NOT EXISTS task_recurrences (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    workspace_id INT NOT NULL,
+    description TEXT NOT NULL,
+    userid INT NOT NULL,
+    priority VARCHAR(2) NOT NULL,
+    project_id INT NULL,
+    estimate INT NULL,
+    freq VARCHAR(10) NOT NULL,
+    repeat_interval INT NOT NULL DEFAULT 0,
+    by_weekday VARCHAR(20) NOT NULL DEFAULT '',
+    until_at DATETIME NULL,
+    max_count INT NOT NULL DEFAULT 0,
+    start_at DATETIME NOT NULL,
+    next_at DATETIME NULL,
+    last_at DATETIME NULL,
+    last_task_id INT NULL,
+    occurrences INT NOT NULL DEFAULT 0,
+    version INT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    INDEX idx_task_recurrences_next_at (workspace_id, next_at),
+    CONSTRAINT fk_task_recurrences_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id),
+    CONSTRAINT fk_task_recurrences_userid FOREIGN KEY (userid) REFERENCES users (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_task_recurrences_project_id FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL,
+    CONSTRAINT fk_task_recurrences_last_task_id FOREIGN KEY (last_task_id) REFERENCES tasks (id) ON DELETE SET NULL
+);`
+
+func createTaskRecurrenceTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createTaskRecurrenceTableSQL)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
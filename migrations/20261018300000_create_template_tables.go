@@ -0,0 +1,42 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+const createTemplateTableSQL = `
+CREATE TABLE IF NOT EXISTS templates (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    workspace_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    INDEX idx_templates_workspace_id (workspace_id),
+    CONSTRAINT fk_templates_workspace_id FOREIGN KEY (workspace_id) REFERENCES workspaces (id)
+);`
+
+// The tasks of a template go with it. Their descriptions are kept with their placeholders, filled in when the
+// template is instantiated.
+const createTemplateTaskTableSQL = `
+CREATE TABLE IF NOT EXISTS template_tasks (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    template_id INT NOT NULL,
+    position INT NOT NULL,
+    description TEXT NOT NULL,
+    priority VARCHAR(2) NOT NULL DEFAULT '',
+    estimate INT NULL,
+    CONSTRAINT fk_template_tasks_template_id FOREIGN KEY (template_id) REFERENCES templates (id) ON DELETE CASCADE
+);`
+
+func createTemplateTables() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{createTemplateTableSQL, createTemplateTaskTableSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
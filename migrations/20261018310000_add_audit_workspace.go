@@ -0,0 +1,39 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Audit entries belong to the workspace of the change they record, and are only read from it. Entries recorded
+// before are given the workspace of their user, or of the events of their task, which the update trigger is lifted
+// for; entries of users deleted since stay in the default workspace. The workspace is not hashed, so the chain of
+// entries already recorded still verifies.
+const (
+	addAuditLogWorkspaceSQL = `
+ALTER TABLE audit_log ADD COLUMN workspace_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD INDEX idx_audit_log_workspace_id (workspace_id, id);`
+
+	dropAuditLogUpdateTriggerSQL = `DROP TRIGGER IF EXISTS audit_log_no_update;`
+
+	backfillAuditLogUserWorkspaceSQL = `
+UPDATE audit_log a JOIN users u ON a.entity = 'user' AND u.id = a.entity_id
+SET a.workspace_id = u.workspace_id;`
+
+	backfillAuditLogTaskWorkspaceSQL = `
+UPDATE audit_log a JOIN (SELECT DISTINCT task_id, workspace_id FROM task_events) e
+    ON a.entity = 'task' AND e.task_id = a.entity_id
+SET a.workspace_id = e.workspace_id;`
+)
+
+func addAuditWorkspace() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{addAuditLogWorkspaceSQL, dropAuditLogUpdateTriggerSQL,
+				backfillAuditLogUserWorkspaceSQL, backfillAuditLogTaskWorkspaceSQL, preventAuditLogUpdateSQL} {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// Refresh tokens are kept by the id they carry, and are only accepted while kept. They go away with their user.
+// Refresh tokens issued before carry no id, so their users have to log in again.
+const createRefreshTokenTableSQL = `
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id CHAR(32) PRIMARY KEY,
+    workspace_id INT NOT NULL,
+    user_id INT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL,
+    INDEX idx_refresh_tokens_user_id (user_id),
+    CONSTRAINT fk_refresh_tokens_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);`
+
+func createRefreshTokenTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createRefreshTokenTableSQL)
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+// The tasks of a user deleted with tasks=cascade go to the trash without a user, where they wait for the purge job
+// like any trashed task. The foreign key still keeps users with tasks from being deleted otherwise.
+const allowTasksWithoutUserSQL = `
+ALTER TABLE tasks MODIFY userid INT NULL;`
+
+func allowTasksWithoutUser() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(allowTasksWithoutUserSQL)
+
+			return err
+		},
+	}
+}
@@ -8,5 +8,29 @@ import (
 func All() map[int64]migration.Migrate {
 	return map[int64]migration.Migrate{
 		20250701185018: createTaskTable(),
+		20261018100000: convertTaskStatus(),
+		20261018110000: addVersionColumns(),
+		20261018120000: addTaskUserForeignKey(),
+		20261018130000: addTaskDeletedAt(),
+		20261018140000: addTaskTimestamps(),
+		20261018150000: addTaskPriority(),
+		20261018160000: createTagTables(),
+		20261018170000: addTaskParent(),
+		20261018180000: createTaskDependencies(),
+		20261018190000: createCommentTable(),
+		20261018200000: createAttachmentTable(),
+		20261018210000: createTaskEventTable(),
+		20261018220000: createAuditLog(),
+		20261018230000: addUserPassword(),
+		20261018240000: addUserRole(),
+		20261018250000: createAPITokenTable(),
+		20261018260000: createWorkspaceTable(),
+		20261018270000: createProjectTables(),
+		20261018280000: createBoardTables(),
+		20261018290000: createTaskRecurrenceTable(),
+		20261018300000: createTemplateTables(),
+		20261018310000: addAuditWorkspace(),
+		20261018320000: createRefreshTokenTable(),
+		20261018330000: allowTasksWithoutUser(),
 	}
 }
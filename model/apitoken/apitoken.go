@@ -0,0 +1,126 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Scope limits the routes an API token can be used on. The user owning the token still needs the permissions of
+// their role, so a scope never grants more than the user could do by logging in.
+type Scope string
+
+const (
+	// ScopeTasksRead reads tasks, their tags, comments, attachments and history, the projects they belong to, the
+	// boards showing them and the recurrences and templates creating them.
+	ScopeTasksRead Scope = "tasks:read"
+	// ScopeTasksWrite changes tasks and everything under them, projects, boards, recurrences and templates.
+	ScopeTasksWrite Scope = "tasks:write"
+	// ScopeUsersRead reads users.
+	ScopeUsersRead Scope = "users:read"
+	// ScopeUsersAdmin changes and deletes users.
+	ScopeUsersAdmin Scope = "users:admin"
+)
+
+// Scopes are the scopes a token can be given.
+var Scopes = []Scope{ScopeTasksRead, ScopeTasksWrite, ScopeUsersRead, ScopeUsersAdmin}
+
+// Prefix starts every API token, telling it apart from the access tokens issued on login.
+const Prefix = "gft_"
+
+// MaxNameLength is the longest token name accepted, in bytes.
+const MaxNameLength = 100
+
+// Token is an API token of a user. The token itself is only known to its user: it is returned once, when issued,
+// and only its hash is kept. Its Prefix is kept in the clear to find it and to tell tokens apart in listings.
+type Token struct {
+	ID     int     `json:"id"`
+	UserID int     `json:"user_id"`
+	Name   string  `json:"name"`
+	Prefix string  `json:"prefix"`
+	Scopes []Scope `json:"scopes"`
+	// WorkspaceID is the workspace of the user, which requests made with the token run in.
+	WorkspaceID int `json:"-"`
+	// ExpiresAt is when the token stops working. A token without it works until revoked.
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Hash       string     `json:"-"`
+}
+
+// Issued is a token just issued, with the secret Token to send as "Authorization: Bearer <token>".
+type Issued struct {
+	Token
+	Secret string `json:"token"`
+}
+
+func (t *Token) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if len(t.Name) > MaxNameLength {
+		return errs.Validation{Field: "name", Reason: "must be at most 100 bytes long"}
+	}
+
+	if len(t.Scopes) == 0 {
+		return errs.Validation{Field: "scopes", Reason: "must not be empty"}
+	}
+
+	for _, s := range t.Scopes {
+		if !slices.Contains(Scopes, s) {
+			return errs.Validation{Field: "scopes", Reason: "must be among tasks:read, tasks:write, users:read, users:admin"}
+		}
+	}
+
+	return nil
+}
+
+// Has says whether the token was given scope s.
+func (t *Token) Has(s Scope) bool {
+	return slices.Contains(t.Scopes, s)
+}
+
+// Expired says whether the token has stopped working as of now.
+func (t *Token) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// prefixLength is the number of hex digits identifying a token after Prefix.
+const prefixLength = 12
+
+// Generate returns a new random token and its prefix. The token is the prefix, an underscore and 32 random bytes.
+func Generate() (prefix, token string, err error) {
+	buf := make([]byte, prefixLength/2+32)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+
+	prefix = Prefix + hex.EncodeToString(buf[:prefixLength/2])
+
+	return prefix, prefix + "_" + base64.RawURLEncoding.EncodeToString(buf[prefixLength/2:]), nil
+}
+
+// ParsePrefix returns the prefix of a token, or false if it is not shaped like an API token.
+func ParsePrefix(token string) (string, bool) {
+	n := len(Prefix) + prefixLength
+
+	if !strings.HasPrefix(token, Prefix) || len(token) <= n+1 || token[n] != '_' {
+		return "", false
+	}
+
+	return token[:n], true
+}
+
+// Hash is the digest of a token kept in place of the token. Tokens are long and random, so a fast hash is enough.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
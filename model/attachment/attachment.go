@@ -0,0 +1,43 @@
+package attachment
+
+import (
+	"strings"
+	"time"
+)
+
+// Attachment is the metadata of a file attached to a task. The content itself lives in the attachment storage
+// under StorageKey.
+type Attachment struct {
+	ID          int    `json:"id"`
+	TaskID      int    `json:"task_id"`
+	Filename    string `json:"filename"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+	// Checksum is the hex encoded SHA-256 of the content.
+	Checksum   string    `json:"checksum"`
+	StorageKey string    `json:"-"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Limits restrict the files that can be attached.
+type Limits struct {
+	// MaxSize is the largest file accepted, in bytes.
+	MaxSize int64
+	// ContentTypes are the media types accepted, such as "application/pdf", "image/*" or "*/*". Empty accepts any.
+	ContentTypes []string
+}
+
+// Allows reports whether files of the media type ct can be attached.
+func (l Limits) Allows(ct string) bool {
+	if len(l.ContentTypes) == 0 {
+		return true
+	}
+
+	for _, allowed := range l.ContentTypes {
+		if allowed == ct || allowed == "*/*" || strings.HasSuffix(allowed, "/*") && strings.HasPrefix(ct, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Entities whose changes are audited.
+const (
+	EntityUser = "user"
+	EntityTask = "task"
+)
+
+// Actions on users. Changes to tasks are recorded with the task.EventType of the change.
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// Genesis is the PrevHash of the first entry of the log.
+var Genesis = strings.Repeat("0", sha256.Size*2)
+
+// Entry is a link of the audit log. Its Hash covers all of its other fields, PrevHash included, so altering,
+// removing or reordering an entry breaks the chain from there on.
+type Entry struct {
+	ID       int    `json:"id"`
+	Entity   string `json:"entity"`
+	EntityID int    `json:"entity_id"`
+	Action   string `json:"action"`
+	// ActorID is the user who made the change, or null for changes made by the system or by anonymous clients.
+	ActorID *int      `json:"actor_id"`
+	At      time.Time `json:"at"`
+	// Data is the JSON encoded detail of the change, exactly as hashed.
+	Data     json.RawMessage `json:"data"`
+	PrevHash string          `json:"prev_hash"`
+	Hash     string          `json:"hash"`
+}
+
+// ComputeHash returns the hex encoded SHA-256 of the entry, Hash aside.
+func (e Entry) ComputeHash() string {
+	// a struct marshals its fields in a fixed order, which keeps the hashed form stable
+	b, _ := json.Marshal(struct {
+		ID       int
+		Entity   string
+		EntityID int
+		Action   string
+		ActorID  *int
+		At       string
+		Data     string
+		PrevHash string
+	}{e.ID, e.Entity, e.EntityID, e.Action, e.ActorID, e.At.UTC().Format(time.RFC3339Nano), string(e.Data), e.PrevHash})
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Change is the data of an entry: the entity on either side of the change and any detail of how it was made.
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+	Detail any `json:"detail,omitempty"`
+}
+
+// Filter narrows the entries returned by the audit query API. Zero values do not filter.
+type Filter struct {
+	Entity   string
+	EntityID int
+	ActorID  int
+	Action   string
+}
+
+// Verification is the outcome of walking the audit log.
+type Verification struct {
+	// Entries is the number of entries checked, up to and including the first broken one.
+	Entries int  `json:"entries"`
+	Valid   bool `json:"valid"`
+	// BrokenAt is the id of the first entry that does not link to the one before it, or does not match its hash.
+	BrokenAt *int   `json:"broken_at,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
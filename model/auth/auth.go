@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/golang-jwt/jwt/v5"
+	"strconv"
+	"time"
+)
+
+// Token types, kept in the TypeClaim of a token so that a refresh token cannot be used as an access token and the
+// other way round. TypeAPIToken marks the claims the Authenticate middleware makes up for a request carrying an API
+// token, which is not a JWT.
+const (
+	TypeAccess   = "access"
+	TypeRefresh  = "refresh"
+	TypeAPIToken = "api_token"
+)
+
+// TypeClaim is the claim holding the type of a token. The subject of a token is the id of its user.
+const TypeClaim = "type"
+
+// IDClaim is the claim holding the id of a refresh token, by which the record kept of it is found.
+const IDClaim = "jti"
+
+// Credentials are the body of POST /auth/login.
+type Credentials struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// RefreshRequest is the body of POST /auth/refresh.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Tokens is a pair of signed tokens for a user. The access token is sent as "Authorization: Bearer <token>" and
+// expires after ExpiresIn seconds; the longer lived refresh token is exchanged for a new pair.
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// RefreshToken is the record kept of a refresh token issued to a user. A refresh token is only accepted while its
+// record exists: exchanging it, logging out or changing the password deletes it.
+type RefreshToken struct {
+	ID        string
+	UserID    int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenType    = errors.New("wrong token type")
+)
+
+// Issue signs a token of type typ for the user with the given id in the given workspace, valid for ttl from now. The
+// token carries id unless it is empty, as access tokens have none.
+func Issue(secret []byte, userID, workspaceID int, typ, id string, now time.Time, ttl time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"sub":           strconv.Itoa(userID),
+		"iat":           now.Unix(),
+		"exp":           now.Add(ttl).Unix(),
+		TypeClaim:       typ,
+		workspace.Claim: workspaceID,
+	}
+
+	if id != "" {
+		claims[IDClaim] = id
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
+
+// Verify checks the signature, expiry and type of a token as of now and returns its claims.
+func Verify(secret []byte, token, typ string, now time.Time) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if claims[TypeClaim] != typ {
+		return nil, ErrTokenType
+	}
+
+	if _, err := UserID(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// UserID returns the id of the user a token was issued to.
+func UserID(claims jwt.MapClaims) (int, error) {
+	sub, err := claims.GetSubject()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	id, err := strconv.Atoi(sub)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: subject %q is not a user id", ErrInvalidToken, sub)
+	}
+
+	return id, nil
+}
+
+// TokenID returns the id of a token, empty for tokens that have none.
+func TokenID(claims jwt.MapClaims) string {
+	id, _ := claims[IDClaim].(string)
+
+	return id
+}
+
+// WorkspaceID returns the id of the workspace of the user a token was issued to. Tokens issued before workspaces
+// existed carry none, and belong to the default workspace.
+func WorkspaceID(claims jwt.MapClaims) int {
+	switch id := claims[workspace.Claim].(type) {
+	case float64:
+		return int(id)
+	case int:
+		return id
+	default:
+		return workspace.Default
+	}
+}
@@ -0,0 +1,98 @@
+package board
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"strings"
+	"time"
+)
+
+// MaxNameLength is the longest board or column name accepted, in bytes.
+const MaxNameLength = 100
+
+// MaxColumns is the number of columns a board may have.
+const MaxColumns = 20
+
+// MaxColumnTasks is the number of tasks a board view shows in each column.
+const MaxColumnTasks = 100
+
+// Board is a kanban view of the tasks of a workspace, or of one of its projects. Each column shows the tasks with
+// one status, in the order given by their rank.
+type Board struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// ProjectID restricts the board to the tasks of a project.
+	ProjectID *int     `json:"project_id,omitempty"`
+	Columns   []Column `json:"columns"`
+	Version   int      `json:"version"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Column is a column of a board, showing the tasks with Status.
+type Column struct {
+	ID     int         `json:"id"`
+	Name   string      `json:"name"`
+	Status task.Status `json:"status"`
+}
+
+func (b *Board) Validate() error {
+	if strings.TrimSpace(b.Name) == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if len(b.Name) > MaxNameLength {
+		return errs.Validation{Field: "name", Reason: "must be at most 100 bytes long"}
+	}
+
+	if len(b.Columns) == 0 || len(b.Columns) > MaxColumns {
+		return errs.Validation{Field: "columns", Reason: "must have between 1 and 20 columns"}
+	}
+
+	seen := make(map[task.Status]bool)
+
+	for i, col := range b.Columns {
+		field := fmt.Sprintf("columns[%d]", i)
+
+		if strings.TrimSpace(col.Name) == "" || len(col.Name) > MaxNameLength {
+			return errs.Validation{Field: field + ".name", Reason: "must be between 1 and 100 bytes long"}
+		}
+
+		if col.Status == "" {
+			return errs.Validation{Field: field + ".status", Reason: "must not be empty"}
+		}
+
+		if seen[col.Status] {
+			return errs.Validation{Field: field + ".status", Reason: "is already shown by another column"}
+		}
+
+		seen[col.Status] = true
+	}
+
+	return nil
+}
+
+// Lane is a column of a board along with its tasks, in rank order.
+type Lane struct {
+	Column
+	Tasks []task.Task `json:"tasks"`
+	// More is set when the column holds more than MaxColumnTasks tasks, only the first of which are shown.
+	More bool `json:"more"`
+}
+
+// View is a board along with the tasks of its columns.
+type View struct {
+	Board   Board  `json:"board"`
+	Columns []Lane `json:"columns"`
+}
+
+// Move is the request body for moving a task to a column of a board, between two of the tasks it shows.
+type Move struct {
+	ColumnID int `json:"column_id"`
+	// After is the task to place it right after, omitted for the top of the column.
+	After *int `json:"after,omitempty"`
+	// Before is the task to place it right before, omitted for the bottom of the column.
+	Before *int `json:"before,omitempty"`
+}
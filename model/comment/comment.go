@@ -0,0 +1,41 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/model/errs"
+	"strings"
+	"time"
+)
+
+// MaxBodyLength is the longest comment accepted, in bytes.
+const MaxBodyLength = 10000
+
+type Comment struct {
+	ID     int `json:"id"`
+	TaskID int `json:"task_id"`
+	// ParentID makes the comment a reply to another comment on the same task.
+	ParentID *int `json:"parent_id,omitempty"`
+	// AuthorID is the user who wrote the comment. It is cleared when that user is deleted.
+	AuthorID *int `json:"author_id"`
+	// Author is the name of the author, read from the users table.
+	Author  string `json:"author,omitempty"`
+	Body    string `json:"body"`
+	Version int    `json:"version"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Replies are the answers to the comment, oldest first.
+	Replies []Comment `json:"replies,omitempty"`
+}
+
+func (cm *Comment) Validate() error {
+	if strings.TrimSpace(cm.Body) == "" {
+		return errs.Validation{Field: "body", Reason: "must not be empty"}
+	}
+
+	if len(cm.Body) > MaxBodyLength {
+		return errs.Validation{Field: "body", Reason: "must be at most 10000 bytes long"}
+	}
+
+	return nil
+}
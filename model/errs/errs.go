@@ -0,0 +1,93 @@
+// Package errs holds the domain errors returned by stores and services. Each implements
+// StatusCode, so gofr responds with the matching HTTP status and its usual JSON error body.
+package errs
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// NotFound is returned when the entity a request addresses does not exist.
+type NotFound struct {
+	Entity string
+	ID     int
+}
+
+func (e NotFound) Error() string {
+	return fmt.Sprintf("%s with id %d not found", e.Entity, e.ID)
+}
+
+func (NotFound) StatusCode() int {
+	return http.StatusNotFound
+}
+
+// Conflict is returned when a request clashes with the current state of an entity.
+type Conflict struct {
+	Entity string
+	ID     int
+	Reason string
+}
+
+func (e Conflict) Error() string {
+	return fmt.Sprintf("%s %d: %s", e.Entity, e.ID, e.Reason)
+}
+
+func (Conflict) StatusCode() int {
+	return http.StatusConflict
+}
+
+// Validation is returned when a well-formed body carries a value the domain does not accept.
+type Validation struct {
+	Field  string
+	Reason string
+}
+
+func (e Validation) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+func (Validation) StatusCode() int {
+	return http.StatusUnprocessableEntity
+}
+
+// DependencyMissing is returned when an entity referenced by the request, rather than addressed by it,
+// does not exist, e.g. the user a task is assigned to.
+type DependencyMissing struct {
+	Entity string
+	ID     int
+}
+
+func (e DependencyMissing) Error() string {
+	return fmt.Sprintf("referenced %s with id %d does not exist", e.Entity, e.ID)
+}
+
+func (DependencyMissing) StatusCode() int {
+	return http.StatusUnprocessableEntity
+}
+
+// Unauthorized is returned when a request does not prove who makes it, e.g. with wrong credentials or an
+// expired token.
+type Unauthorized struct {
+	Reason string
+}
+
+func (e Unauthorized) Error() string {
+	return "unauthorized: " + e.Reason
+}
+
+func (Unauthorized) StatusCode() int {
+	return http.StatusUnauthorized
+}
+
+// Forbidden is returned when the user making a request is not allowed to use the permission it needs.
+type Forbidden struct {
+	Permission string
+}
+
+func (e Forbidden) Error() string {
+	return fmt.Sprintf("permission %s denied", e.Permission)
+}
+
+func (Forbidden) StatusCode() int {
+	return http.StatusForbidden
+}
@@ -0,0 +1,131 @@
+package page
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Query describes which slice of a list to return. When Cursor is set it takes the place of Offset
+// and continues right after the last row of the previous page.
+type Query struct {
+	Limit  int
+	Offset int
+	Cursor *Cursor
+	Sort   string
+	Order  string
+}
+
+// Page is the envelope returned by list endpoints.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Cursor points at the last row of a page: its value for the sort key and its id as a tie-breaker.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Value string `json:"v"`
+	ID    int    `json:"id"`
+}
+
+// NewQuery parses the raw limit, offset, cursor, sort and order query parameters.
+// sorts lists the sort keys the endpoint supports, the first one being the default.
+func NewQuery(limit, offset, cursor, sort, order string, sorts ...string) (Query, error) {
+	q := Query{Limit: DefaultLimit, Sort: sorts[0], Order: OrderAsc}
+
+	if limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l < 1 || l > MaxLimit {
+			return q, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}
+		}
+
+		q.Limit = l
+	}
+
+	if offset != "" {
+		o, err := strconv.Atoi(offset)
+		if err != nil || o < 0 {
+			return q, gofrHttp.ErrorInvalidParam{Params: []string{"offset"}}
+		}
+
+		q.Offset = o
+	}
+
+	if sort != "" {
+		if !contains(sorts, sort) {
+			return q, gofrHttp.ErrorInvalidParam{Params: []string{"sort"}}
+		}
+
+		q.Sort = sort
+	}
+
+	if order != "" {
+		order = strings.ToLower(order)
+		if order != OrderAsc && order != OrderDesc {
+			return q, gofrHttp.ErrorInvalidParam{Params: []string{"order"}}
+		}
+
+		q.Order = order
+	}
+
+	if cursor != "" {
+		cur, err := decodeCursor(cursor)
+		if err != nil || cur.Sort != q.Sort || cur.Order != q.Order || q.Offset != 0 {
+			return q, gofrHttp.ErrorInvalidParam{Params: []string{"cursor"}}
+		}
+
+		q.Cursor = &cur
+	}
+
+	return q, nil
+}
+
+// Desc reports whether the query sorts in descending order.
+func (q Query) Desc() bool {
+	return q.Order == OrderDesc
+}
+
+// NextCursor returns the opaque cursor pointing after a row with the given sort value and id.
+func (q Query) NextCursor(value string, id int) string {
+	b, _ := json.Marshal(Cursor{Sort: q.Sort, Order: q.Order, Value: value, ID: id})
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (Cursor, error) {
+	var c Cursor
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+
+	err = json.Unmarshal(b, &c)
+
+	return c, err
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
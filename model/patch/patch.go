@@ -0,0 +1,57 @@
+package patch
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Merge applies a JSON merge patch (RFC 7396) to target, which must be a pointer to a struct
+// with JSON tags. Members set to null in the patch are removed, i.e. reset to their zero value;
+// nested objects are merged recursively and every other value replaces the current one.
+func Merge(target any, patch map[string]any) error {
+	b, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]any
+
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+
+	b, err = json.Marshal(merge(doc, patch))
+	if err != nil {
+		return err
+	}
+
+	// unmarshal into a zeroed value so removed members do not keep their old value
+	v := reflect.ValueOf(target).Elem()
+	v.Set(reflect.Zero(v.Type()))
+
+	return json.Unmarshal(b, target)
+}
+
+func merge(doc, patch map[string]any) map[string]any {
+	if doc == nil {
+		doc = map[string]any{}
+	}
+
+	for k, v := range patch {
+		if v == nil {
+			delete(doc, k)
+			continue
+		}
+
+		if p, ok := v.(map[string]any); ok {
+			d, _ := doc[k].(map[string]any)
+			doc[k] = merge(d, p)
+
+			continue
+		}
+
+		doc[k] = v
+	}
+
+	return doc
+}
@@ -0,0 +1,42 @@
+package policy
+
+// Permission names an operation guarded by the access policy, as reported when it is denied. Each is checked
+// against the owner of what the operation touches: the assignee of a task, or the user being changed.
+type Permission string
+
+const (
+	// TaskAssign creates a task for its owner or reassigns a task to them.
+	TaskAssign Permission = "task:assign"
+	// TaskUpdate edits a task, its tags, its blockers and its attachments, and moves it to a status other than done.
+	TaskUpdate Permission = "task:update"
+	// TaskComplete moves a task to done, or to the status tasks are completed in under another workflow.
+	TaskComplete Permission = "task:complete"
+	// TaskDelete moves a task to the trash.
+	TaskDelete Permission = "task:delete"
+	// TaskRestore takes a task out of the trash.
+	TaskRestore Permission = "task:restore"
+	// UserCreate adds a user to a workspace. The first admin of a workspace is created along with it instead.
+	UserCreate Permission = "user:create"
+	// UserUpdate edits the name, email or password of a user.
+	UserUpdate Permission = "user:update"
+	// UserSetRole gives a user a role, or creates a user with a role other than member.
+	UserSetRole Permission = "user:set_role"
+	// UserDelete deletes a user.
+	UserDelete Permission = "user:delete"
+	// TokenIssue issues an API token acting as a user. Tokens are only ever issued by their own user.
+	TokenIssue Permission = "token:issue"
+	// TokenManage lists and revokes the API tokens of a user.
+	TokenManage Permission = "token:manage"
+	// ProjectManage creates, edits and deletes projects, and adds and removes their members.
+	ProjectManage Permission = "project:manage"
+	// BoardManage creates, edits and deletes boards. Moving a task on a board only takes TaskUpdate.
+	BoardManage Permission = "board:manage"
+	// TemplateManage creates, edits and deletes task templates. Instantiating a template only takes TaskAssign.
+	TemplateManage Permission = "template:manage"
+	// CommentUpdate edits a comment, owned by its author.
+	CommentUpdate Permission = "comment:update"
+	// CommentDelete deletes a comment along with its replies.
+	CommentDelete Permission = "comment:delete"
+	// AuditRead reads the audit log of a workspace.
+	AuditRead Permission = "audit:read"
+)
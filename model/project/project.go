@@ -0,0 +1,49 @@
+package project
+
+import (
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"strings"
+	"time"
+)
+
+// MaxNameLength is the longest project name accepted, in bytes.
+const MaxNameLength = 100
+
+// Project groups tasks of a workspace. Only its members may be assigned its tasks.
+type Project struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Version     int    `json:"version"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (p *Project) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if len(p.Name) > MaxNameLength {
+		return errs.Validation{Field: "name", Reason: "must be at most 100 bytes long"}
+	}
+
+	return nil
+}
+
+// Stats counts the live tasks of a project. Open tasks are those whose status is not closed, and Done those in the
+// status tasks are completed in, so tasks closed otherwise, such as cancelled ones, count in Total only.
+type Stats struct {
+	Total int `json:"total"`
+	Open  int `json:"open"`
+	Done  int `json:"done"`
+}
+
+// Tasks is a page of the tasks of a project, along with the stats of all of them.
+type Tasks struct {
+	page.Page[task.Task]
+	Stats Stats `json:"stats"`
+}
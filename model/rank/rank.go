@@ -0,0 +1,92 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+// Ranks order the tasks of a board column. They are strings of base-36 digits compared byte by byte, read as the
+// fractional part of a number, so that there is always room between two of them: moving a task rewrites its own rank
+// and no other. Ranks never end with the digit 0, which leaves room below any of them as well.
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// width is the length After pads ranks to, which leaves room to append 36^5 tasks before ranks grow any longer.
+const width = 6
+
+// ErrNoRoom is returned when asked for a rank between two ranks that are not in increasing order.
+var ErrNoRoom = errors.New("no rank between ranks out of order")
+
+// Between returns a rank greater than a and less than b. An empty a stands for the start of the column and an
+// empty b for its end, so Between("", "") is the rank of the first task of an empty column.
+func Between(a, b string) (string, error) {
+	if b != "" && a >= b {
+		return "", ErrNoRoom
+	}
+
+	var (
+		out     []byte
+		bounded = b != ""
+	)
+
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(a) {
+			lo = strings.IndexByte(digits, a[i])
+		}
+
+		hi := base
+		if bounded {
+			if i >= len(b) {
+				// only reached if b is a with trailing zeros appended, which no rank is
+				return "", ErrNoRoom
+			}
+
+			hi = strings.IndexByte(digits, b[i])
+		}
+
+		if lo < 0 || hi < 0 {
+			return "", ErrNoRoom
+		}
+
+		if lo == hi {
+			out = append(out, digits[lo])
+
+			continue
+		}
+
+		if mid := (lo + hi) / 2; mid > lo {
+			return string(append(out, digits[mid])), nil
+		}
+
+		// hi is the digit after lo: keep lo, anything greater than the rest of a is then less than b
+		out = append(out, digits[lo])
+		bounded = false
+	}
+}
+
+// After returns a rank greater than a, of about the same length, to append a task to the end of a column whose last
+// rank is a. Unlike Between(a, ""), appending over and over does not make ranks grow longer and longer.
+func After(a string) string {
+	out := []byte(a)
+
+	// trailing zeros do not change the order of a rank
+	for len(out) < width {
+		out = append(out, digits[0])
+	}
+
+	for i := len(out) - 1; i >= 0; i-- {
+		d := strings.IndexByte(digits, out[i])
+		if d < base-1 {
+			out[i] = digits[d+1]
+
+			return strings.TrimRight(string(out), "0")
+		}
+
+		out[i] = digits[0]
+	}
+
+	// a is all z, or empty
+	return a + digits[base/2:base/2+1]
+}
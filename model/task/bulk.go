@@ -0,0 +1,135 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+)
+
+// DefaultMaxBulk is the number of operations a bulk request may hold, unless configured otherwise.
+const DefaultMaxBulk = 100
+
+// BulkAction is what an operation of a bulk request does to a task.
+type BulkAction string
+
+const (
+	BulkCreate   BulkAction = "create"
+	BulkComplete BulkAction = "complete"
+	BulkDelete   BulkAction = "delete"
+	BulkReassign BulkAction = "reassign"
+)
+
+// BulkMode decides what becomes of the other operations of a bulk request when one fails.
+type BulkMode string
+
+const (
+	// BulkAtomic applies every operation or none of them.
+	BulkAtomic BulkMode = "atomic"
+	// BulkBestEffort applies every operation that can be applied, whatever becomes of the others.
+	BulkBestEffort BulkMode = "best_effort"
+)
+
+// Bulk is the request body for applying several operations to tasks at once.
+type Bulk struct {
+	// Mode defaults to BulkAtomic.
+	Mode       BulkMode    `json:"mode,omitempty"`
+	Operations []Operation `json:"operations"`
+}
+
+// Operation is an operation of a bulk request.
+type Operation struct {
+	Action BulkAction `json:"action"`
+	// ID is the task to complete, delete or reassign.
+	ID int `json:"id,omitempty"`
+	// Version is the version the task must still be at, as sent in If-Match on its own. Omitted for any version.
+	Version int `json:"version,omitempty"`
+	// Userid is the user to reassign the task to.
+	Userid int `json:"userid,omitempty"`
+	// Task is the task to create.
+	Task *Task `json:"task,omitempty"`
+}
+
+// Validate checks the shape of a bulk request of at most limit operations. Each task is changed by one operation at
+// most, the checks of each operation being made against the tasks as they were before the request.
+func (b *Bulk) Validate(limit int) error {
+	if b.Mode != BulkAtomic && b.Mode != BulkBestEffort {
+		return errs.Validation{Field: "mode", Reason: "must be atomic or best_effort"}
+	}
+
+	if len(b.Operations) == 0 || len(b.Operations) > limit {
+		return errs.Validation{Field: "operations", Reason: fmt.Sprintf("must have between 1 and %d operations", limit)}
+	}
+
+	seen := make(map[int]int)
+
+	for i, op := range b.Operations {
+		field := fmt.Sprintf("operations[%d]", i)
+
+		switch op.Action {
+		case BulkCreate:
+			if op.Task == nil {
+				return errs.Validation{Field: field + ".task", Reason: "must be set to create a task"}
+			}
+
+			continue
+		case BulkComplete, BulkDelete, BulkReassign:
+		default:
+			return errs.Validation{Field: field + ".action", Reason: "must be one of create, complete, delete, reassign"}
+		}
+
+		if op.ID <= 0 {
+			return errs.Validation{Field: field + ".id", Reason: "must be set to " + string(op.Action) + " a task"}
+		}
+
+		if op.Action == BulkReassign && op.Userid <= 0 {
+			return errs.Validation{Field: field + ".userid", Reason: "must be set to reassign a task"}
+		}
+
+		if j, ok := seen[op.ID]; ok {
+			return errs.Validation{Field: field + ".id", Reason: fmt.Sprintf("is already changed by operations[%d]", j)}
+		}
+
+		seen[op.ID] = i
+	}
+
+	return nil
+}
+
+// Change is an operation of a bulk request once checked, as stores apply it: Task is the task to create, or the task
+// to change as read, with its new status or assignee and the time of the change.
+type Change struct {
+	Action BulkAction
+	Task   Task
+}
+
+// BulkResult is what became of an operation of a bulk request.
+type BulkResult struct {
+	Action BulkAction `json:"action"`
+	ID     int        `json:"id,omitempty"`
+	// Status is the HTTP status the operation would have got as a request of its own.
+	Status int `json:"status"`
+	// Task is the task created or changed, omitted if the operation failed.
+	Task  *Task  `json:"task,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// BulkReport is the response to a bulk request, with a result for each operation in their order.
+type BulkReport struct {
+	Mode      BulkMode     `json:"mode"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Results   []BulkResult `json:"results"`
+}
+
+// BatchError is returned by stores applying changes all or none when one of them fails: Index is that change.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e BatchError) Error() string {
+	return fmt.Sprintf("change %d: %v", e.Index, e.Err)
+}
+
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,31 @@
+package task
+
+import "time"
+
+// EventType names the kind of change an Event records.
+type EventType string
+
+const (
+	EventCreated    EventType = "created"
+	EventUpdated    EventType = "updated"
+	EventReassigned EventType = "reassigned"
+	// EventTransitioned is a status change other than a completion.
+	EventTransitioned EventType = "transitioned"
+	EventCompleted    EventType = "completed"
+	EventDeleted      EventType = "deleted"
+	EventRestored     EventType = "restored"
+)
+
+// Event is an entry in the history of a task: a change, who made it and when, with snapshots of the task on
+// either side of it.
+type Event struct {
+	ID     int       `json:"id"`
+	TaskID int       `json:"task_id"`
+	Type   EventType `json:"type"`
+	// ActorID is the user who made the change, or null for changes made by the system or by anonymous clients.
+	ActorID *int      `json:"actor_id"`
+	At      time.Time `json:"at"`
+	// Before is null for a creation or a restoration, After for a deletion.
+	Before *Task `json:"before"`
+	After  *Task `json:"after"`
+}
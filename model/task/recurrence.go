@@ -0,0 +1,185 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"slices"
+	"time"
+)
+
+// Frequency is the unit a recurrence rule repeats in.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+)
+
+// Weekday is a day of the week, spelt as in iCalendar recurrence rules.
+type Weekday string
+
+// Weekdays are the days of the week in their order, weeks starting on Monday.
+var Weekdays = []Weekday{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// Offset is the number of days between the Monday of a week and d, or -1 if d is not a weekday.
+func (d Weekday) Offset() int {
+	return slices.Index(Weekdays, d)
+}
+
+// WeekdayOf returns the Weekday of a day.
+func WeekdayOf(t time.Time) Weekday {
+	return Weekdays[(int(t.Weekday())+6)%7]
+}
+
+// MaxInterval is the longest interval of a recurrence rule, in days, weeks or months.
+const MaxInterval = 1000
+
+// MaxOccurrences is the number of upcoming occurrences a preview lists at most.
+const MaxOccurrences = 100
+
+// Rule is a recurrence rule in the manner of iCalendar RRULEs: it repeats every Interval days, weeks or months counted
+// from the start of the recurrence, until Until or for Count occurrences. Monthly rules fall on the day of the month
+// of the start, skipping the months without that day.
+type Rule struct {
+	Freq Frequency `json:"freq"`
+	// Interval is the number of days, weeks or months between occurrences, 1 if left out.
+	Interval int `json:"interval,omitempty"`
+	// ByWeekday keeps the occurrences of a daily rule to these days, or repeats a weekly rule on each of them. A weekly
+	// rule without it falls on the weekday of the start.
+	ByWeekday []Weekday `json:"by_weekday,omitempty"`
+	// Until is the time past which there are no more occurrences.
+	Until *time.Time `json:"until,omitempty"`
+	// Count is the number of occurrences there are in all, no limit if left out.
+	Count int `json:"count,omitempty"`
+}
+
+// Recurrence creates tasks from a template on the schedule of a rule, one occurrence at a time: the task of an
+// occurrence is created once it falls due.
+type Recurrence struct {
+	ID int `json:"id"`
+	// Desc, Userid, Priority, ProjectID and Estimate are given to every occurrence.
+	Desc      string   `json:"desc"`
+	Userid    int      `json:"userid"`
+	Priority  Priority `json:"priority"`
+	ProjectID *int     `json:"project_id,omitempty"`
+	Estimate  *int     `json:"estimate,omitempty"`
+
+	Rule Rule `json:"rule"`
+	// Start is where the rule counts its days, weeks or months from, and the time of day every occurrence is due at.
+	// It may be given in any time zone and is normalized to UTC.
+	Start time.Time `json:"start"`
+
+	// NextAt is when the next occurrence is due, nil once the rule has no more.
+	NextAt *time.Time `json:"next_at,omitempty"`
+	// LastAt is when the latest occurrence is due, and LastTaskID its task.
+	LastAt     *time.Time `json:"last_at,omitempty"`
+	LastTaskID *int       `json:"last_task_id,omitempty"`
+	// Occurrences is the number of tasks created so far.
+	Occurrences int `json:"occurrences"`
+
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Occurrence is the task of the occurrence due at the given time.
+func (r *Recurrence) Occurrence(due time.Time) Task {
+	return Task{Desc: r.Desc, Userid: r.Userid, Priority: r.Priority, ProjectID: r.ProjectID, Estimate: r.Estimate,
+		DueAt: &due}
+}
+
+func (r *Recurrence) Validate() error {
+	t := r.Occurrence(r.Start)
+
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	if r.Start.IsZero() {
+		return errs.Validation{Field: "start", Reason: "must be set"}
+	}
+
+	return r.Rule.Validate(r.Start.UTC())
+}
+
+// Validate checks the rule on its own, and that it falls due at least once when counted from start, in the time zone
+// of start.
+func (r *Rule) Validate(start time.Time) error {
+	switch r.Freq {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	default:
+		return errs.Validation{Field: "rule.freq", Reason: "must be one of daily, weekly, monthly"}
+	}
+
+	if r.Interval < 0 || r.Interval > MaxInterval {
+		return errs.Validation{Field: "rule.interval", Reason: fmt.Sprintf("must be between 1 and %d", MaxInterval)}
+	}
+
+	if len(r.ByWeekday) > 0 && r.Freq == FrequencyMonthly {
+		return errs.Validation{Field: "rule.by_weekday", Reason: "is only allowed in daily and weekly rules"}
+	}
+
+	for i, d := range r.ByWeekday {
+		if d.Offset() < 0 {
+			return errs.Validation{Field: "rule.by_weekday", Reason: "must be among MO, TU, WE, TH, FR, SA, SU"}
+		}
+
+		if slices.Contains(r.ByWeekday[:i], d) {
+			return errs.Validation{Field: "rule.by_weekday", Reason: "must not repeat " + string(d)}
+		}
+	}
+
+	if r.Count < 0 {
+		return errs.Validation{Field: "rule.count", Reason: "must not be negative"}
+	}
+
+	if r.Count > 0 && r.Until != nil {
+		return errs.Validation{Field: "rule.count", Reason: "must not be set along with until"}
+	}
+
+	if r.Until != nil && r.Until.Before(start) {
+		return errs.Validation{Field: "rule.until", Reason: "must not be before start"}
+	}
+
+	if first, ok := r.first(start); !ok || r.Until != nil && first.After(*r.Until) {
+		return errs.Validation{Field: "rule", Reason: "never falls due"}
+	}
+
+	return nil
+}
+
+// first returns when the first occurrence of the rule counted from start is due, looking one cycle ahead: the
+// weekdays of the days a daily rule falls on come back every 7 intervals, a weekly rule falls on its weekdays every
+// interval, and a monthly one on the start. There is none if the weekdays of a daily rule are never reached.
+func (r *Rule) first(start time.Time) (time.Time, bool) {
+	step := max(r.Interval, 1)
+
+	switch r.Freq {
+	case FrequencyMonthly:
+		return start, true
+	case FrequencyWeekly:
+		days := r.ByWeekday
+		if len(days) == 0 {
+			days = []Weekday{WeekdayOf(start)}
+		}
+
+		for _, week := range []int{0, step} {
+			for _, d := range Weekdays {
+				t := start.AddDate(0, 0, 7*week+d.Offset()-WeekdayOf(start).Offset())
+				if slices.Contains(days, d) && !t.Before(start) {
+					return t, true
+				}
+			}
+		}
+	default:
+		for i := range 7 {
+			t := start.AddDate(0, 0, i*step)
+			if len(r.ByWeekday) == 0 || slices.Contains(r.ByWeekday, WeekdayOf(t)) {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,11 @@
+package task
+
+import "time"
+
+// Reminder is emitted once for a task that passes its due date while still open.
+type Reminder struct {
+	TaskID int       `json:"task_id"`
+	Userid int       `json:"userid"`
+	Desc   string    `json:"desc"`
+	DueAt  time.Time `json:"due_at"`
+}
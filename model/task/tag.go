@@ -0,0 +1,32 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/model/errs"
+	"strings"
+)
+
+// Tag is a label attached to tasks, along with the number of live tasks carrying it.
+type Tag struct {
+	Name  string `json:"name"`
+	Tasks int    `json:"tasks"`
+}
+
+// MaxTagLength is the longest tag name accepted.
+const MaxTagLength = 64
+
+// NormalizeTag lower-cases and trims a tag name, and checks it only holds letters, digits, '-', '_' and ':'.
+func NormalizeTag(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if name == "" || len(name) > MaxTagLength {
+		return "", errs.Validation{Field: "tag", Reason: "must be 1 to 64 characters long"}
+	}
+
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' && r != ':' {
+			return "", errs.Validation{Field: "tag", Reason: "may only contain letters, digits, '-', '_' and ':'"}
+		}
+	}
+
+	return name, nil
+}
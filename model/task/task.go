@@ -1,19 +1,143 @@
 package task
 
 import (
-	gofrHttp "gofr.dev/pkg/gofr/http"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"time"
 )
 
+// Status is a state in the task workflow.
+type Status string
+
+const (
+	StatusTodo       Status = "todo"
+	StatusInProgress Status = "in_progress"
+	StatusBlocked    Status = "blocked"
+	StatusInReview   Status = "in_review"
+	StatusDone       Status = "done"
+	StatusCancelled  Status = "cancelled"
+)
+
+// Priority ranks how urgent a task is, from P0 (most urgent) to P3. Priorities sort in rank order.
+type Priority string
+
+const (
+	PriorityP0 Priority = "P0"
+	PriorityP1 Priority = "P1"
+	PriorityP2 Priority = "P2"
+	PriorityP3 Priority = "P3"
+)
+
+// DefaultPriority is given to tasks created without a priority.
+const DefaultPriority = PriorityP2
+
+// Valid reports whether p is one of P0 to P3.
+func (p Priority) Valid() bool {
+	switch p {
+	case PriorityP0, PriorityP1, PriorityP2, PriorityP3:
+		return true
+	}
+
+	return false
+}
+
+// MaxDepth is the number of levels a task hierarchy may have, a top-level task being on level 1.
+const MaxDepth = 5
+
 type Task struct {
-	ID     int    `json:"id"`
-	Desc   string `json:"desc"`
-	Status bool   `json:"status"`
-	Userid int    `json:"userid"`
+	ID       int      `json:"id"`
+	Desc     string   `json:"desc"`
+	Status   Status   `json:"status"`
+	Userid   int      `json:"userid"`
+	Priority Priority `json:"priority"`
+	// ParentID makes the task a subtask of another one.
+	ParentID *int `json:"parent_id,omitempty"`
+	// Estimate is the optional amount of work left, in hours.
+	Estimate *int `json:"estimate,omitempty"`
+	// ProjectID puts the task in a project, whose members alone may be assigned it.
+	ProjectID *int `json:"project_id,omitempty"`
+	// Comments is the number of comments on the task, replies included.
+	Comments int `json:"comments"`
+	Version  int `json:"version"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// DueAt is optional. It may be given in any time zone and is normalized to UTC.
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// DeletedAt is set while the task is in the trash.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// SortKeys are the keys the task list can be sorted by, the first being the default.
+var SortKeys = []string{"id", "desc", "status", "userid", "priority"}
+
+// Filter narrows down the task list. Zero values do not filter.
+type Filter struct {
+	Status    Status
+	Userid    int
+	ProjectID int
+	Text      string
+
+	// Trashed lists the tasks in the trash instead of the live ones.
+	Trashed bool
+
+	// Overdue keeps the open tasks whose due date has passed.
+	Overdue bool
+	// DueWithin keeps the open tasks due between Now and Now+DueWithin.
+	DueWithin time.Duration
+	// Now is the reference time of Overdue and DueWithin.
+	Now time.Time
+
+	// Tags keeps the tasks carrying any of the tags, or all of them if AllTags is set.
+	Tags    []string
+	AllTags bool
+}
+
+// Node is a task along with its subtasks.
+type Node struct {
+	Task
+	Children []Node `json:"children"`
+}
+
+// Path is a chain of tasks, each blocking the next one, along with the sum of their estimates.
+type Path struct {
+	Tasks    []Task `json:"tasks"`
+	Estimate int    `json:"estimate"`
+}
+
+// Dependency records that the task TaskID is blocked by the task BlockerID.
+type Dependency struct {
+	TaskID    int
+	BlockerID int
+}
+
+// Transition is the request body for moving a task to another status.
+type Transition struct {
+	Status Status `json:"status"`
+}
+
+// Placement puts a task in the column of a board showing the tasks with Status, between two tasks of that column.
+type Placement struct {
+	Status Status
+	// ProjectID is the project of the board, whose tasks alone it shows, or 0 for a board of the whole workspace.
+	ProjectID int
+	// After is the task to place it right after, nil for the top of the column.
+	After *int
+	// Before is the task to place it right before, nil for the bottom of the column.
+	Before *int
 }
 
 func (t *Task) Validate() error {
 	if t.Desc == "" {
-		return gofrHttp.ErrorInvalidParam{Params: []string{"task.desc"}}
+		return errs.Validation{Field: "desc", Reason: "must not be empty"}
+	}
+
+	if t.Priority != "" && !t.Priority.Valid() {
+		return errs.Validation{Field: "priority", Reason: "must be one of P0, P1, P2, P3"}
+	}
+
+	if t.Estimate != nil && *t.Estimate <= 0 {
+		return errs.Validation{Field: "estimate", Reason: "must be a positive number of hours"}
 	}
 
 	return nil
@@ -0,0 +1,112 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxNameLength is the longest template name accepted, in bytes.
+const MaxNameLength = 100
+
+// MaxTasks is the number of tasks a template may have.
+const MaxTasks = 50
+
+// Placeholders the descriptions of the tasks of a template may hold, filled in when it is instantiated.
+const (
+	// PlaceholderUserID is the id of the user the tasks are created for.
+	PlaceholderUserID = "{{user.id}}"
+	// PlaceholderUserName is the name of the user the tasks are created for.
+	PlaceholderUserName = "{{user.name}}"
+	// PlaceholderUserEmail is the email of the user the tasks are created for.
+	PlaceholderUserEmail = "{{user.email}}"
+	// PlaceholderDate is the day the tasks are created, as 2006-01-02 in UTC.
+	PlaceholderDate = "{{date}}"
+)
+
+// Placeholders are the placeholders a description may hold.
+var Placeholders = []string{PlaceholderUserID, PlaceholderUserName, PlaceholderUserEmail, PlaceholderDate}
+
+var placeholder = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+// Template is a named list of tasks created together for a user, such as the tasks of onboarding a new hire.
+type Template struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Tasks   []Item `json:"tasks"`
+	Version int    `json:"version"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Item is a task of a template. Its description may hold Placeholders.
+type Item struct {
+	Desc     string        `json:"desc"`
+	Priority task.Priority `json:"priority,omitempty"`
+	// Estimate is the expected effort in hours.
+	Estimate *int `json:"estimate,omitempty"`
+}
+
+func (t *Template) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if len(t.Name) > MaxNameLength {
+		return errs.Validation{Field: "name", Reason: "must be at most 100 bytes long"}
+	}
+
+	if len(t.Tasks) == 0 || len(t.Tasks) > MaxTasks {
+		return errs.Validation{Field: "tasks", Reason: "must have between 1 and 50 tasks"}
+	}
+
+	for i, it := range t.Tasks {
+		field := fmt.Sprintf("tasks[%d]", i)
+
+		// the fields of an item are those of a task
+		var v errs.Validation
+
+		if err := (&task.Task{Desc: it.Desc, Priority: it.Priority, Estimate: it.Estimate}).Validate(); errors.As(err, &v) {
+			return errs.Validation{Field: field + "." + v.Field, Reason: v.Reason}
+		}
+
+		for _, p := range placeholder.FindAllString(it.Desc, -1) {
+			if !slices.Contains(Placeholders, p) {
+				return errs.Validation{Field: field + ".desc", Reason: "unknown placeholder " + p}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Instantiation is the request body for instantiating a template: whom its tasks are created for, and in which
+// project.
+type Instantiation struct {
+	Userid    int  `json:"userid"`
+	ProjectID *int `json:"project_id,omitempty"`
+}
+
+// Render returns the tasks of the template for the user u on the given day, their placeholders filled in, in the
+// project of the instantiation. They have no status yet.
+func (t *Template) Render(u user.User, in Instantiation, day time.Time) []task.Task {
+	r := strings.NewReplacer(PlaceholderUserID, strconv.Itoa(u.ID), PlaceholderUserName, u.Name,
+		PlaceholderUserEmail, u.Email, PlaceholderDate, day.UTC().Format(time.DateOnly))
+
+	tasks := make([]task.Task, len(t.Tasks))
+
+	for i, it := range t.Tasks {
+		tasks[i] = task.Task{Desc: r.Replace(it.Desc), Userid: u.ID, Priority: it.Priority, Estimate: it.Estimate,
+			ProjectID: in.ProjectID}
+	}
+
+	return tasks
+}
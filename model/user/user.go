@@ -1,20 +1,88 @@
 package user
 
 import (
-	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"slices"
 )
 
 type User struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Role    Role   `json:"role"`
+	Version int    `json:"version"`
+	// Password is write-only: it is accepted in request bodies, hashed into PasswordHash and never returned.
+	Password     string `json:"password,omitempty"`
+	PasswordHash string `json:"-"`
 }
 
-var err = errors.New("name and email cannot be empty")
+// Password lengths accepted by ValidatePassword. bcrypt only looks at the first 72 bytes of a password.
+const (
+	MinPasswordLen = 8
+	MaxPasswordLen = 72
+)
+
+// Role decides what a user may do; package policy holds what each role is granted.
+type Role string
+
+const (
+	RoleAdmin   Role = "admin"
+	RoleManager Role = "manager"
+	RoleMember  Role = "member"
+)
+
+// Roles are the roles a user can have.
+var Roles = []Role{RoleAdmin, RoleManager, RoleMember}
+
+// SortKeys are the keys the user list can be sorted by, the first being the default.
+var SortKeys = []string{"id", "name", "email"}
+
+// Filter narrows down the user list. Text matches either the name or the email.
+type Filter struct {
+	Text string
+}
+
+// OnDelete says what happens to the tasks and recurrences of a user being deleted.
+type OnDelete string
+
+const (
+	// OnDeleteReject refuses to delete a user who still has tasks or recurrences.
+	OnDeleteReject OnDelete = "reject"
+	// OnDeleteCascade moves the tasks to the trash, where they stay until purged, and deletes the recurrences together
+	// with the user.
+	OnDeleteCascade OnDelete = "cascade"
+	// OnDeleteReassign moves the tasks and recurrences to another user, who must be a member of their projects.
+	OnDeleteReassign OnDelete = "reassign"
+)
+
+// DeletePolicy is the delete policy of DELETE /user/{id}. ReassignTo is only used with OnDeleteReassign.
+type DeletePolicy struct {
+	Tasks      OnDelete `json:"tasks"`
+	ReassignTo int      `json:"reassign_to,omitempty"`
+}
 
 func (u *User) Validate() error {
-	if u.Name == "" || u.Email == "" {
-		return err
+	if u.Name == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if u.Email == "" {
+		return errs.Validation{Field: "email", Reason: "must not be empty"}
+	}
+
+	if u.Role != "" && !slices.Contains(Roles, u.Role) {
+		return errs.Validation{Field: "role", Reason: "must be one of admin, manager, member"}
+	}
+
+	return nil
+}
+
+// ValidatePassword checks the length of a new password.
+func ValidatePassword(p string) error {
+	if len(p) < MinPasswordLen || len(p) > MaxPasswordLen {
+		return errs.Validation{Field: "password",
+			Reason: fmt.Sprintf("must be %d to %d bytes long", MinPasswordLen, MaxPasswordLen)}
 	}
 
 	return nil
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Any is the expected version meaning "whatever the current version is", sent as If-Match: *.
+const Any = 0
+
+// ErrMismatch is returned by stores when a conditional write finds the row at another version.
+var ErrMismatch = errors.New("version mismatch")
+
+// ETag returns the entity tag for a version.
+func ETag(v int) string {
+	return strconv.Quote(strconv.Itoa(v))
+}
+
+// FromIfMatch parses an If-Match header holding a single entity tag made by ETag. A weak tag is
+// accepted as well, and "*" yields Any.
+func FromIfMatch(h string) (int, error) {
+	h = strings.TrimSpace(h)
+
+	switch h {
+	case "":
+		return Any, ErrPreconditionRequired{}
+	case "*":
+		return Any, nil
+	}
+
+	tag, err := strconv.Unquote(strings.TrimPrefix(h, "W/"))
+	if err != nil {
+		return Any, ErrPreconditionFailed{IfMatch: h}
+	}
+
+	v, err := strconv.Atoi(tag)
+	if err != nil || v < 1 {
+		return Any, ErrPreconditionFailed{IfMatch: h}
+	}
+
+	return v, nil
+}
+
+// ErrPreconditionRequired is returned when a mutating request does not send If-Match.
+type ErrPreconditionRequired struct{}
+
+func (ErrPreconditionRequired) Error() string {
+	return "If-Match header is required, send the ETag of the resource being changed"
+}
+
+func (ErrPreconditionRequired) StatusCode() int {
+	return http.StatusPreconditionRequired
+}
+
+// ErrPreconditionFailed is returned when If-Match does not match the current version of the resource.
+type ErrPreconditionFailed struct {
+	IfMatch string
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return fmt.Sprintf("resource was modified, If-Match %s does not match its current ETag", e.IfMatch)
+}
+
+func (ErrPreconditionFailed) StatusCode() int {
+	return http.StatusPreconditionFailed
+}
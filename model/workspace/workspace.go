@@ -0,0 +1,97 @@
+package workspace
+
+import (
+	"context"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+	"strings"
+	"time"
+)
+
+// Workspace is a tenant of the service. Every user and task belongs to one workspace and is only seen from it.
+type Workspace struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Signup is the body of POST /workspaces: the workspace to create, and the account of its first admin, created in
+// the same transaction so that nobody else can take the workspace over before it has one.
+type Signup struct {
+	Workspace
+	Admin user.User `json:"admin"`
+}
+
+// MaxNameLength is the longest workspace name accepted, in bytes.
+const MaxNameLength = 100
+
+func (w *Workspace) Validate() error {
+	if strings.TrimSpace(w.Name) == "" {
+		return errs.Validation{Field: "name", Reason: "must not be empty"}
+	}
+
+	if len(w.Name) > MaxNameLength {
+		return errs.Validation{Field: "name", Reason: "must be at most 100 bytes long"}
+	}
+
+	return nil
+}
+
+// Default is the workspace holding everything created before workspaces existed, and the one requests fall back to
+// when they name none.
+const Default = 1
+
+// Header names the workspace of a request to a public route, such as logging in. Other requests are
+// in the workspace of their token.
+const Header = "X-Workspace-ID"
+
+// Claim is the claim of an access or refresh token holding the id of the workspace of its user.
+const Claim = "wid"
+
+type ctxKey int
+
+const idKey ctxKey = iota
+
+// WithID returns a copy of ctx in the workspace with the given id.
+func WithID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, idKey, id)
+}
+
+// ID returns the id of the workspace a request or job runs in, as set by the Authenticate middleware or by In, and
+// Default if none was set.
+func ID(c *gofr.Context) int {
+	for _, ctx := range []context.Context{c.Context, request(c)} {
+		if ctx == nil {
+			continue
+		}
+
+		if id, ok := ctx.Value(idKey).(int); ok {
+			return id
+		}
+	}
+
+	return Default
+}
+
+func request(c *gofr.Context) context.Context {
+	if c.Request == nil {
+		return nil
+	}
+
+	return c.Request.Context()
+}
+
+// In returns a copy of c running in the workspace with the given id, for work done outside of a request, such as
+// cron jobs, or on behalf of a token naming its workspace.
+func In(c *gofr.Context, id int) *gofr.Context {
+	ctx := c.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cc := *c
+	cc.Context = WithID(ctx, id)
+
+	return &cc
+}
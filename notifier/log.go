@@ -0,0 +1,21 @@
+package notifier
+
+import (
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+// Log writes reminders to the application log.
+type Log struct{}
+
+// NewLog returns a notifier that logs reminders.
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (*Log) Notify(c *gofr.Context, r task.Reminder) error {
+	c.Infof("task %d assigned to user %d was due at %s: %s", r.TaskID, r.Userid, r.DueAt.Format(time.RFC3339), r.Desc)
+
+	return nil
+}
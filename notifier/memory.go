@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"sync"
+)
+
+// Memory keeps the reminders it is sent, for tests and local runs.
+type Memory struct {
+	mu        sync.Mutex
+	reminders []task.Reminder
+}
+
+// NewMemory returns an empty in-memory notifier.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Notify(_ *gofr.Context, r task.Reminder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.reminders = append(m.reminders, r)
+
+	return nil
+}
+
+// Reminders returns the reminders sent so far, oldest first.
+func (m *Memory) Reminders() []task.Reminder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]task.Reminder(nil), m.reminders...)
+}
@@ -0,0 +1,11 @@
+package rbac
+
+import (
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+// UserStore looks up the role of the user making a request.
+type UserStore interface {
+	GetByIDUser(c *gofr.Context, id int) (user.User, error)
+}
@@ -0,0 +1,189 @@
+package rbac
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	userService "github.com/MGajendra22/GoFr/service/user"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// secret signs the access tokens of actorRequest
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+// actorRequest is a request to POST /user made by the user with the given id, or by nobody to the public POST
+// /workspaces if id is 0, as seen after the Authenticate middleware with the public routes of the service
+func actorRequest(id int) *gofrHttp.Request {
+	req := httptest.NewRequest(http.MethodPost, "/workspaces", http.NoBody)
+
+	if id != 0 {
+		req = httptest.NewRequest(http.MethodPost, "/user", http.NoBody)
+
+		token, _ := auth.Issue(secret, id, workspace.Default, auth.TypeAccess, "", time.Now(), time.Hour)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	var out *http.Request
+
+	authenticate(req, func(r *http.Request) { out = r })
+
+	return gofrHttp.NewRequest(out)
+}
+
+// authenticate serves req through the Authenticate middleware with the public routes of the service, calling next
+// with the request it lets through, and returns the response
+func authenticate(req *http.Request, next func(r *http.Request)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+
+	middleware.Authenticate(secret, nil, middleware.PublicRoutes...)(nil, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		next(r)
+	})).ServeHTTP(w, req)
+
+	return w
+}
+
+func Test_Authorize(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  int
+		role   user.Role
+		getErr error
+		perm   policy.Permission
+		owner  int
+		expErr error
+	}{
+		{name: "Admin Deletes User", actor: 1, role: user.RoleAdmin, perm: policy.UserDelete, owner: 5},
+		{name: "Manager Deletes User", actor: 1, role: user.RoleManager, perm: policy.UserDelete, owner: 5,
+			expErr: errs.Forbidden{Permission: "user:delete"}},
+		{name: "Manager Completes Any Task", actor: 1, role: user.RoleManager, perm: policy.TaskComplete, owner: 5},
+		{name: "Member Completes Own Task", actor: 5, role: user.RoleMember, perm: policy.TaskComplete, owner: 5},
+		{name: "Member Completes Other Task", actor: 5, role: user.RoleMember, perm: policy.TaskComplete, owner: 6,
+			expErr: errs.Forbidden{Permission: "task:complete"}},
+		{name: "Member Restores Own Task", actor: 5, role: user.RoleMember, perm: policy.TaskRestore, owner: 5,
+			expErr: errs.Forbidden{Permission: "task:restore"}},
+		{name: "Member Updates Own Account", actor: 5, role: user.RoleMember, perm: policy.UserUpdate, owner: 5},
+		{name: "Manager Updates Other Account", actor: 1, role: user.RoleManager, perm: policy.UserUpdate, owner: 5,
+			expErr: errs.Forbidden{Permission: "user:update"}},
+		{name: "Manager Adds User", actor: 1, role: user.RoleManager, perm: policy.UserCreate},
+		{name: "Member Adds User", actor: 5, role: user.RoleMember, perm: policy.UserCreate,
+			expErr: errs.Forbidden{Permission: "user:create"}},
+		{name: "Manager Manages Projects", actor: 1, role: user.RoleManager, perm: policy.ProjectManage},
+		{name: "Member Manages Projects", actor: 5, role: user.RoleMember, perm: policy.ProjectManage,
+			expErr: errs.Forbidden{Permission: "project:manage"}},
+		{name: "Manager Manages Boards", actor: 1, role: user.RoleManager, perm: policy.BoardManage},
+		{name: "Member Manages Boards", actor: 5, role: user.RoleMember, perm: policy.BoardManage,
+			expErr: errs.Forbidden{Permission: "board:manage"}},
+		{name: "Manager Manages Templates", actor: 1, role: user.RoleManager, perm: policy.TemplateManage},
+		{name: "Member Manages Templates", actor: 5, role: user.RoleMember, perm: policy.TemplateManage,
+			expErr: errs.Forbidden{Permission: "template:manage"}},
+		{name: "Admin Issues Token For Other", actor: 1, role: user.RoleAdmin, perm: policy.TokenIssue, owner: 5,
+			expErr: errs.Forbidden{Permission: "token:issue"}},
+		{name: "Admin Revokes Token Of Other", actor: 1, role: user.RoleAdmin, perm: policy.TokenManage, owner: 5},
+		{name: "Member Edits Own Comment", actor: 5, role: user.RoleMember, perm: policy.CommentUpdate, owner: 5},
+		{name: "Member Deletes Comment Of Other", actor: 5, role: user.RoleMember, perm: policy.CommentDelete, owner: 6,
+			expErr: errs.Forbidden{Permission: "comment:delete"}},
+		{name: "Manager Edits Comment Of Other", actor: 1, role: user.RoleManager, perm: policy.CommentUpdate, owner: 5,
+			expErr: errs.Forbidden{Permission: "comment:update"}},
+		{name: "Manager Deletes Comment Of Other", actor: 1, role: user.RoleManager, perm: policy.CommentDelete, owner: 5},
+		{name: "Admin Reads Audit Log", actor: 1, role: user.RoleAdmin, perm: policy.AuditRead},
+		{name: "Manager Reads Audit Log", actor: 1, role: user.RoleManager, perm: policy.AuditRead,
+			expErr: errs.Forbidden{Permission: "audit:read"}},
+		{name: "Anonymous", perm: policy.TaskUpdate, owner: 5, expErr: errs.Forbidden{Permission: "task:update"}},
+		{name: "Deleted Actor", actor: 1, getErr: errs.NotFound{Entity: "user", ID: 1}, perm: policy.TaskUpdate, owner: 1,
+			expErr: errs.Forbidden{Permission: "task:update"}},
+		{name: "Store Error", actor: 1, getErr: errors.New("db down"), perm: policy.TaskUpdate, owner: 1,
+			expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockUsers := NewMockUserStore(ctrl)
+
+		p := NewPolicy(mockUsers)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+			Request:   actorRequest(tt.actor),
+		}
+
+		if tt.actor != 0 {
+			mockUsers.EXPECT().GetByIDUser(ctx, tt.actor).Return(user.User{ID: tt.actor, Role: tt.role}, tt.getErr)
+		}
+
+		assert.Equal(t, tt.expErr, p.Authorize(ctx, tt.perm, tt.owner), tt.name)
+	}
+}
+
+func Test_CreateUserThroughMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  int
+		role   user.Role
+		create user.Role
+		expErr error
+	}{
+		{name: "Admin Adds Manager", actor: 1, role: user.RoleAdmin, create: user.RoleManager},
+		{name: "Admin Adds Admin", actor: 1, role: user.RoleAdmin, create: user.RoleAdmin},
+		{name: "Manager Adds Member", actor: 2, role: user.RoleManager, create: user.RoleMember},
+		{name: "Manager Adds Manager", actor: 2, role: user.RoleManager, create: user.RoleManager,
+			expErr: errs.Forbidden{Permission: "user:set_role"}},
+		{name: "Member Adds Member", actor: 5, role: user.RoleMember, create: user.RoleMember,
+			expErr: errs.Forbidden{Permission: "user:create"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockUsers := userService.NewMockUserStoreInterface(ctrl)
+
+		service := userService.NewUserService(mockUsers, userService.WithPolicy(NewPolicy(mockUsers)))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+			Request:   actorRequest(tt.actor),
+		}
+
+		mockUsers.EXPECT().GetByIDUser(ctx, tt.actor).Return(user.User{ID: tt.actor, Role: tt.role}, nil).AnyTimes()
+
+		if tt.expErr == nil {
+			mockUsers.EXPECT().CreateUser(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, u user.User) (user.User, error) {
+				u.ID = 9
+				return u, nil
+			})
+		}
+
+		res, err := service.Create(ctx, user.User{Name: "Bob", Email: "bob@example.com", Password: "correct horse", Role: tt.create})
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, tt.create, res.Role, tt.name)
+		}
+	}
+
+	t.Run("Anonymous", func(t *testing.T) {
+		called := false
+
+		w := authenticate(httptest.NewRequest(http.MethodPost, "/user", http.NoBody), func(*http.Request) { called = true })
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.False(t, called, "users are only added by someone allowed to")
+	})
+}
@@ -0,0 +1,27 @@
+package apitoken
+
+import (
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type APITokenStoreInterface interface {
+	CreateAPIToken(c *gofr.Context, t apitoken.Token) (apitoken.Token, error)
+	GetByIDAPIToken(c *gofr.Context, id int) (apitoken.Token, error)
+	GetByPrefixAPIToken(c *gofr.Context, prefix string) (apitoken.Token, error)
+	GetByUserIDAPIToken(c *gofr.Context, userID int) ([]apitoken.Token, error)
+	TouchAPIToken(c *gofr.Context, id int, at time.Time) error
+	DeleteAPIToken(c *gofr.Context, id int) error
+}
+
+type UserServiceInterface interface {
+	Get(c *gofr.Context, id int) (user.User, error)
+}
+
+// Policy decides whether the user making a request may use a permission over what owner owns.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=apitoken
+//
+
+// Package apitoken is a generated GoMock package.
+package apitoken
+
+import (
+	reflect "reflect"
+	time "time"
+
+	apitoken "github.com/MGajendra22/GoFr/model/apitoken"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	user "github.com/MGajendra22/GoFr/model/user"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAPITokenStoreInterface is a mock of APITokenStoreInterface interface.
+type MockAPITokenStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPITokenStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPITokenStoreInterfaceMockRecorder is the mock recorder for MockAPITokenStoreInterface.
+type MockAPITokenStoreInterfaceMockRecorder struct {
+	mock *MockAPITokenStoreInterface
+}
+
+// NewMockAPITokenStoreInterface creates a new mock instance.
+func NewMockAPITokenStoreInterface(ctrl *gomock.Controller) *MockAPITokenStoreInterface {
+	mock := &MockAPITokenStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockAPITokenStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPITokenStoreInterface) EXPECT() *MockAPITokenStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) CreateAPIToken(c *gofr.Context, t apitoken.Token) (apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", c, t)
+	ret0, _ := ret[0].(apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) CreateAPIToken(c, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).CreateAPIToken), c, t)
+}
+
+// DeleteAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) DeleteAPIToken(c *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIToken", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) DeleteAPIToken(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).DeleteAPIToken), c, id)
+}
+
+// GetByIDAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) GetByIDAPIToken(c *gofr.Context, id int) (apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDAPIToken", c, id)
+	ret0, _ := ret[0].(apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDAPIToken indicates an expected call of GetByIDAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) GetByIDAPIToken(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).GetByIDAPIToken), c, id)
+}
+
+// GetByPrefixAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) GetByPrefixAPIToken(c *gofr.Context, prefix string) (apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPrefixAPIToken", c, prefix)
+	ret0, _ := ret[0].(apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPrefixAPIToken indicates an expected call of GetByPrefixAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) GetByPrefixAPIToken(c, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrefixAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).GetByPrefixAPIToken), c, prefix)
+}
+
+// GetByUserIDAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) GetByUserIDAPIToken(c *gofr.Context, userID int) ([]apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserIDAPIToken", c, userID)
+	ret0, _ := ret[0].([]apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserIDAPIToken indicates an expected call of GetByUserIDAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) GetByUserIDAPIToken(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).GetByUserIDAPIToken), c, userID)
+}
+
+// TouchAPIToken mocks base method.
+func (m *MockAPITokenStoreInterface) TouchAPIToken(c *gofr.Context, id int, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIToken", c, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIToken indicates an expected call of TouchAPIToken.
+func (mr *MockAPITokenStoreInterfaceMockRecorder) TouchAPIToken(c, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIToken", reflect.TypeOf((*MockAPITokenStoreInterface)(nil).TouchAPIToken), c, id, at)
+}
+
+// MockUserServiceInterface is a mock of UserServiceInterface interface.
+type MockUserServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceInterfaceMockRecorder is the mock recorder for MockUserServiceInterface.
+type MockUserServiceInterfaceMockRecorder struct {
+	mock *MockUserServiceInterface
+}
+
+// NewMockUserServiceInterface creates a new mock instance.
+func NewMockUserServiceInterface(ctrl *gomock.Controller) *MockUserServiceInterface {
+	mock := &MockUserServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockUserServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserServiceInterface) EXPECT() *MockUserServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUserServiceInterface) Get(c *gofr.Context, id int) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserServiceInterface)(nil).Get), c, id)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
@@ -0,0 +1,176 @@
+package apitoken
+
+import (
+	"crypto/subtle"
+	"errors"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+// TouchInterval is how often the last use of a token is recorded at most, sparing a write on every request.
+const TouchInterval = time.Minute
+
+// ErrInvalidAPIToken is returned by Verify for a token that is malformed, unknown, expired or revoked.
+var ErrInvalidAPIToken = errs.Unauthorized{Reason: "invalid, expired or revoked api token"}
+
+type APITokenService struct {
+	str    APITokenStoreInterface
+	users  UserServiceInterface
+	policy Policy
+	now    func() time.Time
+}
+
+// Option configures optional collaborators of APITokenService.
+type Option func(*APITokenService)
+
+// WithPolicy makes the service check with p that the user making a request may issue, list or revoke tokens.
+func WithPolicy(p Policy) Option {
+	return func(s *APITokenService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock tokens are stamped and checked against.
+func WithClock(now func() time.Time) Option {
+	return func(s *APITokenService) {
+		s.now = now
+	}
+}
+
+func NewService(s APITokenStoreInterface, users UserServiceInterface, opts ...Option) *APITokenService {
+	svc := &APITokenService{
+		str:   s,
+		users: users,
+		now:   svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Issue creates a token for a user with the name, scopes and expiry of t. The secret token is only part of the
+// result, and cannot be read again.
+func (s *APITokenService) Issue(c *gofr.Context, userID int, t apitoken.Token) (apitoken.Issued, error) {
+	if err := t.Validate(); err != nil {
+		return apitoken.Issued{}, err
+	}
+
+	now := s.now()
+
+	if t.ExpiresAt != nil {
+		at := t.ExpiresAt.UTC().Truncate(time.Second)
+		if !at.After(now) {
+			return apitoken.Issued{}, errs.Validation{Field: "expires_at", Reason: "must be in the future"}
+		}
+
+		t.ExpiresAt = &at
+	}
+
+	if _, err := s.users.Get(c, userID); err != nil {
+		return apitoken.Issued{}, err
+	}
+
+	if err := s.authorize(c, policy.TokenIssue, userID); err != nil {
+		return apitoken.Issued{}, err
+	}
+
+	prefix, secret, err := apitoken.Generate()
+	if err != nil {
+		return apitoken.Issued{}, err
+	}
+
+	t.ID = 0
+	t.UserID = userID
+	t.Prefix = prefix
+	t.Hash = apitoken.Hash(secret)
+	t.LastUsedAt = nil
+	t.CreatedAt = now
+
+	t, err = s.str.CreateAPIToken(c, t)
+	if err != nil {
+		return apitoken.Issued{}, err
+	}
+
+	return apitoken.Issued{Token: t, Secret: secret}, nil
+}
+
+// List returns the tokens of a user, newest first.
+func (s *APITokenService) List(c *gofr.Context, userID int) ([]apitoken.Token, error) {
+	if _, err := s.users.Get(c, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorize(c, policy.TokenManage, userID); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetByUserIDAPIToken(c, userID)
+}
+
+// Revoke deletes a token of a user, which stops working at once. A token of another user is not found.
+func (s *APITokenService) Revoke(c *gofr.Context, userID, id int) error {
+	t, err := s.str.GetByIDAPIToken(c, id)
+	if err != nil {
+		return err
+	}
+
+	if t.UserID != userID {
+		return errs.NotFound{Entity: "api token", ID: id}
+	}
+
+	if err := s.authorize(c, policy.TokenManage, userID); err != nil {
+		return err
+	}
+
+	return s.str.DeleteAPIToken(c, id)
+}
+
+// Verify returns the token a request carries, and records its use. It is called by the Authenticate middleware.
+func (s *APITokenService) Verify(c *gofr.Context, token string) (apitoken.Token, error) {
+	prefix, ok := apitoken.ParsePrefix(token)
+	if !ok {
+		return apitoken.Token{}, ErrInvalidAPIToken
+	}
+
+	t, err := s.str.GetByPrefixAPIToken(c, prefix)
+	if errors.As(err, &errs.NotFound{}) {
+		return apitoken.Token{}, ErrInvalidAPIToken
+	}
+
+	if err != nil {
+		return apitoken.Token{}, err
+	}
+
+	now := s.now()
+
+	if subtle.ConstantTimeCompare([]byte(apitoken.Hash(token)), []byte(t.Hash)) != 1 || t.Expired(now) {
+		return apitoken.Token{}, ErrInvalidAPIToken
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= TouchInterval {
+		// a token still works when its use cannot be recorded
+		if err := s.str.TouchAPIToken(c, t.ID, now); err != nil {
+			c.Errorf("recording use of api token %d: %v", t.ID, err)
+		} else {
+			t.LastUsedAt = &now
+		}
+	}
+
+	return t, nil
+}
+
+// authorize asks the policy, if any, whether the user making the request may use perm on the tokens of owner.
+func (s *APITokenService) authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, perm, owner)
+}
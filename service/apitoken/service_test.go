@@ -0,0 +1,261 @@
+package apitoken
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+func Test_Issue(t *testing.T) {
+	later := stamp.Add(24 * time.Hour)
+	earlier := stamp.Add(-time.Hour)
+	read := []apitoken.Scope{apitoken.ScopeTasksRead}
+
+	tests := []struct {
+		name    string
+		input   apitoken.Token
+		ifGet   bool
+		getErr  error
+		authErr error
+		ifStore bool
+		expErr  error
+	}{
+		{name: "Valid Token", input: apitoken.Token{Name: "CI", Scopes: read, ExpiresAt: &later},
+			ifGet: true, ifStore: true},
+		{name: "Missing Name", input: apitoken.Token{Scopes: read},
+			expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "Missing Scopes", input: apitoken.Token{Name: "CI"},
+			expErr: errs.Validation{Field: "scopes", Reason: "must not be empty"}},
+		{name: "Unknown Scope", input: apitoken.Token{Name: "CI", Scopes: []apitoken.Scope{"tasks:*"}},
+			expErr: errs.Validation{Field: "scopes", Reason: "must be among tasks:read, tasks:write, users:read, users:admin"}},
+		{name: "Past Expiry", input: apitoken.Token{Name: "CI", Scopes: read, ExpiresAt: &earlier},
+			expErr: errs.Validation{Field: "expires_at", Reason: "must be in the future"}},
+		{name: "User Not Found", input: apitoken.Token{Name: "CI", Scopes: read}, ifGet: true,
+			getErr: errs.NotFound{Entity: "user", ID: 3}, expErr: errs.NotFound{Entity: "user", ID: 3}},
+		{name: "Someone Else", input: apitoken.Token{Name: "CI", Scopes: read}, ifGet: true,
+			authErr: errs.Forbidden{Permission: "token:issue"}, expErr: errs.Forbidden{Permission: "token:issue"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockAPITokenStoreInterface(ctrl)
+		mockUsers := NewMockUserServiceInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, mockUsers, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.ifGet {
+			mockUsers.EXPECT().Get(ctx, 3).Return(user.User{ID: 3}, tt.getErr)
+
+			if tt.getErr == nil {
+				mockPolicy.EXPECT().Authorize(ctx, policy.TokenIssue, 3).Return(tt.authErr)
+			}
+		}
+
+		var stored apitoken.Token
+
+		if tt.ifStore {
+			mockStore.EXPECT().CreateAPIToken(ctx, gomock.Any()).DoAndReturn(
+				func(_ *gofr.Context, tk apitoken.Token) (apitoken.Token, error) {
+					stored = tk
+					tk.ID = 4
+
+					return tk, nil
+				})
+		}
+
+		res, err := service.Issue(ctx, 3, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr != nil {
+			continue
+		}
+
+		prefix, ok := apitoken.ParsePrefix(res.Secret)
+		assert.True(t, ok, tt.name)
+		assert.True(t, strings.HasPrefix(prefix, apitoken.Prefix), tt.name)
+
+		// only the hash of the token is stored
+		assert.Equal(t, prefix, stored.Prefix, tt.name)
+		assert.Equal(t, apitoken.Hash(res.Secret), stored.Hash, tt.name)
+		assert.NotContains(t, stored.Hash, res.Secret, tt.name)
+
+		assert.Equal(t, 4, res.ID, tt.name)
+		assert.Equal(t, 3, res.UserID, tt.name)
+		assert.Equal(t, stamp, res.CreatedAt, tt.name)
+		assert.Equal(t, later, *res.ExpiresAt, tt.name)
+	}
+}
+
+func Test_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAPITokenStoreInterface(ctrl)
+	mockUsers := NewMockUserServiceInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewService(mockStore, mockUsers, WithPolicy(mockPolicy), fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tokens := []apitoken.Token{{ID: 4, UserID: 3, Name: "CI"}}
+
+	mockUsers.EXPECT().Get(ctx, 3).Return(user.User{ID: 3}, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TokenManage, 3).Return(nil)
+	mockStore.EXPECT().GetByUserIDAPIToken(ctx, 3).Return(tokens, nil)
+
+	res, err := service.List(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, tokens, res)
+
+	mockUsers.EXPECT().Get(ctx, 3).Return(user.User{ID: 3}, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TokenManage, 3).Return(errs.Forbidden{Permission: "token:manage"})
+
+	_, err = service.List(ctx, 3)
+	assert.Equal(t, errs.Forbidden{Permission: "token:manage"}, err)
+
+	mockUsers.EXPECT().Get(ctx, 9).Return(user.User{}, errs.NotFound{Entity: "user", ID: 9})
+
+	_, err = service.List(ctx, 9)
+	assert.Equal(t, errs.NotFound{Entity: "user", ID: 9}, err)
+}
+
+func Test_Revoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  int
+		getErr  error
+		ifAuth  bool
+		authErr error
+		ifDel   bool
+		expErr  error
+	}{
+		{name: "Own Token", userID: 3, ifAuth: true, ifDel: true},
+		{name: "Token Of Another User", userID: 5, expErr: errs.NotFound{Entity: "api token", ID: 4}},
+		{name: "Token Not Found", userID: 3, getErr: errs.NotFound{Entity: "api token", ID: 4},
+			expErr: errs.NotFound{Entity: "api token", ID: 4}},
+		{name: "Denied", userID: 3, ifAuth: true, authErr: errs.Forbidden{Permission: "token:manage"},
+			expErr: errs.Forbidden{Permission: "token:manage"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockAPITokenStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDAPIToken(ctx, 4).Return(apitoken.Token{ID: 4, UserID: 3}, tt.getErr)
+
+		if tt.ifAuth {
+			mockPolicy.EXPECT().Authorize(ctx, policy.TokenManage, tt.userID).Return(tt.authErr)
+		}
+
+		if tt.ifDel {
+			mockStore.EXPECT().DeleteAPIToken(ctx, 4).Return(nil)
+		}
+
+		assert.Equal(t, tt.expErr, service.Revoke(ctx, tt.userID, 4), tt.name)
+	}
+}
+
+func Test_Verify(t *testing.T) {
+	prefix, secret, err := apitoken.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recently := stamp.Add(-time.Second)
+	longAgo := stamp.Add(-time.Hour)
+	expired := stamp.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		token    string
+		ifGet    bool
+		stored   apitoken.Token
+		getErr   error
+		ifTouch  bool
+		touchErr error
+		expErr   error
+	}{
+		{name: "Valid Token", token: secret, ifGet: true, stored: apitoken.Token{LastUsedAt: &longAgo}, ifTouch: true},
+		{name: "First Use", token: secret, ifGet: true, ifTouch: true},
+		{name: "Used Recently", token: secret, ifGet: true, stored: apitoken.Token{LastUsedAt: &recently}},
+		{name: "Touch Fails", token: secret, ifGet: true, ifTouch: true, touchErr: errors.New("db down")},
+		{name: "Expired", token: secret, ifGet: true, stored: apitoken.Token{ExpiresAt: &expired},
+			expErr: ErrInvalidAPIToken},
+		{name: "Wrong Secret", token: prefix + "_guessed", ifGet: true, expErr: ErrInvalidAPIToken},
+		{name: "Revoked", token: secret, ifGet: true, getErr: errs.NotFound{Entity: "api token"},
+			expErr: ErrInvalidAPIToken},
+		{name: "Store Error", token: secret, ifGet: true, getErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Not An API Token", token: "eyJhbGciOiJIUzI1NiJ9.e30.sig", expErr: ErrInvalidAPIToken},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockAPITokenStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		stored := tt.stored
+		stored.ID, stored.UserID, stored.Prefix, stored.Hash = 4, 3, prefix, apitoken.Hash(secret)
+
+		if tt.ifGet {
+			mockStore.EXPECT().GetByPrefixAPIToken(ctx, prefix).Return(stored, tt.getErr)
+		}
+
+		if tt.ifTouch {
+			mockStore.EXPECT().TouchAPIToken(ctx, 4, stamp).Return(tt.touchErr)
+		}
+
+		res, err := service.Verify(ctx, tt.token)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 3, res.UserID, tt.name)
+		}
+	}
+}
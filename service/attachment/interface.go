@@ -0,0 +1,33 @@
+package attachment
+
+import (
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type AttachmentStoreInterface interface {
+	CreateAttachment(c *gofr.Context, a attachment.Attachment) (attachment.Attachment, error)
+	GetByIDAttachment(c *gofr.Context, id int) (attachment.Attachment, error)
+	GetByTaskIDAttachment(c *gofr.Context, taskID int) ([]attachment.Attachment, error)
+	DeleteAttachment(c *gofr.Context, id int) error
+	PurgeAttachment(c *gofr.Context, before time.Time) ([]attachment.Attachment, error)
+}
+
+type TaskServiceInterface interface {
+	GetTask(c *gofr.Context, id int) (task.Task, error)
+}
+
+// Storage keeps the contents of attachments by key.
+type Storage interface {
+	Put(c *gofr.Context, key string, content []byte) error
+	Get(c *gofr.Context, key string) ([]byte, error)
+	Delete(c *gofr.Context, key string) error
+}
+
+// Policy decides whether the user making a request may use a permission on a task assigned to owner.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -0,0 +1,263 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=attachment
+//
+
+// Package attachment is a generated GoMock package.
+package attachment
+
+import (
+	reflect "reflect"
+	time "time"
+
+	attachment "github.com/MGajendra22/GoFr/model/attachment"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	task "github.com/MGajendra22/GoFr/model/task"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAttachmentStoreInterface is a mock of AttachmentStoreInterface interface.
+type MockAttachmentStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentStoreInterfaceMockRecorder is the mock recorder for MockAttachmentStoreInterface.
+type MockAttachmentStoreInterfaceMockRecorder struct {
+	mock *MockAttachmentStoreInterface
+}
+
+// NewMockAttachmentStoreInterface creates a new mock instance.
+func NewMockAttachmentStoreInterface(ctrl *gomock.Controller) *MockAttachmentStoreInterface {
+	mock := &MockAttachmentStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentStoreInterface) EXPECT() *MockAttachmentStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAttachment mocks base method.
+func (m *MockAttachmentStoreInterface) CreateAttachment(c *gofr.Context, a attachment.Attachment) (attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", c, a)
+	ret0, _ := ret[0].(attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockAttachmentStoreInterfaceMockRecorder) CreateAttachment(c, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentStoreInterface)(nil).CreateAttachment), c, a)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentStoreInterface) DeleteAttachment(c *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentStoreInterfaceMockRecorder) DeleteAttachment(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentStoreInterface)(nil).DeleteAttachment), c, id)
+}
+
+// GetByIDAttachment mocks base method.
+func (m *MockAttachmentStoreInterface) GetByIDAttachment(c *gofr.Context, id int) (attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDAttachment", c, id)
+	ret0, _ := ret[0].(attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDAttachment indicates an expected call of GetByIDAttachment.
+func (mr *MockAttachmentStoreInterfaceMockRecorder) GetByIDAttachment(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDAttachment", reflect.TypeOf((*MockAttachmentStoreInterface)(nil).GetByIDAttachment), c, id)
+}
+
+// GetByTaskIDAttachment mocks base method.
+func (m *MockAttachmentStoreInterface) GetByTaskIDAttachment(c *gofr.Context, taskID int) ([]attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTaskIDAttachment", c, taskID)
+	ret0, _ := ret[0].([]attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTaskIDAttachment indicates an expected call of GetByTaskIDAttachment.
+func (mr *MockAttachmentStoreInterfaceMockRecorder) GetByTaskIDAttachment(c, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTaskIDAttachment", reflect.TypeOf((*MockAttachmentStoreInterface)(nil).GetByTaskIDAttachment), c, taskID)
+}
+
+// PurgeAttachment mocks base method.
+func (m *MockAttachmentStoreInterface) PurgeAttachment(c *gofr.Context, before time.Time) ([]attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAttachment", c, before)
+	ret0, _ := ret[0].([]attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAttachment indicates an expected call of PurgeAttachment.
+func (mr *MockAttachmentStoreInterfaceMockRecorder) PurgeAttachment(c, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAttachment", reflect.TypeOf((*MockAttachmentStoreInterface)(nil).PurgeAttachment), c, before)
+}
+
+// MockTaskServiceInterface is a mock of TaskServiceInterface interface.
+type MockTaskServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskServiceInterfaceMockRecorder is the mock recorder for MockTaskServiceInterface.
+type MockTaskServiceInterfaceMockRecorder struct {
+	mock *MockTaskServiceInterface
+}
+
+// NewMockTaskServiceInterface creates a new mock instance.
+func NewMockTaskServiceInterface(ctrl *gomock.Controller) *MockTaskServiceInterface {
+	mock := &MockTaskServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskServiceInterface) EXPECT() *MockTaskServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetTask mocks base method.
+func (m *MockTaskServiceInterface) GetTask(c *gofr.Context, id int) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", c, id)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTask), c, id)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStorage) Delete(c *gofr.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageMockRecorder) Delete(c, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), c, key)
+}
+
+// Get mocks base method.
+func (m *MockStorage) Get(c *gofr.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStorageMockRecorder) Get(c, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStorage)(nil).Get), c, key)
+}
+
+// Put mocks base method.
+func (m *MockStorage) Put(c *gofr.Context, key string, content []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", c, key, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStorageMockRecorder) Put(c, key, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStorage)(nil).Put), c, key, content)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
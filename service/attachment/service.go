@@ -0,0 +1,268 @@
+package attachment
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// maxFilenameLength is the longest filename kept, in bytes, as stored.
+const maxFilenameLength = 255
+
+type AttachmentService struct {
+	str     AttachmentStoreInterface
+	tasks   TaskServiceInterface
+	storage Storage
+	limits  attachment.Limits
+	policy  Policy
+	now     func() time.Time
+}
+
+// Option configures optional collaborators of AttachmentService.
+type Option func(*AttachmentService)
+
+// WithPolicy checks every upload and removal of an attachment against an access policy, as an edit of its task.
+// Without one, anyone may attach files to any task and remove them.
+func WithPolicy(p Policy) Option {
+	return func(s *AttachmentService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp attachments.
+func WithClock(now func() time.Time) Option {
+	return func(s *AttachmentService) {
+		s.now = now
+	}
+}
+
+func NewService(s AttachmentStoreInterface, tasks TaskServiceInterface, storage Storage, limits attachment.Limits,
+	opts ...Option) *AttachmentService {
+	svc := &AttachmentService{
+		str:     s,
+		tasks:   tasks,
+		storage: storage,
+		limits:  limits,
+		now:     svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Upload attaches the file read from r to a task. Its content type is sniffed from the content rather than taken
+// from the client, and both it and the size must be within the limits of the service.
+func (s *AttachmentService) Upload(c *gofr.Context, taskID int, filename string, r io.Reader) (attachment.Attachment, error) {
+	name, err := cleanFilename(filename)
+	if err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	t, err := s.tasks.GetTask(c, taskID)
+	if err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	if err := s.authorize(c, t); err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r, s.limits.MaxSize+1))
+	if err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	if len(content) == 0 {
+		return attachment.Attachment{}, errs.Validation{Field: "file", Reason: "must not be empty"}
+	}
+
+	if int64(len(content)) > s.limits.MaxSize {
+		return attachment.Attachment{}, errs.Validation{Field: "file", Reason: fmt.Sprintf("must be at most %d bytes", s.limits.MaxSize)}
+	}
+
+	ct, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	if !s.limits.Allows(ct) {
+		return attachment.Attachment{}, errs.Validation{Field: "file", Reason: fmt.Sprintf("content type %s is not allowed", ct)}
+	}
+
+	key, err := storageKey(taskID)
+	if err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	sum := sha256.Sum256(content)
+
+	a := attachment.Attachment{
+		TaskID:      taskID,
+		Filename:    name,
+		Size:        int64(len(content)),
+		ContentType: ct,
+		Checksum:    hex.EncodeToString(sum[:]),
+		StorageKey:  key,
+		CreatedAt:   s.now(),
+	}
+
+	if err := s.storage.Put(c, key, content); err != nil {
+		return attachment.Attachment{}, err
+	}
+
+	a, err = s.str.CreateAttachment(c, a)
+	if err != nil {
+		s.discard(c, key)
+
+		return attachment.Attachment{}, err
+	}
+
+	return a, nil
+}
+
+// List returns the attachments of a task, oldest first.
+func (s *AttachmentService) List(c *gofr.Context, taskID int) ([]attachment.Attachment, error) {
+	if _, err := s.tasks.GetTask(c, taskID); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetByTaskIDAttachment(c, taskID)
+}
+
+// Download returns an attachment of a task along with its content.
+func (s *AttachmentService) Download(c *gofr.Context, taskID, id int) (attachment.Attachment, []byte, error) {
+	_, a, err := s.get(c, taskID, id)
+	if err != nil {
+		return a, nil, err
+	}
+
+	content, err := s.storage.Get(c, a.StorageKey)
+	if err != nil {
+		return a, nil, err
+	}
+
+	return a, content, nil
+}
+
+// Delete removes an attachment of a task. Its metadata goes first, so a failure to remove the content leaves it
+// orphaned in the storage rather than listed but unreadable.
+func (s *AttachmentService) Delete(c *gofr.Context, taskID, id int) error {
+	t, a, err := s.get(c, taskID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, t); err != nil {
+		return err
+	}
+
+	if err := s.str.DeleteAttachment(c, id); err != nil {
+		return err
+	}
+
+	s.discard(c, a.StorageKey)
+
+	return nil
+}
+
+// Purge removes the attachments of the tasks that have been in the trash for longer than retention and returns how
+// many were removed, so that purging the tasks leaves none of their content behind. As in Delete, the metadata goes
+// first.
+func (s *AttachmentService) Purge(c *gofr.Context, retention time.Duration) (int, error) {
+	purged, err := s.str.PurgeAttachment(c, s.now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, a := range purged {
+		s.discard(c, a.StorageKey)
+	}
+
+	return len(purged), nil
+}
+
+// get reads an attachment of a task, along with the task. An attachment of another task, or of a task that cannot be
+// read, is not found.
+func (s *AttachmentService) get(c *gofr.Context, taskID, id int) (task.Task, attachment.Attachment, error) {
+	t, err := s.tasks.GetTask(c, taskID)
+	if err != nil {
+		return t, attachment.Attachment{}, err
+	}
+
+	a, err := s.str.GetByIDAttachment(c, id)
+	if err != nil {
+		return t, a, err
+	}
+
+	if a.TaskID != taskID {
+		return t, a, errs.NotFound{Entity: "attachment", ID: id}
+	}
+
+	return t, a, nil
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may change the
+// attachments of t, which takes editing t.
+func (s *AttachmentService) authorize(c *gofr.Context, t task.Task) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, policy.TaskUpdate, t.Userid)
+}
+
+// discard removes content from the storage on a best effort basis.
+func (s *AttachmentService) discard(c *gofr.Context, key string) {
+	if err := s.storage.Delete(c, key); err != nil {
+		c.Errorf("removing attachment content %s: %v", key, err)
+	}
+}
+
+// cleanFilename keeps the base name of a client supplied filename, without control characters.
+func cleanFilename(filename string) (string, error) {
+	name := strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, path.Base(strings.ReplaceAll(filename, "\\", "/"))))
+
+	if name == "" || name == "." || name == "/" {
+		return "", errs.Validation{Field: "filename", Reason: "must not be empty"}
+	}
+
+	if len(name) > maxFilenameLength {
+		return "", errs.Validation{Field: "filename", Reason: fmt.Sprintf("must be at most %d bytes", maxFilenameLength)}
+	}
+
+	return name, nil
+}
+
+// storageKey returns a new random key for content attached to a task.
+func storageKey(taskID int) (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("tasks/%d/%s", taskID, hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,387 @@
+package attachment
+
+import (
+	"bytes"
+	"errors"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+	limits     = attachment.Limits{MaxSize: 16, ContentTypes: []string{"text/plain", "image/*"}}
+)
+
+// pngHeader is the signature content is sniffed as a PNG image by
+const pngHeader = "\x89PNG\r\n\x1a\n"
+
+func Test_Upload(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		taskErr  error
+		storeErr error
+		expType  string
+		expName  string
+		expErr   error
+	}{
+		{
+			name:     "Text File",
+			filename: "app.log",
+			content:  "hello",
+			expType:  "text/plain",
+			expName:  "app.log",
+		},
+		{
+			name:     "Wildcard Type",
+			filename: "shot.png",
+			content:  pngHeader + "data",
+			expType:  "image/png",
+			expName:  "shot.png",
+		},
+		{
+			name:     "Path Stripped",
+			filename: "../../etc/app.log",
+			content:  "hello",
+			expType:  "text/plain",
+			expName:  "app.log",
+		},
+		{
+			name:     "Empty Filename",
+			filename: " ",
+			content:  "hello",
+			expErr:   errs.Validation{Field: "filename", Reason: "must not be empty"},
+		},
+		{
+			name:     "Task Not Found",
+			filename: "app.log",
+			content:  "hello",
+			taskErr:  errs.NotFound{Entity: "task", ID: 1},
+			expErr:   errs.NotFound{Entity: "task", ID: 1},
+		},
+		{
+			name:     "Empty File",
+			filename: "app.log",
+			expErr:   errs.Validation{Field: "file", Reason: "must not be empty"},
+		},
+		{
+			name:     "Too Large",
+			filename: "app.log",
+			content:  strings.Repeat("a", 17),
+			expErr:   errs.Validation{Field: "file", Reason: "must be at most 16 bytes"},
+		},
+		{
+			name:     "Type Not Allowed",
+			filename: "page.html",
+			content:  "<html></html>",
+			expErr:   errs.Validation{Field: "file", Reason: "content type text/html is not allowed"},
+		},
+		{
+			name:     "Store Error",
+			filename: "app.log",
+			content:  "hello",
+			storeErr: errors.New("Insert failed"),
+			expErr:   errors.New("Insert failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockAttachmentStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+		files := storage.NewMemory()
+
+		service := NewService(mockStore, mockTasks, files, limits, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if strings.TrimSpace(tt.filename) != "" {
+			mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, tt.taskErr)
+		}
+
+		var key string
+
+		if tt.expType != "" || tt.storeErr != nil {
+			mockStore.EXPECT().CreateAttachment(ctx, gomock.Any()).DoAndReturn(
+				func(_ *gofr.Context, a attachment.Attachment) (attachment.Attachment, error) {
+					key = a.StorageKey
+					a.ID = 4
+
+					return a, tt.storeErr
+				})
+		}
+
+		res, err := service.Upload(ctx, 1, tt.filename, strings.NewReader(tt.content))
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+
+			if key != "" {
+				_, err := files.Get(ctx, key)
+				assert.Error(t, err, tt.name+": content must be discarded")
+			}
+
+			continue
+		}
+
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, 4, res.ID, tt.name)
+		assert.Equal(t, tt.expName, res.Filename, tt.name)
+		assert.Equal(t, tt.expType, res.ContentType, tt.name)
+		assert.Equal(t, int64(len(tt.content)), res.Size, tt.name)
+		assert.Len(t, res.Checksum, 64, tt.name)
+		assert.Equal(t, stamp, res.CreatedAt, tt.name)
+		assert.True(t, strings.HasPrefix(key, "tasks/1/"), tt.name)
+
+		content, err := files.Get(ctx, key)
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.content, string(content), tt.name)
+	}
+}
+
+func Test_UploadChecksum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+
+	service := NewService(mockStore, mockTasks, storage.NewMemory(), limits, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil)
+	mockStore.EXPECT().CreateAttachment(ctx, gomock.Any()).DoAndReturn(
+		func(_ *gofr.Context, a attachment.Attachment) (attachment.Attachment, error) {
+			return a, nil
+		})
+
+	res, err := service.Upload(ctx, 1, "hello.txt", bytes.NewBufferString("hello"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", res.Checksum)
+}
+
+func Test_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+
+	service := NewService(mockStore, mockTasks, storage.NewMemory(), limits)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockTasks.EXPECT().GetTask(ctx, 9).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 9})
+
+	_, err := service.List(ctx, 9)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 9}, err)
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil)
+	mockStore.EXPECT().GetByTaskIDAttachment(ctx, 1).Return([]attachment.Attachment{{ID: 4, TaskID: 1}}, nil)
+
+	res, err := service.List(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+}
+
+func Test_Download(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+	files := storage.NewMemory()
+
+	service := NewService(mockStore, mockTasks, files, limits)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	_ = files.Put(ctx, "tasks/1/ab12", []byte("hello"))
+
+	mockTasks.EXPECT().GetTask(ctx, 3).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 3})
+
+	_, _, err := service.Download(ctx, 3, 9)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 3}, err, "attachments of tasks that cannot be read")
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil).AnyTimes()
+	mockStore.EXPECT().GetByIDAttachment(ctx, 9).Return(attachment.Attachment{}, errs.NotFound{Entity: "attachment", ID: 9})
+
+	_, _, err = service.Download(ctx, 1, 9)
+	assert.Equal(t, errs.NotFound{Entity: "attachment", ID: 9}, err)
+
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 2, StorageKey: "tasks/2/cd34"}, nil)
+
+	_, _, err = service.Download(ctx, 1, 4)
+	assert.Equal(t, errs.NotFound{Entity: "attachment", ID: 4}, err, "attachment of another task")
+
+	mockStore.EXPECT().GetByIDAttachment(ctx, 5).Return(attachment.Attachment{ID: 5, TaskID: 1, StorageKey: "tasks/1/gone"}, nil)
+
+	_, _, err = service.Download(ctx, 1, 5)
+	assert.Error(t, err, "content missing from storage")
+
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"}, nil)
+
+	a, content, err := service.Download(ctx, 1, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, a.ID)
+	assert.Equal(t, "hello", string(content))
+}
+
+func Test_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+	files := storage.NewMemory()
+
+	service := NewService(mockStore, mockTasks, files, limits)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	_ = files.Put(ctx, "tasks/1/ab12", []byte("hello"))
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil).AnyTimes()
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 2}, nil)
+
+	err := service.Delete(ctx, 1, 4)
+	assert.Equal(t, errs.NotFound{Entity: "attachment", ID: 4}, err)
+
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"}, nil)
+	mockStore.EXPECT().DeleteAttachment(ctx, 4).Return(errors.New("Delete failed"))
+
+	err = service.Delete(ctx, 1, 4)
+	assert.Error(t, err)
+
+	_, err = files.Get(ctx, "tasks/1/ab12")
+	assert.NoError(t, err, "content kept while its metadata is")
+
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"}, nil)
+	mockStore.EXPECT().DeleteAttachment(ctx, 4).Return(nil)
+
+	err = service.Delete(ctx, 1, 4)
+	assert.NoError(t, err)
+
+	_, err = files.Get(ctx, "tasks/1/ab12")
+	assert.Error(t, err, "content removed")
+}
+
+func Test_Policy(t *testing.T) {
+	owned := task.Task{ID: 1, Userid: 3}
+	denied := errs.Forbidden{Permission: "task:update"}
+
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+	files := storage.NewMemory()
+
+	service := NewService(mockStore, mockTasks, files, limits, fixedClock, WithPolicy(mockPolicy))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// attachments are changed by those who may edit their task
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(owned, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 3).Return(denied)
+
+	_, err := service.Upload(ctx, 1, "app.log", strings.NewReader("hello"))
+	assert.Equal(t, denied, err)
+
+	_ = files.Put(ctx, "tasks/1/ab12", []byte("hello"))
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(owned, nil)
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"}, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 3).Return(denied)
+
+	assert.Equal(t, denied, service.Delete(ctx, 1, 4))
+
+	_, err = files.Get(ctx, "tasks/1/ab12")
+	assert.NoError(t, err, "content kept when the removal is denied")
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(owned, nil)
+	mockStore.EXPECT().GetByIDAttachment(ctx, 4).Return(attachment.Attachment{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"}, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 3).Return(nil)
+	mockStore.EXPECT().DeleteAttachment(ctx, 4).Return(nil)
+
+	assert.NoError(t, service.Delete(ctx, 1, 4))
+}
+
+func Test_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAttachmentStoreInterface(ctrl)
+	files := storage.NewMemory()
+
+	service := NewService(mockStore, nil, files, limits, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	_ = files.Put(ctx, "tasks/1/ab12", []byte("hello"))
+	_ = files.Put(ctx, "tasks/2/cd34", []byte("world"))
+
+	before := stamp.Add(-720 * time.Hour)
+
+	mockStore.EXPECT().PurgeAttachment(ctx, before).Return(nil, errors.New("Delete failed"))
+
+	_, err := service.Purge(ctx, 720*time.Hour)
+	assert.Error(t, err)
+
+	_, err = files.Get(ctx, "tasks/1/ab12")
+	assert.NoError(t, err, "content kept while its metadata is")
+
+	mockStore.EXPECT().PurgeAttachment(ctx, before).Return([]attachment.Attachment{
+		{ID: 4, TaskID: 1, StorageKey: "tasks/1/ab12"},
+		{ID: 5, TaskID: 2, StorageKey: "tasks/2/missing"},
+	}, nil)
+
+	n, err := service.Purge(ctx, 720*time.Hour)
+	assert.NoError(t, err, "content already missing is not an error")
+	assert.Equal(t, 2, n)
+
+	_, err = files.Get(ctx, "tasks/1/ab12")
+	assert.Error(t, err, "content of purged attachments removed")
+
+	_, err = files.Get(ctx, "tasks/2/cd34")
+	assert.NoError(t, err, "content of other attachments kept")
+}
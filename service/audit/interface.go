@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"gofr.dev/pkg/gofr"
+)
+
+type AuditStoreInterface interface {
+	AppendAudit(c *gofr.Context, e audit.Entry) (audit.Entry, error)
+	GetAllAudit(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error)
+	GetRangeAudit(c *gofr.Context, after, limit int) ([]audit.Entry, error)
+	GetHeadAudit(c *gofr.Context) (seq int, hash string, err error)
+}
+
+// Policy decides whether the user making a request may use a permission.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=audit
+//
+
+// Package audit is a generated GoMock package.
+package audit
+
+import (
+	reflect "reflect"
+
+	audit "github.com/MGajendra22/GoFr/model/audit"
+	page "github.com/MGajendra22/GoFr/model/page"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockAuditStoreInterface is a mock of AuditStoreInterface interface.
+type MockAuditStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditStoreInterfaceMockRecorder is the mock recorder for MockAuditStoreInterface.
+type MockAuditStoreInterfaceMockRecorder struct {
+	mock *MockAuditStoreInterface
+}
+
+// NewMockAuditStoreInterface creates a new mock instance.
+func NewMockAuditStoreInterface(ctrl *gomock.Controller) *MockAuditStoreInterface {
+	mock := &MockAuditStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditStoreInterface) EXPECT() *MockAuditStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AppendAudit mocks base method.
+func (m *MockAuditStoreInterface) AppendAudit(c *gofr.Context, e audit.Entry) (audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAudit", c, e)
+	ret0, _ := ret[0].(audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendAudit indicates an expected call of AppendAudit.
+func (mr *MockAuditStoreInterfaceMockRecorder) AppendAudit(c, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAudit", reflect.TypeOf((*MockAuditStoreInterface)(nil).AppendAudit), c, e)
+}
+
+// GetAllAudit mocks base method.
+func (m *MockAuditStoreInterface) GetAllAudit(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAudit", c, f, q)
+	ret0, _ := ret[0].(page.Page[audit.Entry])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAudit indicates an expected call of GetAllAudit.
+func (mr *MockAuditStoreInterfaceMockRecorder) GetAllAudit(c, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAudit", reflect.TypeOf((*MockAuditStoreInterface)(nil).GetAllAudit), c, f, q)
+}
+
+// GetHeadAudit mocks base method.
+func (m *MockAuditStoreInterface) GetHeadAudit(c *gofr.Context) (int, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeadAudit", c)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHeadAudit indicates an expected call of GetHeadAudit.
+func (mr *MockAuditStoreInterfaceMockRecorder) GetHeadAudit(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadAudit", reflect.TypeOf((*MockAuditStoreInterface)(nil).GetHeadAudit), c)
+}
+
+// GetRangeAudit mocks base method.
+func (m *MockAuditStoreInterface) GetRangeAudit(c *gofr.Context, after, limit int) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRangeAudit", c, after, limit)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRangeAudit indicates an expected call of GetRangeAudit.
+func (mr *MockAuditStoreInterfaceMockRecorder) GetRangeAudit(c, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRangeAudit", reflect.TypeOf((*MockAuditStoreInterface)(nil).GetRangeAudit), c, after, limit)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+// verifyBatch is the number of entries read at a time while verifying the log.
+const verifyBatch = 500
+
+type AuditService struct {
+	str    AuditStoreInterface
+	policy Policy
+	now    func() time.Time
+}
+
+// Option configures optional collaborators of AuditService.
+type Option func(*AuditService)
+
+// WithPolicy checks reads of the audit log against an access policy. Without one, anyone may read it.
+func WithPolicy(p Policy) Option {
+	return func(s *AuditService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp entries.
+func WithClock(now func() time.Time) Option {
+	return func(s *AuditService) {
+		s.now = now
+	}
+}
+
+func NewService(s AuditStoreInterface, opts ...Option) *AuditService {
+	svc := &AuditService{
+		str: s,
+		now: svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Record appends to the log an action on an entity made by the user making the request, with data describing it.
+func (s *AuditService) Record(c *gofr.Context, entity string, id int, action string, data any) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	e := audit.Entry{Entity: entity, EntityID: id, Action: action, ActorID: middleware.GetActor(c), At: s.now(), Data: b}
+
+	_, err = s.str.AppendAudit(c, e)
+
+	return err
+}
+
+// All returns a page of the entries of the workspace of the request matching the filter.
+func (s *AuditService) All(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error) {
+	if s.policy != nil {
+		if err := s.policy.Authorize(c, policy.AuditRead, 0); err != nil {
+			return page.Page[audit.Entry]{}, err
+		}
+	}
+
+	return s.str.GetAllAudit(c, f, q)
+}
+
+// Verify walks the log from its first entry and reports the first one that is missing, does not link to the entry
+// before it or does not match its hash. The head of the log is checked last, so that entries removed from the end
+// are reported too.
+func (s *AuditService) Verify(c *gofr.Context) (audit.Verification, error) {
+	var (
+		v    audit.Verification
+		last int
+		prev = audit.Genesis
+	)
+
+	for {
+		entries, err := s.str.GetRangeAudit(c, last, verifyBatch)
+		if err != nil {
+			return v, err
+		}
+
+		for _, e := range entries {
+			v.Entries++
+
+			if reason := link(e, last, prev); reason != "" {
+				return broken(v, e.ID, reason), nil
+			}
+
+			last, prev = e.ID, e.Hash
+		}
+
+		if len(entries) < verifyBatch {
+			break
+		}
+	}
+
+	seq, hash, err := s.str.GetHeadAudit(c)
+	if err != nil {
+		return v, err
+	}
+
+	if seq != last || hash != prev {
+		return broken(v, last+1, fmt.Sprintf("missing: the log ends at entry %d but %d entries were appended", last, seq)), nil
+	}
+
+	v.Valid = true
+
+	return v, nil
+}
+
+// link checks that e follows the entry numbered last with hash prev, and returns why it does not.
+func link(e audit.Entry, last int, prev string) string {
+	switch {
+	case e.ID != last+1:
+		return fmt.Sprintf("entries %d to %d are missing", last+1, e.ID-1)
+	case e.PrevHash != prev:
+		return "prev_hash does not match the hash of the entry before it"
+	case e.ComputeHash() != e.Hash:
+		return "hash does not match the content of the entry"
+	}
+
+	return ""
+}
+
+func broken(v audit.Verification, id int, reason string) audit.Verification {
+	v.BrokenAt = &id
+	v.Reason = reason
+
+	return v
+}
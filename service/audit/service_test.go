@@ -0,0 +1,246 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+// chain returns a valid log of n entries
+func chain(n int) []audit.Entry {
+	entries := make([]audit.Entry, n)
+	prev := audit.Genesis
+
+	for i := range entries {
+		e := audit.Entry{ID: i + 1, Entity: audit.EntityTask, EntityID: 1, Action: "updated", At: stamp, Data: json.RawMessage(`{}`),
+			PrevHash: prev}
+		e.Hash = e.ComputeHash()
+		entries[i], prev = e, e.Hash
+	}
+
+	return entries
+}
+
+func Test_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAuditStoreInterface(ctrl)
+
+	service := NewService(mockStore, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	token, _ := auth.Issue(secret, 7, workspace.Default, auth.TypeAccess, "", time.Now(), time.Hour)
+
+	req := httptest.NewRequest(http.MethodDelete, "/user/2", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var seen *http.Request
+
+	middleware.Authenticate(secret, nil)(nil, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   gofrHttp.NewRequest(seen),
+	}
+
+	actor := 7
+
+	mockStore.EXPECT().AppendAudit(ctx, audit.Entry{Entity: audit.EntityUser, EntityID: 2, Action: audit.ActionDeleted, ActorID: &actor,
+		At: stamp, Data: json.RawMessage(`{"before":{"id":2},"after":null}`)}).Return(audit.Entry{ID: 1}, nil)
+
+	err := service.Record(ctx, audit.EntityUser, 2, audit.ActionDeleted, audit.Change{Before: map[string]int{"id": 2}})
+	assert.NoError(t, err)
+
+	mockStore.EXPECT().AppendAudit(ctx, gomock.Any()).Return(audit.Entry{}, errors.New("db write failed"))
+
+	err = service.Record(ctx, audit.EntityUser, 2, audit.ActionDeleted, audit.Change{})
+	assert.Error(t, err)
+
+	err = service.Record(ctx, audit.EntityUser, 2, audit.ActionDeleted, make(chan int))
+	assert.Error(t, err, "data that cannot be encoded")
+}
+
+func Test_All(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAuditStoreInterface(ctrl)
+
+	service := NewService(mockStore)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	f := audit.Filter{Entity: audit.EntityTask}
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+	entries := page.Page[audit.Entry]{Items: chain(2), Total: 2, Limit: 20}
+
+	mockStore.EXPECT().GetAllAudit(ctx, f, q).Return(entries, nil)
+
+	res, err := service.All(ctx, f, q)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entries, res)
+}
+
+func Test_AllPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAuditStoreInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewService(mockStore, WithPolicy(mockPolicy))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+	entries := page.Page[audit.Entry]{Items: chain(1), Total: 1, Limit: 20}
+
+	mockPolicy.EXPECT().Authorize(ctx, policy.AuditRead, 0).Return(nil)
+	mockStore.EXPECT().GetAllAudit(ctx, audit.Filter{}, q).Return(entries, nil)
+
+	res, err := service.All(ctx, audit.Filter{}, q)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entries, res)
+
+	mockPolicy.EXPECT().Authorize(ctx, policy.AuditRead, 0).Return(errs.Forbidden{Permission: "audit:read"})
+
+	_, err = service.All(ctx, audit.Filter{}, q)
+
+	assert.Equal(t, errs.Forbidden{Permission: "audit:read"}, err)
+}
+
+func Test_Verify(t *testing.T) {
+	tampered := chain(3)
+	tampered[1].Data = json.RawMessage(`{"after":"forged"}`)
+
+	relinked := chain(3)
+	relinked[1].PrevHash = relinked[2].Hash
+	relinked[1].Hash = relinked[1].ComputeHash()
+
+	gap := chain(3)
+	gap = append(gap[:1], gap[2])
+
+	// head is the head of the log the entries were read from, if the walk gets to check it
+	type head struct {
+		seq  int
+		hash string
+	}
+
+	tests := []struct {
+		name     string
+		entries  []audit.Entry
+		head     *head
+		valid    bool
+		brokenAt int
+		reason   string
+		checked  int
+	}{
+		{name: "Empty Log", head: &head{0, audit.Genesis}, valid: true},
+		{name: "Intact Chain", entries: chain(3), head: &head{3, chain(3)[2].Hash}, valid: true, checked: 3},
+		{name: "Altered Entry", entries: tampered, brokenAt: 2, reason: "hash does not match the content of the entry", checked: 2},
+		{name: "Relinked Entry", entries: relinked, brokenAt: 2, reason: "prev_hash does not match the hash of the entry before it",
+			checked: 2},
+		{name: "Removed Entry", entries: gap, brokenAt: 3, reason: "entries 2 to 2 are missing", checked: 2},
+		{name: "Removed Tail", entries: chain(2), head: &head{3, chain(3)[2].Hash}, brokenAt: 3,
+			reason: "missing: the log ends at entry 2 but 3 entries were appended", checked: 2},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockAuditStoreInterface(ctrl)
+
+		service := NewService(mockStore)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetRangeAudit(ctx, 0, verifyBatch).Return(tt.entries, nil)
+
+		if tt.head != nil {
+			mockStore.EXPECT().GetHeadAudit(ctx).Return(tt.head.seq, tt.head.hash, nil)
+		}
+
+		v, err := service.Verify(ctx)
+
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.valid, v.Valid, tt.name)
+		assert.Equal(t, tt.checked, v.Entries, tt.name)
+		assert.Equal(t, tt.reason, v.Reason, tt.name)
+
+		if tt.brokenAt != 0 {
+			assert.Equal(t, tt.brokenAt, *v.BrokenAt, tt.name)
+		} else {
+			assert.Nil(t, v.BrokenAt, tt.name)
+		}
+	}
+}
+
+func Test_VerifyInBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockAuditStoreInterface(ctrl)
+
+	service := NewService(mockStore)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	entries := chain(verifyBatch + 1)
+
+	gomock.InOrder(
+		mockStore.EXPECT().GetRangeAudit(ctx, 0, verifyBatch).Return(entries[:verifyBatch], nil),
+		mockStore.EXPECT().GetRangeAudit(ctx, verifyBatch, verifyBatch).Return(entries[verifyBatch:], nil),
+		mockStore.EXPECT().GetHeadAudit(ctx).Return(verifyBatch+1, entries[verifyBatch].Hash, nil),
+	)
+
+	v, err := service.Verify(ctx)
+
+	assert.NoError(t, err)
+	assert.True(t, v.Valid)
+	assert.Equal(t, verifyBatch+1, v.Entries)
+
+	mockStore.EXPECT().GetRangeAudit(ctx, 0, verifyBatch).Return(nil, errors.New("Query failed"))
+
+	_, err = service.Verify(ctx)
+	assert.Error(t, err)
+}
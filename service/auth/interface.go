@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+type UserServiceInterface interface {
+	Authenticate(c *gofr.Context, email, password string) (user.User, error)
+	AddRefreshToken(c *gofr.Context, t auth.RefreshToken) error
+	UseRefreshToken(c *gofr.Context, userID int, id string) error
+	RevokeRefreshTokens(c *gofr.Context, userID int) error
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+// Default lifetimes of the tokens issued by AuthService.
+const (
+	DefaultAccessTTL  = 15 * time.Minute
+	DefaultRefreshTTL = 7 * 24 * time.Hour
+)
+
+// ErrInvalidRefreshToken is returned by Refresh for a refresh token that is malformed, forged or expired, or that was
+// exchanged or revoked already: by a logout, a new password or the deletion of its user.
+var ErrInvalidRefreshToken = errs.Unauthorized{Reason: "invalid refresh token"}
+
+type AuthService struct {
+	users      UserServiceInterface
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+// Option configures optional settings of AuthService.
+type Option func(*AuthService)
+
+// WithTTL replaces the lifetimes of access and refresh tokens.
+func WithTTL(access, refresh time.Duration) Option {
+	return func(s *AuthService) {
+		s.accessTTL, s.refreshTTL = access, refresh
+	}
+}
+
+// WithClock replaces the clock tokens are issued and checked against.
+func WithClock(now func() time.Time) Option {
+	return func(s *AuthService) {
+		s.now = now
+	}
+}
+
+// NewService returns an AuthService signing tokens with secret.
+func NewService(users UserServiceInterface, secret []byte, opts ...Option) *AuthService {
+	svc := &AuthService{
+		users:      users,
+		secret:     secret,
+		accessTTL:  DefaultAccessTTL,
+		refreshTTL: DefaultRefreshTTL,
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Login checks the credentials of a user of the workspace of the request and issues them a pair of tokens.
+func (s *AuthService) Login(c *gofr.Context, cr auth.Credentials) (auth.Tokens, error) {
+	if cr.Email == "" {
+		return auth.Tokens{}, errs.Validation{Field: "email", Reason: "must not be empty"}
+	}
+
+	if cr.Password == "" {
+		return auth.Tokens{}, errs.Validation{Field: "password", Reason: "must not be empty"}
+	}
+
+	u, err := s.users.Authenticate(c, cr.Email, cr.Password)
+	if err != nil {
+		return auth.Tokens{}, err
+	}
+
+	return s.issue(c, u.ID, workspace.ID(c))
+}
+
+// Refresh exchanges a refresh token for a new pair of tokens of the same user. Refresh tokens are rotated: the one
+// exchanged is revoked, so that it is accepted once only.
+func (s *AuthService) Refresh(c *gofr.Context, token string) (auth.Tokens, error) {
+	claims, err := auth.Verify(s.secret, token, auth.TypeRefresh, s.now())
+	if err != nil || auth.TokenID(claims) == "" {
+		return auth.Tokens{}, ErrInvalidRefreshToken
+	}
+
+	id, _ := auth.UserID(claims)
+	ws := auth.WorkspaceID(claims)
+
+	// the token is looked up in the workspace it was issued in, whichever the request names
+	c = workspace.In(c, ws)
+
+	var tokens auth.Tokens
+
+	err = sqlutil.InTx(c, func(c *gofr.Context) error {
+		if err := s.users.UseRefreshToken(c, id, auth.TokenID(claims)); err != nil {
+			return err
+		}
+
+		tokens, err = s.issue(c, id, ws)
+
+		return err
+	})
+	if errors.As(err, &errs.NotFound{}) {
+		return auth.Tokens{}, ErrInvalidRefreshToken
+	}
+
+	if err != nil {
+		return auth.Tokens{}, err
+	}
+
+	return tokens, nil
+}
+
+// Logout revokes every refresh token of the user making the request. Their access tokens are left to expire.
+func (s *AuthService) Logout(c *gofr.Context) error {
+	actor := middleware.GetActor(c)
+	if actor == nil {
+		return errs.Unauthorized{Reason: "no user to log out"}
+	}
+
+	return s.users.RevokeRefreshTokens(c, *actor)
+}
+
+// issue signs a pair of tokens for a user and keeps the refresh token, so that it is accepted.
+func (s *AuthService) issue(c *gofr.Context, userID, workspaceID int) (auth.Tokens, error) {
+	now := s.now()
+
+	access, err := auth.Issue(s.secret, userID, workspaceID, auth.TypeAccess, "", now, s.accessTTL)
+	if err != nil {
+		return auth.Tokens{}, err
+	}
+
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return auth.Tokens{}, err
+	}
+
+	rt := auth.RefreshToken{ID: hex.EncodeToString(b), UserID: userID, ExpiresAt: now.Add(s.refreshTTL), CreatedAt: now}
+
+	refresh, err := auth.Issue(s.secret, userID, workspaceID, auth.TypeRefresh, rt.ID, now, s.refreshTTL)
+	if err != nil {
+		return auth.Tokens{}, err
+	}
+
+	if err := s.users.AddRefreshToken(c, rt); err != nil {
+		return auth.Tokens{}, err
+	}
+
+	return auth.Tokens{AccessToken: access, RefreshToken: refresh, TokenType: "Bearer",
+		ExpiresIn: int(s.accessTTL / time.Second)}, nil
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	userService "github.com/MGajendra22/GoFr/service/user"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+	secret     = []byte("0123456789abcdef0123456789abcdef")
+)
+
+func Test_Login(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   auth.Credentials
+		authErr error
+		expErr  error
+	}{
+		{name: "Valid Credentials", input: auth.Credentials{Email: "alice@example.com", Password: "correct horse"}},
+		{name: "Wrong Credentials", input: auth.Credentials{Email: "alice@example.com", Password: "battery staple"},
+			authErr: userService.ErrInvalidCredentials, expErr: userService.ErrInvalidCredentials},
+		{name: "Missing Email", input: auth.Credentials{Password: "correct horse"},
+			expErr: errs.Validation{Field: "email", Reason: "must not be empty"}},
+		{name: "Missing Password", input: auth.Credentials{Email: "alice@example.com"},
+			expErr: errs.Validation{Field: "password", Reason: "must not be empty"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockUsers := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockUsers, secret, fixedClock, WithTTL(time.Minute, time.Hour))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := workspace.In(&gofr.Context{
+			Container: mockContainer,
+		}, 2)
+
+		if tt.input.Email != "" && tt.input.Password != "" {
+			mockUsers.EXPECT().Authenticate(ctx, tt.input.Email, tt.input.Password).Return(user.User{ID: 3}, tt.authErr)
+		}
+
+		var kept auth.RefreshToken
+
+		if tt.expErr == nil {
+			mockUsers.EXPECT().AddRefreshToken(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, rt auth.RefreshToken) error {
+				kept = rt
+
+				return nil
+			})
+		}
+
+		res, err := service.Login(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr != nil {
+			continue
+		}
+
+		assert.Equal(t, "Bearer", res.TokenType, tt.name)
+		assert.Equal(t, 60, res.ExpiresIn, tt.name)
+
+		claims, err := auth.Verify(secret, res.AccessToken, auth.TypeAccess, stamp)
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, "3", claims["sub"], tt.name)
+		assert.Equal(t, 2, auth.WorkspaceID(claims), tt.name)
+
+		_, err = auth.Verify(secret, res.AccessToken, auth.TypeAccess, stamp.Add(2*time.Minute))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken, "%s: access token expired", tt.name)
+
+		claims, err = auth.Verify(secret, res.RefreshToken, auth.TypeRefresh, stamp.Add(59*time.Minute))
+		assert.NoError(t, err, tt.name)
+
+		// the refresh token is kept by its id until it expires
+		assert.Len(t, kept.ID, 32, tt.name)
+		assert.Equal(t, auth.RefreshToken{ID: auth.TokenID(claims), UserID: 3, ExpiresAt: stamp.Add(time.Hour), CreatedAt: stamp},
+			kept, tt.name)
+
+		_, err = auth.Verify(secret, res.RefreshToken, auth.TypeAccess, stamp)
+		assert.ErrorIs(t, err, auth.ErrTokenType, "%s: refresh token used as access token", tt.name)
+	}
+}
+
+func Test_Refresh(t *testing.T) {
+	refresh, _ := auth.Issue(secret, 3, 2, auth.TypeRefresh, "kept", stamp.Add(-time.Hour), 2*time.Hour)
+	expired, _ := auth.Issue(secret, 3, 2, auth.TypeRefresh, "kept", stamp.Add(-3*time.Hour), 2*time.Hour)
+	unkept, _ := auth.Issue(secret, 3, 2, auth.TypeRefresh, "", stamp.Add(-time.Hour), 2*time.Hour)
+	access, _ := auth.Issue(secret, 3, 2, auth.TypeAccess, "", stamp, time.Hour)
+	forged, _ := auth.Issue([]byte("another secret of at least 32 bytes"), 3, 2, auth.TypeRefresh, "kept", stamp, time.Hour)
+
+	tests := []struct {
+		name   string
+		token  string
+		ifUse  bool
+		useErr error
+		addErr error
+		expErr error
+	}{
+		{name: "Valid Token", token: refresh, ifUse: true},
+		{name: "Token Used Already", token: refresh, ifUse: true, useErr: errs.NotFound{Entity: "refresh token"},
+			expErr: ErrInvalidRefreshToken},
+		{name: "Store Error", token: refresh, ifUse: true, useErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "New Token Not Kept", token: refresh, ifUse: true, addErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Token Without Id", token: unkept, expErr: ErrInvalidRefreshToken},
+		{name: "Expired Token", token: expired, expErr: ErrInvalidRefreshToken},
+		{name: "Access Token", token: access, expErr: ErrInvalidRefreshToken},
+		{name: "Forged Token", token: forged, expErr: ErrInvalidRefreshToken},
+		{name: "Malformed Token", token: "not.a.token", expErr: ErrInvalidRefreshToken},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockUsers := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockUsers, secret, fixedClock)
+
+		mockContainer, mock := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.ifUse {
+			mock.SQL.ExpectBegin()
+
+			// the token is looked up in the workspace it was issued in, and exchanged along with the new one kept
+			mockUsers.EXPECT().UseRefreshToken(gomock.Any(), 3, "kept").DoAndReturn(func(c *gofr.Context, _ int, _ string) error {
+				assert.Equal(t, 2, workspace.ID(c), tt.name)
+
+				return tt.useErr
+			})
+
+			if tt.useErr == nil {
+				mockUsers.EXPECT().AddRefreshToken(gomock.Any(), gomock.Any()).Return(tt.addErr)
+			}
+
+			if tt.expErr != nil {
+				mock.SQL.ExpectRollback()
+			} else {
+				mock.SQL.ExpectCommit()
+			}
+		}
+
+		res, err := service.Refresh(ctx, tt.token)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.NoError(t, mock.SQL.ExpectationsWereMet(), tt.name)
+
+		if tt.expErr == nil {
+			claims, err := auth.Verify(secret, res.AccessToken, auth.TypeAccess, stamp)
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, "3", claims["sub"], tt.name)
+			assert.Equal(t, 2, auth.WorkspaceID(claims), tt.name)
+			assert.Equal(t, int(DefaultAccessTTL/time.Second), res.ExpiresIn, tt.name)
+
+			// the new refresh token is another one
+			claims, err = auth.Verify(secret, res.RefreshToken, auth.TypeRefresh, stamp)
+			assert.NoError(t, err, tt.name)
+			assert.NotEqual(t, "kept", auth.TokenID(claims), tt.name)
+		}
+	}
+}
+
+func Test_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockUsers := NewMockUserServiceInterface(ctrl)
+
+	service := NewService(mockUsers, secret, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	// logging out needs an access token, which tells whose refresh tokens to revoke
+	req := httptest.NewRequest(http.MethodPost, "/auth/logout", http.NoBody)
+
+	token, _ := auth.Issue(secret, 3, workspace.Default, auth.TypeAccess, "", time.Now(), time.Hour)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var out *http.Request
+
+	middleware.Authenticate(secret, nil, middleware.PublicRoutes...)(nil, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   gofrHttp.NewRequest(out),
+	}
+
+	mockUsers.EXPECT().RevokeRefreshTokens(ctx, 3).Return(nil)
+
+	assert.NoError(t, service.Logout(ctx))
+
+	anonymous := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	assert.Equal(t, errs.Unauthorized{Reason: "no user to log out"}, service.Logout(anonymous))
+}
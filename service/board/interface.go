@@ -0,0 +1,35 @@
+package board
+
+import (
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+)
+
+type BoardStoreInterface interface {
+	CreateBoard(c *gofr.Context, b board.Board) (board.Board, error)
+	GetByIDBoard(c *gofr.Context, id int) (board.Board, error)
+	GetByColumnIDBoard(c *gofr.Context, columnID int) (board.Board, error)
+	GetAllBoard(c *gofr.Context) ([]board.Board, error)
+	UpdateBoard(c *gofr.Context, b board.Board) (board.Board, error)
+	DeleteBoard(c *gofr.Context, id, ver int) error
+}
+
+// TaskServiceInterface ranks the tasks shown on boards.
+type TaskServiceInterface interface {
+	HasStatus(st task.Status) bool
+	Ranked(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error)
+	Move(c *gofr.Context, id int, p task.Placement) (task.Task, error)
+}
+
+// Projects tells whether the project a board is restricted to exists.
+type Projects interface {
+	GetByIDProject(c *gofr.Context, id int) (project.Project, error)
+}
+
+// Policy decides whether the user making a request may use a permission.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -0,0 +1,279 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=board
+//
+
+// Package board is a generated GoMock package.
+package board
+
+import (
+	reflect "reflect"
+
+	board "github.com/MGajendra22/GoFr/model/board"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	project "github.com/MGajendra22/GoFr/model/project"
+	task "github.com/MGajendra22/GoFr/model/task"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockBoardStoreInterface is a mock of BoardStoreInterface interface.
+type MockBoardStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardStoreInterfaceMockRecorder is the mock recorder for MockBoardStoreInterface.
+type MockBoardStoreInterfaceMockRecorder struct {
+	mock *MockBoardStoreInterface
+}
+
+// NewMockBoardStoreInterface creates a new mock instance.
+func NewMockBoardStoreInterface(ctrl *gomock.Controller) *MockBoardStoreInterface {
+	mock := &MockBoardStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockBoardStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardStoreInterface) EXPECT() *MockBoardStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateBoard mocks base method.
+func (m *MockBoardStoreInterface) CreateBoard(c *gofr.Context, b board.Board) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoard", c, b)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBoard indicates an expected call of CreateBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) CreateBoard(c, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).CreateBoard), c, b)
+}
+
+// DeleteBoard mocks base method.
+func (m *MockBoardStoreInterface) DeleteBoard(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBoard", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBoard indicates an expected call of DeleteBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) DeleteBoard(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).DeleteBoard), c, id, ver)
+}
+
+// GetAllBoard mocks base method.
+func (m *MockBoardStoreInterface) GetAllBoard(c *gofr.Context) ([]board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBoard", c)
+	ret0, _ := ret[0].([]board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBoard indicates an expected call of GetAllBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) GetAllBoard(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).GetAllBoard), c)
+}
+
+// GetByColumnIDBoard mocks base method.
+func (m *MockBoardStoreInterface) GetByColumnIDBoard(c *gofr.Context, columnID int) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByColumnIDBoard", c, columnID)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByColumnIDBoard indicates an expected call of GetByColumnIDBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) GetByColumnIDBoard(c, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByColumnIDBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).GetByColumnIDBoard), c, columnID)
+}
+
+// GetByIDBoard mocks base method.
+func (m *MockBoardStoreInterface) GetByIDBoard(c *gofr.Context, id int) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDBoard", c, id)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDBoard indicates an expected call of GetByIDBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) GetByIDBoard(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).GetByIDBoard), c, id)
+}
+
+// UpdateBoard mocks base method.
+func (m *MockBoardStoreInterface) UpdateBoard(c *gofr.Context, b board.Board) (board.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoard", c, b)
+	ret0, _ := ret[0].(board.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoard indicates an expected call of UpdateBoard.
+func (mr *MockBoardStoreInterfaceMockRecorder) UpdateBoard(c, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoard", reflect.TypeOf((*MockBoardStoreInterface)(nil).UpdateBoard), c, b)
+}
+
+// MockTaskServiceInterface is a mock of TaskServiceInterface interface.
+type MockTaskServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskServiceInterfaceMockRecorder is the mock recorder for MockTaskServiceInterface.
+type MockTaskServiceInterfaceMockRecorder struct {
+	mock *MockTaskServiceInterface
+}
+
+// NewMockTaskServiceInterface creates a new mock instance.
+func NewMockTaskServiceInterface(ctrl *gomock.Controller) *MockTaskServiceInterface {
+	mock := &MockTaskServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskServiceInterface) EXPECT() *MockTaskServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// HasStatus mocks base method.
+func (m *MockTaskServiceInterface) HasStatus(st task.Status) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasStatus", st)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasStatus indicates an expected call of HasStatus.
+func (mr *MockTaskServiceInterfaceMockRecorder) HasStatus(st any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasStatus", reflect.TypeOf((*MockTaskServiceInterface)(nil).HasStatus), st)
+}
+
+// Move mocks base method.
+func (m *MockTaskServiceInterface) Move(c *gofr.Context, id int, p task.Placement) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", c, id, p)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTaskServiceInterfaceMockRecorder) Move(c, id, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTaskServiceInterface)(nil).Move), c, id, p)
+}
+
+// Ranked mocks base method.
+func (m *MockTaskServiceInterface) Ranked(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ranked", c, f, limit)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ranked indicates an expected call of Ranked.
+func (mr *MockTaskServiceInterfaceMockRecorder) Ranked(c, f, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ranked", reflect.TypeOf((*MockTaskServiceInterface)(nil).Ranked), c, f, limit)
+}
+
+// MockProjects is a mock of Projects interface.
+type MockProjects struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsMockRecorder is the mock recorder for MockProjects.
+type MockProjectsMockRecorder struct {
+	mock *MockProjects
+}
+
+// NewMockProjects creates a new mock instance.
+func NewMockProjects(ctrl *gomock.Controller) *MockProjects {
+	mock := &MockProjects{ctrl: ctrl}
+	mock.recorder = &MockProjectsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjects) EXPECT() *MockProjectsMockRecorder {
+	return m.recorder
+}
+
+// GetByIDProject mocks base method.
+func (m *MockProjects) GetByIDProject(c *gofr.Context, id int) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDProject", c, id)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDProject indicates an expected call of GetByIDProject.
+func (mr *MockProjectsMockRecorder) GetByIDProject(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDProject", reflect.TypeOf((*MockProjects)(nil).GetByIDProject), c, id)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
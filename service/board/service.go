@@ -0,0 +1,223 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type BoardService struct {
+	str      BoardStoreInterface
+	tasks    TaskServiceInterface
+	projects Projects
+	policy   Policy
+	now      func() time.Time
+}
+
+// Option configures optional collaborators of BoardService.
+type Option func(*BoardService)
+
+// WithPolicy checks every change to a board against an access policy. Without one, anyone may manage boards. Moving
+// tasks on a board is checked by the task service.
+func WithPolicy(p Policy) Option {
+	return func(s *BoardService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp boards.
+func WithClock(now func() time.Time) Option {
+	return func(s *BoardService) {
+		s.now = now
+	}
+}
+
+func NewService(s BoardStoreInterface, tasks TaskServiceInterface, projects Projects, opts ...Option) *BoardService {
+	svc := &BoardService{
+		str:      s,
+		tasks:    tasks,
+		projects: projects,
+		now:      svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Create adds a board along with its columns.
+func (s *BoardService) Create(c *gofr.Context, b board.Board) (board.Board, error) {
+	if err := s.validate(c, b); err != nil {
+		return board.Board{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return board.Board{}, err
+	}
+
+	b.ID = 0
+	b.CreatedAt = s.now()
+	b.UpdatedAt = b.CreatedAt
+
+	return s.str.CreateBoard(c, b)
+}
+
+func (s *BoardService) Get(c *gofr.Context, id int) (board.Board, error) {
+	return s.str.GetByIDBoard(c, id)
+}
+
+// All returns every board of the workspace, in name order.
+func (s *BoardService) All(c *gofr.Context) ([]board.Board, error) {
+	return s.str.GetAllBoard(c)
+}
+
+// Update replaces the name, project and columns of a board at version ver. The columns get new ids.
+func (s *BoardService) Update(c *gofr.Context, id, ver int, b board.Board) (board.Board, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return board.Board{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return board.Board{}, err
+	}
+
+	b.ID, b.Version, b.CreatedAt = cur.ID, cur.Version, cur.CreatedAt
+
+	if err := s.validate(c, b); err != nil {
+		return board.Board{}, err
+	}
+
+	b.UpdatedAt = s.now()
+
+	b, err = s.str.UpdateBoard(c, b)
+	if err != nil {
+		return board.Board{}, err
+	}
+
+	b.Version++
+
+	return b, nil
+}
+
+// Delete removes a board at version ver, or at any version if ver is version.Any. The tasks it shows are left as
+// they are.
+func (s *BoardService) Delete(c *gofr.Context, id, ver int) error {
+	if _, err := s.get(c, id, ver); err != nil {
+		return err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return err
+	}
+
+	return s.str.DeleteBoard(c, id, ver)
+}
+
+// View returns a board along with the tasks of each of its columns, in rank order, up to board.MaxColumnTasks of
+// them per column.
+func (s *BoardService) View(c *gofr.Context, id int) (board.View, error) {
+	b, err := s.str.GetByIDBoard(c, id)
+	if err != nil {
+		return board.View{}, err
+	}
+
+	v := board.View{Board: b, Columns: make([]board.Lane, len(b.Columns))}
+
+	for i, col := range b.Columns {
+		// one task more than shown tells whether there are more
+		tasks, err := s.tasks.Ranked(c, task.Filter{Status: col.Status, ProjectID: projectOf(b)}, board.MaxColumnTasks+1)
+		if err != nil {
+			return board.View{}, err
+		}
+
+		v.Columns[i] = board.Lane{Column: col, Tasks: tasks}
+
+		if len(tasks) > board.MaxColumnTasks {
+			v.Columns[i].Tasks, v.Columns[i].More = tasks[:board.MaxColumnTasks], true
+		}
+	}
+
+	return v, nil
+}
+
+// Move moves a task to a column of a board, between two of the tasks it shows, and to the status of the column.
+func (s *BoardService) Move(c *gofr.Context, id int, m board.Move) (task.Task, error) {
+	b, err := s.str.GetByColumnIDBoard(c, m.ColumnID)
+	if errors.As(err, &errs.NotFound{}) {
+		return task.Task{}, errs.DependencyMissing{Entity: "column", ID: m.ColumnID}
+	}
+
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	for _, col := range b.Columns {
+		if col.ID == m.ColumnID {
+			return s.tasks.Move(c, id, task.Placement{Status: col.Status, ProjectID: projectOf(b), After: m.After, Before: m.Before})
+		}
+	}
+
+	return task.Task{}, errs.DependencyMissing{Entity: "column", ID: m.ColumnID}
+}
+
+// validate checks a board and that its columns show statuses of the task workflow and its project exists.
+func (s *BoardService) validate(c *gofr.Context, b board.Board) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+
+	for i, col := range b.Columns {
+		if !s.tasks.HasStatus(col.Status) {
+			return errs.Validation{Field: fmt.Sprintf("columns[%d].status", i), Reason: "unknown status " + string(col.Status)}
+		}
+	}
+
+	if b.ProjectID == nil {
+		return nil
+	}
+
+	_, err := s.projects.GetByIDProject(c, *b.ProjectID)
+	if errors.As(err, &errs.NotFound{}) {
+		return errs.DependencyMissing{Entity: "project", ID: *b.ProjectID}
+	}
+
+	return err
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may manage boards.
+func (s *BoardService) authorize(c *gofr.Context) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, policy.BoardManage, 0)
+}
+
+// get reads a board and checks it is still at version ver, unless ver is version.Any.
+func (s *BoardService) get(c *gofr.Context, id, ver int) (board.Board, error) {
+	b, err := s.str.GetByIDBoard(c, id)
+	if err == nil && ver != version.Any && b.Version != ver {
+		return b, version.ErrMismatch
+	}
+
+	return b, err
+}
+
+// projectOf returns the project a board is restricted to, 0 for none.
+func projectOf(b board.Board) int {
+	if b.ProjectID == nil {
+		return 0
+	}
+
+	return *b.ProjectID
+}
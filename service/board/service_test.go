@@ -0,0 +1,316 @@
+package board
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+var denied = errs.Forbidden{Permission: string(policy.BoardManage)}
+
+var columns = []board.Column{{Name: "To do", Status: task.StatusTodo}, {Name: "Done", Status: task.StatusDone}}
+
+func ref(id int) *int {
+	return &id
+}
+
+func Test_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      board.Board
+		projectErr error
+		policyErr  error
+		ifStore    bool
+		expErr     error
+	}{
+		{name: "Valid Board", input: board.Board{ID: 7, Name: "Sprint", Columns: columns}, ifStore: true},
+		{name: "Board Of A Project", input: board.Board{Name: "Sprint", ProjectID: ref(3), Columns: columns}, ifStore: true},
+		{name: "Missing Name", input: board.Board{Columns: columns},
+			expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "No Columns", input: board.Board{Name: "Sprint"},
+			expErr: errs.Validation{Field: "columns", Reason: "must have between 1 and 20 columns"}},
+		{name: "Status Shown Twice", input: board.Board{Name: "Sprint", Columns: append(columns, board.Column{Name: "Again", Status: task.StatusTodo})},
+			expErr: errs.Validation{Field: "columns[2].status", Reason: "is already shown by another column"}},
+		{name: "Unknown Status", input: board.Board{Name: "Sprint", Columns: []board.Column{{Name: "Archive", Status: "archived"}}},
+			expErr: errs.Validation{Field: "columns[0].status", Reason: "unknown status archived"}},
+		{name: "Missing Project", input: board.Board{Name: "Sprint", ProjectID: ref(3), Columns: columns},
+			projectErr: errs.NotFound{Entity: "project", ID: 3}, expErr: errs.DependencyMissing{Entity: "project", ID: 3}},
+		{name: "Not Allowed", input: board.Board{Name: "Sprint", Columns: columns}, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockBoardStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+		mockProjects := NewMockProjects(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, mockTasks, mockProjects, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockTasks.EXPECT().HasStatus(gomock.Any()).DoAndReturn(func(st task.Status) bool { return st != "archived" }).AnyTimes()
+
+		if tt.input.ProjectID != nil {
+			mockProjects.EXPECT().GetByIDProject(ctx, 3).Return(project.Project{ID: 3}, tt.projectErr)
+		}
+
+		if tt.projectErr == nil && (tt.ifStore || tt.policyErr != nil) {
+			mockPolicy.EXPECT().Authorize(ctx, policy.BoardManage, 0).Return(tt.policyErr)
+		}
+
+		if tt.ifStore {
+			want := tt.input
+			want.ID, want.CreatedAt, want.UpdatedAt = 0, stamp, stamp
+
+			created := want
+			created.ID, created.Version = 2, 1
+
+			mockStore.EXPECT().CreateBoard(ctx, want).Return(created, nil)
+		}
+
+		res, err := service.Create(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 2, res.ID, tt.name)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	cur := board.Board{ID: 4, Name: "Sprint", Version: 2, Columns: columns, CreatedAt: stamp.Add(-time.Hour)}
+	renamed := []board.Column{{Name: "Doing", Status: task.StatusInProgress}}
+
+	tests := []struct {
+		name      string
+		ver       int
+		input     board.Board
+		getErr    error
+		policyErr error
+		storeErr  error
+		expErr    error
+	}{
+		{name: "Valid Update", ver: 2, input: board.Board{Name: "Kanban", Columns: renamed}},
+		{name: "Any Version", ver: version.Any, input: board.Board{Name: "Kanban", Columns: renamed}},
+		{name: "Stale Version", ver: 1, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: 2, getErr: errs.NotFound{Entity: "board", ID: 4}, expErr: errs.NotFound{Entity: "board", ID: 4}},
+		{name: "Not Allowed", ver: 2, input: board.Board{Name: "Kanban", Columns: renamed}, policyErr: denied, expErr: denied},
+		{name: "Invalid Board", ver: 2, input: board.Board{Name: "Kanban"},
+			expErr: errs.Validation{Field: "columns", Reason: "must have between 1 and 20 columns"}},
+		{name: "Store Error", ver: 2, input: board.Board{Name: "Kanban", Columns: renamed}, storeErr: version.ErrMismatch,
+			expErr: version.ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockBoardStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, mockTasks, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDBoard(ctx, 4).Return(cur, tt.getErr)
+		mockTasks.EXPECT().HasStatus(gomock.Any()).Return(true).AnyTimes()
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.BoardManage, 0).Return(tt.policyErr)
+		}
+
+		want := board.Board{ID: 4, Name: "Kanban", Version: 2, Columns: renamed, CreatedAt: cur.CreatedAt, UpdatedAt: stamp}
+
+		if tt.input.Columns != nil && tt.policyErr == nil {
+			updated := want
+			updated.Columns = []board.Column{{ID: 12, Name: "Doing", Status: task.StatusInProgress}}
+
+			mockStore.EXPECT().UpdateBoard(ctx, want).Return(updated, tt.storeErr)
+		}
+
+		res, err := service.Update(ctx, 4, tt.ver, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 3, res.Version, tt.name)
+			assert.Equal(t, 12, res.Columns[0].ID, tt.name)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ver       int
+		getErr    error
+		policyErr error
+		expErr    error
+	}{
+		{name: "Valid Delete", ver: 2},
+		{name: "Stale Version", ver: 1, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: version.Any, getErr: errs.NotFound{Entity: "board", ID: 4},
+			expErr: errs.NotFound{Entity: "board", ID: 4}},
+		{name: "Not Allowed", ver: version.Any, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockBoardStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDBoard(ctx, 4).Return(board.Board{ID: 4, Version: 2}, tt.getErr)
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.BoardManage, 0).Return(tt.policyErr)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().DeleteBoard(ctx, 4, tt.ver).Return(nil)
+		}
+
+		err := service.Delete(ctx, 4, tt.ver)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_View(t *testing.T) {
+	b := board.Board{ID: 4, Name: "Sprint", ProjectID: ref(3),
+		Columns: []board.Column{{ID: 10, Name: "To do", Status: task.StatusTodo}, {ID: 11, Name: "Done", Status: task.StatusDone}}}
+
+	full := make([]task.Task, board.MaxColumnTasks+1)
+	for i := range full {
+		full[i] = task.Task{ID: i + 1, Status: task.StatusDone}
+	}
+
+	tests := []struct {
+		name     string
+		getErr   error
+		tasksErr error
+		expErr   error
+	}{
+		{name: "Columns With Their Tasks"},
+		{name: "Missing Board", getErr: errs.NotFound{Entity: "board", ID: 4}, expErr: errs.NotFound{Entity: "board", ID: 4}},
+		{name: "Tasks Error", tasksErr: errors.New("db down"), expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockBoardStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockTasks, nil)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDBoard(ctx, 4).Return(b, tt.getErr)
+
+		if tt.getErr == nil {
+			mockTasks.EXPECT().Ranked(ctx, task.Filter{Status: task.StatusTodo, ProjectID: 3}, board.MaxColumnTasks+1).
+				Return([]task.Task{{ID: 2}, {ID: 1}}, tt.tasksErr)
+		}
+
+		if tt.expErr == nil {
+			mockTasks.EXPECT().Ranked(ctx, task.Filter{Status: task.StatusDone, ProjectID: 3}, board.MaxColumnTasks+1).Return(full, nil)
+		}
+
+		res, err := service.View(ctx, 4)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, b, res.Board, tt.name)
+			assert.Equal(t, board.Lane{Column: b.Columns[0], Tasks: []task.Task{{ID: 2}, {ID: 1}}}, res.Columns[0], tt.name)
+			assert.Len(t, res.Columns[1].Tasks, board.MaxColumnTasks, tt.name)
+			assert.True(t, res.Columns[1].More, tt.name)
+		}
+	}
+}
+
+func Test_Move(t *testing.T) {
+	b := board.Board{ID: 4, ProjectID: ref(3),
+		Columns: []board.Column{{ID: 10, Name: "To do", Status: task.StatusTodo}, {ID: 11, Name: "Done", Status: task.StatusDone}}}
+
+	tests := []struct {
+		name   string
+		getErr error
+		expErr error
+	}{
+		{name: "Move To Column"},
+		{name: "Missing Column", getErr: errs.NotFound{Entity: "column", ID: 11}, expErr: errs.DependencyMissing{Entity: "column", ID: 11}},
+		{name: "Store Error", getErr: errors.New("db down"), expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockBoardStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockTasks, nil)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByColumnIDBoard(ctx, 11).Return(b, tt.getErr)
+
+		moved := task.Task{ID: 7, Status: task.StatusDone}
+
+		if tt.expErr == nil {
+			mockTasks.EXPECT().Move(ctx, 7, task.Placement{Status: task.StatusDone, ProjectID: 3, After: ref(5)}).Return(moved, nil)
+		}
+
+		res, err := service.Move(ctx, 7, board.Move{ColumnID: 11, After: ref(5)})
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, moved, res, tt.name)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+type CommentStoreInterface interface {
+	CreateComment(c *gofr.Context, cm comment.Comment) (comment.Comment, error)
+	GetByIDComment(c *gofr.Context, id int) (comment.Comment, error)
+	GetByTaskIDComment(c *gofr.Context, taskID int) ([]comment.Comment, error)
+	UpdateComment(c *gofr.Context, cm comment.Comment) error
+	DeleteComment(c *gofr.Context, id, ver int) error
+}
+
+type TaskServiceInterface interface {
+	GetTask(c *gofr.Context, id int) (task.Task, error)
+}
+
+type UserServiceInterface interface {
+	Get(c *gofr.Context, id int) (user.User, error)
+}
+
+// Policy decides whether the user making a request may use a permission on a comment written by owner.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
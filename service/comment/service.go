@@ -0,0 +1,213 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type CommentService struct {
+	str    CommentStoreInterface
+	tasks  TaskServiceInterface
+	users  UserServiceInterface
+	policy Policy
+	now    func() time.Time
+}
+
+// Option configures optional collaborators of CommentService.
+type Option func(*CommentService)
+
+// WithPolicy checks every change to a comment against an access policy. Without one, anyone may change any comment.
+func WithPolicy(p Policy) Option {
+	return func(s *CommentService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp comments.
+func WithClock(now func() time.Time) Option {
+	return func(s *CommentService) {
+		s.now = now
+	}
+}
+
+func NewService(s CommentStoreInterface, tasks TaskServiceInterface, users UserServiceInterface, opts ...Option) *CommentService {
+	svc := &CommentService{
+		str:   s,
+		tasks: tasks,
+		users: users,
+		now:   svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// ErrNoAuthor is returned by Create outside of a request made by a user, whom comments are written by.
+var ErrNoAuthor = errs.Unauthorized{Reason: "comments are written by the user making the request"}
+
+// Create adds a comment written by the user making the request to a task, as a reply if cm.ParentID is set. The
+// author given in cm is ignored.
+func (s *CommentService) Create(c *gofr.Context, taskID int, cm comment.Comment) (comment.Comment, error) {
+	if err := cm.Validate(); err != nil {
+		return cm, err
+	}
+
+	cm.AuthorID = middleware.GetActor(c)
+	if cm.AuthorID == nil {
+		return cm, ErrNoAuthor
+	}
+
+	if _, err := s.tasks.GetTask(c, taskID); err != nil {
+		return cm, err
+	}
+
+	author, err := s.users.Get(c, *cm.AuthorID)
+	if err != nil {
+		return cm, svcutil.Missing(err, "user", *cm.AuthorID)
+	}
+
+	if cm.ParentID != nil {
+		parent, err := s.str.GetByIDComment(c, *cm.ParentID)
+		if err != nil {
+			return cm, svcutil.Missing(err, "comment", *cm.ParentID)
+		}
+
+		if parent.TaskID != taskID {
+			return cm, errs.Validation{Field: "parent_id", Reason: "must be a comment on the same task"}
+		}
+	}
+
+	cm.TaskID = taskID
+	cm.Author = author.Name
+	cm.CreatedAt = s.now()
+	cm.UpdatedAt = cm.CreatedAt
+	cm.Replies = nil
+
+	return s.str.CreateComment(c, cm)
+}
+
+// List returns the comments on a task as threads: the comments that are not replies, oldest first, each with its
+// replies nested.
+func (s *CommentService) List(c *gofr.Context, taskID int) ([]comment.Comment, error) {
+	if _, err := s.tasks.GetTask(c, taskID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.str.GetByTaskIDComment(c, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make(map[int][]comment.Comment)
+	threads := []comment.Comment{}
+
+	for _, cm := range comments {
+		if cm.ParentID == nil {
+			threads = append(threads, cm)
+		} else {
+			replies[*cm.ParentID] = append(replies[*cm.ParentID], cm)
+		}
+	}
+
+	for i := range threads {
+		threads[i] = thread(threads[i], replies)
+	}
+
+	return threads, nil
+}
+
+func thread(cm comment.Comment, replies map[int][]comment.Comment) comment.Comment {
+	for _, r := range replies[cm.ID] {
+		cm.Replies = append(cm.Replies, thread(r, replies))
+	}
+
+	return cm
+}
+
+// Update replaces the body of a comment at version ver. Its task, thread and author cannot change.
+func (s *CommentService) Update(c *gofr.Context, taskID, id, ver int, cm comment.Comment) (comment.Comment, error) {
+	cur, err := s.get(c, taskID, id, ver)
+	if err != nil {
+		return comment.Comment{}, err
+	}
+
+	if err := cm.Validate(); err != nil {
+		return comment.Comment{}, err
+	}
+
+	if err := s.authorize(c, policy.CommentUpdate, cur); err != nil {
+		return comment.Comment{}, err
+	}
+
+	cur.Body = cm.Body
+	cur.UpdatedAt = s.now()
+
+	if err := s.str.UpdateComment(c, cur); err != nil {
+		return comment.Comment{}, err
+	}
+
+	cur.Version++
+
+	return cur, nil
+}
+
+// Delete removes a comment at version ver, or at any version if ver is version.Any, along with its replies.
+func (s *CommentService) Delete(c *gofr.Context, taskID, id, ver int) error {
+	cur, err := s.get(c, taskID, id, ver)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, policy.CommentDelete, cur); err != nil {
+		return err
+	}
+
+	return s.str.DeleteComment(c, id, ver)
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may use perm on a
+// comment. The comments of deleted users are owned by nobody.
+func (s *CommentService) authorize(c *gofr.Context, perm policy.Permission, cm comment.Comment) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	owner := 0
+	if cm.AuthorID != nil {
+		owner = *cm.AuthorID
+	}
+
+	return s.policy.Authorize(c, perm, owner)
+}
+
+// get reads a comment on a task and checks it is still at version ver, unless ver is version.Any. A comment on
+// another task, or on a task of another workspace, is not found.
+func (s *CommentService) get(c *gofr.Context, taskID, id, ver int) (comment.Comment, error) {
+	if _, err := s.tasks.GetTask(c, taskID); err != nil {
+		return comment.Comment{}, err
+	}
+
+	cm, err := s.str.GetByIDComment(c, id)
+	if err != nil {
+		return cm, err
+	}
+
+	if cm.TaskID != taskID {
+		return cm, errs.NotFound{Entity: "comment", ID: id}
+	}
+
+	if ver != version.Any && cm.Version != ver {
+		return cm, version.ErrMismatch
+	}
+
+	return cm, nil
+}
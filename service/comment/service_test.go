@@ -0,0 +1,362 @@
+package comment
+
+import (
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+func ref(id int) *int {
+	return &id
+}
+
+// secret signs the access tokens of actorRequest
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+// actorRequest is a request made by the user with the given id, as seen after the Authenticate middleware with the
+// public routes of the service
+func actorRequest(id int) *gofrHttp.Request {
+	req := httptest.NewRequest(http.MethodPost, "/task/1/comments", http.NoBody)
+
+	token, _ := auth.Issue(secret, id, workspace.Default, auth.TypeAccess, "", time.Now(), time.Hour)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var out *http.Request
+
+	middleware.Authenticate(secret, nil, middleware.PublicRoutes...)(nil, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return gofrHttp.NewRequest(out)
+}
+
+func Test_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     comment.Comment
+		taskErr   error
+		userErr   error
+		parent    *comment.Comment
+		parentErr error
+		anonymous bool
+		expErr    error
+	}{
+		{
+			name:  "Valid Comment",
+			input: comment.Comment{Body: "Looks good"},
+		},
+		{
+			name:  "Author In Body Ignored",
+			input: comment.Comment{AuthorID: ref(4), Body: "Looks good"},
+		},
+		{
+			name:   "Valid Reply",
+			input:  comment.Comment{Body: "Thanks", ParentID: ref(7)},
+			parent: &comment.Comment{ID: 7, TaskID: 1},
+		},
+		{
+			name:   "Empty Body",
+			input:  comment.Comment{Body: "  "},
+			expErr: errs.Validation{Field: "body", Reason: "must not be empty"},
+		},
+		{
+			name:      "Anonymous",
+			input:     comment.Comment{Body: "Looks good"},
+			anonymous: true,
+			expErr:    ErrNoAuthor,
+		},
+		{
+			name:    "Task Not Found",
+			input:   comment.Comment{Body: "Looks good"},
+			taskErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr:  errs.NotFound{Entity: "task", ID: 1},
+		},
+		{
+			name:    "Author Not Found",
+			input:   comment.Comment{Body: "Looks good"},
+			userErr: errs.NotFound{Entity: "user", ID: 3},
+			expErr:  errs.DependencyMissing{Entity: "user", ID: 3},
+		},
+		{
+			name:      "Parent Not Found",
+			input:     comment.Comment{Body: "Thanks", ParentID: ref(9)},
+			parentErr: errs.NotFound{Entity: "comment", ID: 9},
+			expErr:    errs.DependencyMissing{Entity: "comment", ID: 9},
+		},
+		{
+			name:   "Parent On Another Task",
+			input:  comment.Comment{Body: "Thanks", ParentID: ref(7)},
+			parent: &comment.Comment{ID: 7, TaskID: 2},
+			expErr: errs.Validation{Field: "parent_id", Reason: "must be a comment on the same task"},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockCommentStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+		mockUsers := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockTasks, mockUsers, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if !tt.anonymous {
+			ctx.Request = actorRequest(3)
+		}
+
+		if tt.input.Validate() == nil && !tt.anonymous {
+			mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, tt.taskErr)
+
+			if tt.taskErr == nil {
+				mockUsers.EXPECT().Get(ctx, 3).Return(user.User{ID: 3, Name: "Alice"}, tt.userErr)
+			}
+
+			if tt.userErr == nil && tt.input.ParentID != nil {
+				parent := comment.Comment{}
+				if tt.parent != nil {
+					parent = *tt.parent
+				}
+
+				mockStore.EXPECT().GetByIDComment(ctx, *tt.input.ParentID).Return(parent, tt.parentErr)
+			}
+		}
+
+		if tt.expErr == nil {
+			// the author is the user making the request
+			stored := tt.input
+			stored.AuthorID, stored.TaskID, stored.Author, stored.CreatedAt, stored.UpdatedAt = ref(3), 1, "Alice", stamp, stamp
+
+			created := stored
+			created.ID, created.Version = 8, 1
+
+			mockStore.EXPECT().CreateComment(ctx, stored).Return(created, nil)
+		}
+
+		res, err := service.Create(ctx, 1, tt.input)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, 8, res.ID, tt.name)
+			assert.Equal(t, "Alice", res.Author, tt.name)
+		}
+	}
+}
+
+func Test_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockCommentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+
+	service := NewService(mockStore, mockTasks, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	c1 := comment.Comment{ID: 1, TaskID: 1, Body: "Question"}
+	c2 := comment.Comment{ID: 2, TaskID: 1, Body: "Other question"}
+	c3 := comment.Comment{ID: 3, TaskID: 1, Body: "Answer", ParentID: ref(1)}
+	c4 := comment.Comment{ID: 4, TaskID: 1, Body: "Follow-up", ParentID: ref(3)}
+	c5 := comment.Comment{ID: 5, TaskID: 1, Body: "Another answer", ParentID: ref(1)}
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil)
+	mockStore.EXPECT().GetByTaskIDComment(ctx, 1).Return([]comment.Comment{c1, c2, c3, c4, c5}, nil)
+
+	res, err := service.List(ctx, 1)
+
+	c3.Replies = []comment.Comment{c4}
+	c1.Replies = []comment.Comment{c3, c5}
+
+	assert.NoError(t, err)
+	assert.Equal(t, []comment.Comment{c1, c2}, res)
+
+	mockTasks.EXPECT().GetTask(ctx, 9).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 9})
+
+	_, err = service.List(ctx, 9)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 9}, err)
+}
+
+func Test_Update(t *testing.T) {
+	cur := comment.Comment{ID: 7, TaskID: 1, AuthorID: ref(3), Author: "Alice", Body: "Looks good", Version: 2}
+
+	tests := []struct {
+		name      string
+		taskID    int
+		ver       int
+		body      string
+		ifUpdate  bool
+		updateErr error
+		expErr    error
+	}{
+		{name: "Valid Edit", taskID: 1, ver: 2, body: "Looks great", ifUpdate: true},
+		{name: "Any Version", taskID: 1, ver: version.Any, body: "Looks great", ifUpdate: true},
+		{name: "Stale Version", taskID: 1, ver: 1, body: "Looks great", expErr: version.ErrMismatch},
+		{name: "Comment On Another Task", taskID: 2, ver: 2, body: "Looks great", expErr: errs.NotFound{Entity: "comment", ID: 7}},
+		{name: "Empty Body", taskID: 1, ver: 2, body: "", expErr: errs.Validation{Field: "body", Reason: "must not be empty"}},
+		{name: "Changed Concurrently", taskID: 1, ver: 2, body: "Looks great", ifUpdate: true, updateErr: version.ErrMismatch,
+			expErr: version.ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockCommentStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockTasks, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockTasks.EXPECT().GetTask(ctx, tt.taskID).Return(task.Task{ID: tt.taskID}, nil)
+		mockStore.EXPECT().GetByIDComment(ctx, 7).Return(cur, nil)
+
+		updated := cur
+		updated.Body, updated.UpdatedAt = tt.body, stamp
+
+		if tt.ifUpdate {
+			mockStore.EXPECT().UpdateComment(ctx, updated).Return(tt.updateErr)
+		}
+
+		res, err := service.Update(ctx, tt.taskID, 7, tt.ver, comment.Comment{Body: tt.body, AuthorID: ref(4)})
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			updated.Version++
+
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, updated, res, tt.name)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockCommentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+
+	service := NewService(mockStore, mockTasks, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil).Times(2)
+	mockStore.EXPECT().GetByIDComment(ctx, 7).Return(comment.Comment{ID: 7, TaskID: 1, Version: 2}, nil)
+	mockStore.EXPECT().DeleteComment(ctx, 7, 2).Return(nil)
+
+	assert.NoError(t, service.Delete(ctx, 1, 7, 2))
+
+	mockStore.EXPECT().GetByIDComment(ctx, 9).Return(comment.Comment{}, errs.NotFound{Entity: "comment", ID: 9})
+
+	assert.Equal(t, errs.NotFound{Entity: "comment", ID: 9}, service.Delete(ctx, 1, 9, version.Any))
+}
+
+func Test_Policy(t *testing.T) {
+	cur := comment.Comment{ID: 7, TaskID: 1, AuthorID: ref(3), Author: "Alice", Body: "Looks good", Version: 2}
+	orphan := comment.Comment{ID: 8, TaskID: 1, Body: "Left behind", Version: 1}
+	denied := errs.Forbidden{Permission: "comment:update"}
+
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockCommentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewService(mockStore, mockTasks, nil, fixedClock, WithPolicy(mockPolicy))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockTasks.EXPECT().GetTask(ctx, 1).Return(task.Task{ID: 1}, nil).Times(3)
+
+	// comments are owned by their author
+	mockStore.EXPECT().GetByIDComment(ctx, 7).Return(cur, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.CommentUpdate, 3).Return(denied)
+
+	_, err := service.Update(ctx, 1, 7, 2, comment.Comment{Body: "Looks great"})
+	assert.Equal(t, denied, err)
+
+	mockStore.EXPECT().GetByIDComment(ctx, 7).Return(cur, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.CommentDelete, 3).Return(nil)
+	mockStore.EXPECT().DeleteComment(ctx, 7, 2).Return(nil)
+
+	assert.NoError(t, service.Delete(ctx, 1, 7, 2))
+
+	// the comments of deleted users are owned by nobody
+	mockStore.EXPECT().GetByIDComment(ctx, 8).Return(orphan, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.CommentDelete, 0).Return(errs.Forbidden{Permission: "comment:delete"})
+
+	assert.Equal(t, errs.Forbidden{Permission: "comment:delete"}, service.Delete(ctx, 1, 8, 1))
+}
+
+func Test_OtherWorkspace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockCommentStoreInterface(ctrl)
+	mockTasks := NewMockTaskServiceInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewService(mockStore, mockTasks, nil, fixedClock, WithPolicy(mockPolicy))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	// task 1 and its comment 7 are in the default workspace, the request in workspace 2, whose admin may change any
+	// comment of theirs
+	ctx := workspace.In(&gofr.Context{
+		Container: mockContainer,
+	}, 2)
+
+	mockTasks.EXPECT().GetTask(gomock.Any(), 1).DoAndReturn(func(c *gofr.Context, id int) (task.Task, error) {
+		assert.Equal(t, 2, workspace.ID(c))
+
+		return task.Task{}, errs.NotFound{Entity: "task", ID: id}
+	}).Times(2)
+
+	_, err := service.Update(ctx, 1, 7, version.Any, comment.Comment{Body: "Rewritten"})
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 1}, err)
+
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 1}, service.Delete(ctx, 1, 7, version.Any))
+}
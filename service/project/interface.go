@@ -0,0 +1,36 @@
+package project
+
+import (
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+type ProjectStoreInterface interface {
+	CreateProject(c *gofr.Context, p project.Project) (project.Project, error)
+	GetByIDProject(c *gofr.Context, id int) (project.Project, error)
+	GetAllProject(c *gofr.Context) ([]project.Project, error)
+	UpdateProject(c *gofr.Context, p project.Project) error
+	DeleteProject(c *gofr.Context, id, ver int) error
+	AddMemberProject(c *gofr.Context, id, userID int) error
+	RemoveMemberProject(c *gofr.Context, id, userID int) error
+	GetMembersProject(c *gofr.Context, id int) ([]user.User, error)
+	StatsProject(c *gofr.Context, id int) (project.Stats, error)
+}
+
+type UserServiceInterface interface {
+	Get(c *gofr.Context, id int) (user.User, error)
+}
+
+// TaskServiceInterface lists the tasks of a project.
+type TaskServiceInterface interface {
+	All(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error)
+}
+
+// Policy decides whether the user making a request may use a permission.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -0,0 +1,293 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=project
+//
+
+// Package project is a generated GoMock package.
+package project
+
+import (
+	reflect "reflect"
+
+	page "github.com/MGajendra22/GoFr/model/page"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	project "github.com/MGajendra22/GoFr/model/project"
+	task "github.com/MGajendra22/GoFr/model/task"
+	user "github.com/MGajendra22/GoFr/model/user"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockProjectStoreInterface is a mock of ProjectStoreInterface interface.
+type MockProjectStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectStoreInterfaceMockRecorder is the mock recorder for MockProjectStoreInterface.
+type MockProjectStoreInterfaceMockRecorder struct {
+	mock *MockProjectStoreInterface
+}
+
+// NewMockProjectStoreInterface creates a new mock instance.
+func NewMockProjectStoreInterface(ctrl *gomock.Controller) *MockProjectStoreInterface {
+	mock := &MockProjectStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectStoreInterface) EXPECT() *MockProjectStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddMemberProject mocks base method.
+func (m *MockProjectStoreInterface) AddMemberProject(c *gofr.Context, id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberProject", c, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMemberProject indicates an expected call of AddMemberProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) AddMemberProject(c, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).AddMemberProject), c, id, userID)
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectStoreInterface) CreateProject(c *gofr.Context, p project.Project) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", c, p)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) CreateProject(c, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).CreateProject), c, p)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectStoreInterface) DeleteProject(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) DeleteProject(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).DeleteProject), c, id, ver)
+}
+
+// GetAllProject mocks base method.
+func (m *MockProjectStoreInterface) GetAllProject(c *gofr.Context) ([]project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProject", c)
+	ret0, _ := ret[0].([]project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProject indicates an expected call of GetAllProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) GetAllProject(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).GetAllProject), c)
+}
+
+// GetByIDProject mocks base method.
+func (m *MockProjectStoreInterface) GetByIDProject(c *gofr.Context, id int) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDProject", c, id)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDProject indicates an expected call of GetByIDProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) GetByIDProject(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).GetByIDProject), c, id)
+}
+
+// GetMembersProject mocks base method.
+func (m *MockProjectStoreInterface) GetMembersProject(c *gofr.Context, id int) ([]user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembersProject", c, id)
+	ret0, _ := ret[0].([]user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembersProject indicates an expected call of GetMembersProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) GetMembersProject(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).GetMembersProject), c, id)
+}
+
+// RemoveMemberProject mocks base method.
+func (m *MockProjectStoreInterface) RemoveMemberProject(c *gofr.Context, id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMemberProject", c, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMemberProject indicates an expected call of RemoveMemberProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) RemoveMemberProject(c, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).RemoveMemberProject), c, id, userID)
+}
+
+// StatsProject mocks base method.
+func (m *MockProjectStoreInterface) StatsProject(c *gofr.Context, id int) (project.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatsProject", c, id)
+	ret0, _ := ret[0].(project.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatsProject indicates an expected call of StatsProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) StatsProject(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatsProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).StatsProject), c, id)
+}
+
+// UpdateProject mocks base method.
+func (m *MockProjectStoreInterface) UpdateProject(c *gofr.Context, p project.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", c, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockProjectStoreInterfaceMockRecorder) UpdateProject(c, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectStoreInterface)(nil).UpdateProject), c, p)
+}
+
+// MockUserServiceInterface is a mock of UserServiceInterface interface.
+type MockUserServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceInterfaceMockRecorder is the mock recorder for MockUserServiceInterface.
+type MockUserServiceInterfaceMockRecorder struct {
+	mock *MockUserServiceInterface
+}
+
+// NewMockUserServiceInterface creates a new mock instance.
+func NewMockUserServiceInterface(ctrl *gomock.Controller) *MockUserServiceInterface {
+	mock := &MockUserServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockUserServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserServiceInterface) EXPECT() *MockUserServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUserServiceInterface) Get(c *gofr.Context, id int) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserServiceInterface)(nil).Get), c, id)
+}
+
+// MockTaskServiceInterface is a mock of TaskServiceInterface interface.
+type MockTaskServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskServiceInterfaceMockRecorder is the mock recorder for MockTaskServiceInterface.
+type MockTaskServiceInterfaceMockRecorder struct {
+	mock *MockTaskServiceInterface
+}
+
+// NewMockTaskServiceInterface creates a new mock instance.
+func NewMockTaskServiceInterface(ctrl *gomock.Controller) *MockTaskServiceInterface {
+	mock := &MockTaskServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskServiceInterface) EXPECT() *MockTaskServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockTaskServiceInterface) All(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", c, f, q)
+	ret0, _ := ret[0].(page.Page[task.Task])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockTaskServiceInterfaceMockRecorder) All(c, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockTaskServiceInterface)(nil).All), c, f, q)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
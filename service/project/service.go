@@ -0,0 +1,210 @@
+package project
+
+import (
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type ProjectService struct {
+	str    ProjectStoreInterface
+	users  UserServiceInterface
+	tasks  TaskServiceInterface
+	policy Policy
+	now    func() time.Time
+}
+
+// Option configures optional collaborators of ProjectService.
+type Option func(*ProjectService)
+
+// WithPolicy checks every change to a project and its members against an access policy. Without one, anyone may
+// manage projects.
+func WithPolicy(p Policy) Option {
+	return func(s *ProjectService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp projects.
+func WithClock(now func() time.Time) Option {
+	return func(s *ProjectService) {
+		s.now = now
+	}
+}
+
+func NewService(s ProjectStoreInterface, users UserServiceInterface, tasks TaskServiceInterface, opts ...Option) *ProjectService {
+	svc := &ProjectService{
+		str:   s,
+		users: users,
+		tasks: tasks,
+		now:   svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Create adds a project, without members.
+func (s *ProjectService) Create(c *gofr.Context, p project.Project) (project.Project, error) {
+	if err := p.Validate(); err != nil {
+		return project.Project{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return project.Project{}, err
+	}
+
+	p.ID = 0
+	p.CreatedAt = s.now()
+	p.UpdatedAt = p.CreatedAt
+
+	return s.str.CreateProject(c, p)
+}
+
+func (s *ProjectService) Get(c *gofr.Context, id int) (project.Project, error) {
+	return s.str.GetByIDProject(c, id)
+}
+
+// All returns every project of the workspace, in name order.
+func (s *ProjectService) All(c *gofr.Context) ([]project.Project, error) {
+	return s.str.GetAllProject(c)
+}
+
+// Update replaces the name and description of a project at version ver.
+func (s *ProjectService) Update(c *gofr.Context, id, ver int, p project.Project) (project.Project, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return project.Project{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return project.Project{}, err
+	}
+
+	p.ID, p.Version, p.CreatedAt = cur.ID, cur.Version, cur.CreatedAt
+
+	if err := p.Validate(); err != nil {
+		return project.Project{}, err
+	}
+
+	p.UpdatedAt = s.now()
+
+	if err := s.str.UpdateProject(c, p); err != nil {
+		return project.Project{}, err
+	}
+
+	p.Version++
+
+	return p, nil
+}
+
+// Delete removes a project at version ver, or at any version if ver is version.Any. Its tasks are kept, in no
+// project.
+func (s *ProjectService) Delete(c *gofr.Context, id, ver int) error {
+	if _, err := s.get(c, id, ver); err != nil {
+		return err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return err
+	}
+
+	return s.str.DeleteProject(c, id, ver)
+}
+
+// Members returns the members of a project, who alone may be assigned its tasks.
+func (s *ProjectService) Members(c *gofr.Context, id int) ([]user.User, error) {
+	if _, err := s.str.GetByIDProject(c, id); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetMembersProject(c, id)
+}
+
+// AddMember makes a user a member of a project and returns its members.
+func (s *ProjectService) AddMember(c *gofr.Context, id, userID int) ([]user.User, error) {
+	if _, err := s.str.GetByIDProject(c, id); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.Get(c, userID); err != nil {
+		return nil, svcutil.Missing(err, "user", userID)
+	}
+
+	if err := s.authorize(c); err != nil {
+		return nil, err
+	}
+
+	if err := s.str.AddMemberProject(c, id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetMembersProject(c, id)
+}
+
+// RemoveMember removes a user from the members of a project and returns its members. Tasks of the project already
+// assigned to the user stay so, but cannot be assigned to them again.
+func (s *ProjectService) RemoveMember(c *gofr.Context, id, userID int) ([]user.User, error) {
+	if _, err := s.str.GetByIDProject(c, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return nil, err
+	}
+
+	if err := s.str.RemoveMemberProject(c, id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetMembersProject(c, id)
+}
+
+// Tasks returns a page of the tasks of a project matching the filter, along with the stats of all of its tasks.
+func (s *ProjectService) Tasks(c *gofr.Context, id int, f task.Filter, q page.Query) (project.Tasks, error) {
+	if _, err := s.str.GetByIDProject(c, id); err != nil {
+		return project.Tasks{}, err
+	}
+
+	stats, err := s.str.StatsProject(c, id)
+	if err != nil {
+		return project.Tasks{}, err
+	}
+
+	f.ProjectID = id
+
+	tasks, err := s.tasks.All(c, f, q)
+	if err != nil {
+		return project.Tasks{}, err
+	}
+
+	return project.Tasks{Page: tasks, Stats: stats}, nil
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may manage projects.
+func (s *ProjectService) authorize(c *gofr.Context) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, policy.ProjectManage, 0)
+}
+
+// get reads a project and checks it is still at version ver, unless ver is version.Any.
+func (s *ProjectService) get(c *gofr.Context, id, ver int) (project.Project, error) {
+	p, err := s.str.GetByIDProject(c, id)
+	if err == nil && ver != version.Any && p.Version != ver {
+		return p, version.ErrMismatch
+	}
+
+	return p, err
+}
@@ -0,0 +1,317 @@
+package project
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+var denied = errs.Forbidden{Permission: string(policy.ProjectManage)}
+
+func Test_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     project.Project
+		policyErr error
+		ifStore   bool
+		storeErr  error
+		expErr    error
+	}{
+		{name: "Valid Project", input: project.Project{ID: 7, Name: "Website"}, ifStore: true},
+		{name: "Missing Name", input: project.Project{Name: " "},
+			expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "Not Allowed", input: project.Project{Name: "Website"}, policyErr: denied, expErr: denied},
+		{name: "Store Error", input: project.Project{Name: "Website"}, ifStore: true, storeErr: errors.New("db down"),
+			expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockProjectStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.input.Name == "Website" {
+			mockPolicy.EXPECT().Authorize(ctx, policy.ProjectManage, 0).Return(tt.policyErr)
+		}
+
+		want := project.Project{Name: "Website", CreatedAt: stamp, UpdatedAt: stamp}
+
+		if tt.ifStore {
+			// the id is assigned by the store, whatever the request says
+			created := want
+			created.ID, created.Version = 2, 1
+
+			mockStore.EXPECT().CreateProject(ctx, want).Return(created, tt.storeErr)
+		}
+
+		res, err := service.Create(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 2, res.ID, tt.name)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	cur := project.Project{ID: 4, Name: "Website", Version: 2, CreatedAt: stamp.Add(-time.Hour), UpdatedAt: stamp.Add(-time.Hour)}
+
+	tests := []struct {
+		name      string
+		ver       int
+		input     project.Project
+		getErr    error
+		policyErr error
+		ifStore   bool
+		storeErr  error
+		expErr    error
+	}{
+		{name: "Valid Update", ver: 2, input: project.Project{Name: "Web", Description: "Relaunch"}, ifStore: true},
+		{name: "Any Version", ver: version.Any, input: project.Project{Name: "Web", Description: "Relaunch"}, ifStore: true},
+		{name: "Stale Version", ver: 1, input: project.Project{Name: "Web"}, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: 2, getErr: errs.NotFound{Entity: "project", ID: 4},
+			expErr: errs.NotFound{Entity: "project", ID: 4}},
+		{name: "Not Allowed", ver: 2, input: project.Project{Name: "Web"}, policyErr: denied, expErr: denied},
+		{name: "Missing Name", ver: 2, expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "Store Error", ver: 2, input: project.Project{Name: "Web", Description: "Relaunch"}, ifStore: true,
+			storeErr: version.ErrMismatch, expErr: version.ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockProjectStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDProject(ctx, 4).Return(cur, tt.getErr)
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.ProjectManage, 0).Return(tt.policyErr)
+		}
+
+		want := project.Project{ID: 4, Name: "Web", Description: "Relaunch", Version: 2, CreatedAt: cur.CreatedAt, UpdatedAt: stamp}
+
+		if tt.ifStore {
+			mockStore.EXPECT().UpdateProject(ctx, want).Return(tt.storeErr)
+		}
+
+		res, err := service.Update(ctx, 4, tt.ver, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			want.Version = 3
+
+			assert.Equal(t, want, res, tt.name)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ver       int
+		getErr    error
+		policyErr error
+		expErr    error
+	}{
+		{name: "Valid Delete", ver: 2},
+		{name: "Stale Version", ver: 1, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: version.Any, getErr: errs.NotFound{Entity: "project", ID: 4},
+			expErr: errs.NotFound{Entity: "project", ID: 4}},
+		{name: "Not Allowed", ver: version.Any, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockProjectStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDProject(ctx, 4).Return(project.Project{ID: 4, Version: 2}, tt.getErr)
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.ProjectManage, 0).Return(tt.policyErr)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().DeleteProject(ctx, 4, tt.ver).Return(nil)
+		}
+
+		err := service.Delete(ctx, 4, tt.ver)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_Members(t *testing.T) {
+	members := []user.User{{ID: 3, Name: "Ann"}}
+
+	tests := []struct {
+		name      string
+		remove    bool
+		getErr    error
+		userErr   error
+		policyErr error
+		expErr    error
+	}{
+		{name: "Add Member"},
+		{name: "Remove Member", remove: true},
+		{name: "Missing Project", getErr: errs.NotFound{Entity: "project", ID: 4},
+			expErr: errs.NotFound{Entity: "project", ID: 4}},
+		{name: "Missing User", userErr: errs.NotFound{Entity: "user", ID: 3},
+			expErr: errs.DependencyMissing{Entity: "user", ID: 3}},
+		{name: "Not Allowed To Add", policyErr: denied, expErr: denied},
+		{name: "Not Allowed To Remove", remove: true, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockProjectStoreInterface(ctrl)
+		mockUsers := NewMockUserServiceInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, mockUsers, nil, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDProject(ctx, 4).Return(project.Project{ID: 4}, tt.getErr)
+
+		if tt.getErr == nil && !tt.remove {
+			mockUsers.EXPECT().Get(ctx, 3).Return(user.User{ID: 3}, tt.userErr)
+		}
+
+		if tt.getErr == nil && tt.userErr == nil {
+			mockPolicy.EXPECT().Authorize(ctx, policy.ProjectManage, 0).Return(tt.policyErr)
+		}
+
+		var (
+			res []user.User
+			err error
+		)
+
+		if tt.expErr == nil {
+			if tt.remove {
+				mockStore.EXPECT().RemoveMemberProject(ctx, 4, 3).Return(nil)
+			} else {
+				mockStore.EXPECT().AddMemberProject(ctx, 4, 3).Return(nil)
+			}
+
+			mockStore.EXPECT().GetMembersProject(ctx, 4).Return(members, nil)
+		}
+
+		if tt.remove {
+			res, err = service.RemoveMember(ctx, 4, 3)
+		} else {
+			res, err = service.AddMember(ctx, 4, 3)
+		}
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, members, res, tt.name)
+		}
+	}
+}
+
+func Test_Tasks(t *testing.T) {
+	q := page.Query{Limit: 20, Sort: "id"}
+	stats := project.Stats{Total: 3, Open: 2, Done: 1}
+	tasks := page.Page[task.Task]{Items: []task.Task{{ID: 1}, {ID: 2}}, Total: 3, Limit: 2}
+
+	tests := []struct {
+		name     string
+		getErr   error
+		statsErr error
+		tasksErr error
+		expErr   error
+	}{
+		{name: "Tasks With Stats"},
+		{name: "Missing Project", getErr: errs.NotFound{Entity: "project", ID: 4},
+			expErr: errs.NotFound{Entity: "project", ID: 4}},
+		{name: "Stats Error", statsErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Tasks Error", tasksErr: errors.New("db down"), expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockProjectStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+
+		service := NewService(mockStore, nil, mockTasks)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDProject(ctx, 4).Return(project.Project{ID: 4}, tt.getErr)
+
+		if tt.getErr == nil {
+			mockStore.EXPECT().StatsProject(ctx, 4).Return(stats, tt.statsErr)
+		}
+
+		if tt.getErr == nil && tt.statsErr == nil {
+			// the tasks are always those of the project, whatever the filter says
+			mockTasks.EXPECT().All(ctx, task.Filter{Status: task.StatusTodo, ProjectID: 4}, q).Return(tasks, tt.tasksErr)
+		}
+
+		res, err := service.Tasks(ctx, 4, task.Filter{Status: task.StatusTodo, ProjectID: 9}, q)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, project.Tasks{Page: tasks, Stats: stats}, res, tt.name)
+		}
+	}
+}
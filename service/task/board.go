@@ -0,0 +1,156 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/rank"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+)
+
+// HasStatus reports whether st is a state of the workflow tasks move through, which a board column may show.
+func (s *TaskService) HasStatus(st task.Status) bool {
+	return s.workflow.HasState(st)
+}
+
+// Ranked returns the first live tasks matching the filter in the order of the columns of a board, at most limit of
+// them.
+func (s *TaskService) Ranked(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error) {
+	return s.str.GetRankedTask(c, f, limit)
+}
+
+// Move places a task in a board column between two of the tasks it shows, after moving the task to the status of
+// the column through a transition if it has another one. Only the rank of the task is rewritten otherwise:
+// reordering a column changes neither the version nor the history of the task. The column stays locked until the task
+// is placed, and the transition and the new rank are kept or lost together.
+func (s *TaskService) Move(c *gofr.Context, id int, p task.Placement) (task.Task, error) {
+	if !s.workflow.HasState(p.Status) {
+		return task.Task{}, errs.Validation{Field: "status", Reason: "unknown status " + string(p.Status)}
+	}
+
+	t, err := s.str.GetByIDTask(c, id)
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	if err := s.authorize(c, policy.TaskUpdate, t.Userid); err != nil {
+		return task.Task{}, err
+	}
+
+	if !shownIn(t, p) {
+		return task.Task{}, errs.Conflict{Entity: "task", ID: id,
+			Reason: fmt.Sprintf("is not in project %d, whose tasks alone the board shows", p.ProjectID)}
+	}
+
+	err = sqlutil.InTx(c, func(c *gofr.Context) error {
+		if err := s.str.LockColumnTask(c, p.Status); err != nil {
+			return err
+		}
+
+		r, err := s.placement(c, id, p)
+		if err != nil {
+			return err
+		}
+
+		if t.Status != p.Status {
+			if t, err = s.Transition(c, id, version.Any, p.Status); err != nil {
+				return err
+			}
+		}
+
+		return s.str.SetRankTask(c, id, r)
+	})
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	return t, nil
+}
+
+// placement returns the rank placing a task between the neighbours given by p. Neighbours ranked alike leave no room
+// between them, so the ranks of the column are spread out first.
+func (s *TaskService) placement(c *gofr.Context, id int, p task.Placement) (string, error) {
+	lo, hi, err := s.neighbourRanks(c, id, p)
+	if err != nil {
+		return "", err
+	}
+
+	if lo != "" && lo == hi {
+		if err := s.str.RebalanceRankTask(c, p.Status); err != nil {
+			return "", err
+		}
+
+		if lo, hi, err = s.neighbourRanks(c, id, p); err != nil {
+			return "", err
+		}
+	}
+
+	r, err := rank.Between(lo, hi)
+	if errors.Is(err, rank.ErrNoRoom) {
+		return "", errs.Conflict{Entity: "task", ID: id, Reason: "after and before are not in this order, reload the board"}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// the neighbours given are those the client saw, someone else may have moved a task between them since
+	n, err := s.str.CountRankedTask(c, task.Filter{Status: p.Status, ProjectID: p.ProjectID}, lo, hi, id)
+	if err != nil {
+		return "", err
+	}
+
+	if n > 0 {
+		return "", errs.Conflict{Entity: "task", ID: id,
+			Reason: "after and before are no longer next to each other, reload the board"}
+	}
+
+	return r, nil
+}
+
+// neighbourRanks returns the ranks of the tasks a moved task is placed after and before.
+func (s *TaskService) neighbourRanks(c *gofr.Context, id int, p task.Placement) (lo, hi string, err error) {
+	if lo, err = s.neighbourRank(c, id, p, p.After, "after"); err != nil {
+		return "", "", err
+	}
+
+	hi, err = s.neighbourRank(c, id, p, p.Before, "before")
+
+	return lo, hi, err
+}
+
+// neighbourRank returns the rank of the task a moved task is placed next to, which must be shown in the column the
+// task is moved to, or "" if there is none.
+func (s *TaskService) neighbourRank(c *gofr.Context, id int, p task.Placement, neighbour *int, field string) (string, error) {
+	if neighbour == nil {
+		return "", nil
+	}
+
+	if *neighbour == id {
+		return "", errs.Validation{Field: field, Reason: "must be another task than the one moved"}
+	}
+
+	t, err := s.str.GetByIDTask(c, *neighbour)
+	if errors.As(err, &errs.NotFound{}) {
+		return "", errs.DependencyMissing{Entity: "task", ID: *neighbour}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if t.Status != p.Status || !shownIn(t, p) {
+		return "", errs.Validation{Field: field, Reason: fmt.Sprintf("task %d is not in the column", *neighbour)}
+	}
+
+	return s.str.GetRankTask(c, *neighbour)
+}
+
+// shownIn reports whether a board of the project of p shows t, whatever its status.
+func shownIn(t task.Task, p task.Placement) bool {
+	return p.ProjectID == 0 || (t.ProjectID != nil && *t.ProjectID == p.ProjectID)
+}
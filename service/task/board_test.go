@@ -0,0 +1,243 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func Test_Move(t *testing.T) {
+	todo := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2, ProjectID: parent(4)}
+	above := task.Task{ID: 2, Status: task.StatusInProgress, ProjectID: parent(4)}
+	below := task.Task{ID: 3, Status: task.StatusInProgress, ProjectID: parent(4)}
+
+	doing := todo
+	doing.Status = task.StatusInProgress
+
+	tests := []struct {
+		name      string
+		placement task.Placement
+		cur       task.Task
+		ranks     map[int]string
+		between   int
+		expRank   string
+		expErr    error
+		// noTx is set when the move fails before the column is locked
+		noTx bool
+	}{
+		{name: "Between Two Tasks Of Another Column",
+			placement: task.Placement{Status: task.StatusInProgress, ProjectID: 4, After: parent(2), Before: parent(3)},
+			cur:       todo, ranks: map[int]string{2: "0000a", 3: "0000b"}, expRank: "0000ai"},
+		{name: "Top Of The Same Column", placement: task.Placement{Status: task.StatusInProgress, Before: parent(3)},
+			cur: doing, ranks: map[int]string{3: "0000b"}, expRank: "00005"},
+		{name: "Bottom Of A Column", placement: task.Placement{Status: task.StatusInProgress, After: parent(2)},
+			cur: todo, ranks: map[int]string{2: "0000a"}, expRank: "i"},
+		{name: "Empty Column", placement: task.Placement{Status: task.StatusInProgress}, cur: todo, expRank: "i"},
+		{name: "Unknown Status", placement: task.Placement{Status: "archived"},
+			expErr: errs.Validation{Field: "status", Reason: "unknown status archived"}, noTx: true},
+		{name: "Task Of Another Project", placement: task.Placement{Status: task.StatusTodo, ProjectID: 5}, cur: todo,
+			expErr: errs.Conflict{Entity: "task", ID: 1, Reason: "is not in project 5, whose tasks alone the board shows"}, noTx: true},
+		{name: "Next To Itself", placement: task.Placement{Status: task.StatusTodo, After: parent(1)}, cur: todo,
+			expErr: errs.Validation{Field: "after", Reason: "must be another task than the one moved"}},
+		{name: "Neighbour In Another Column", placement: task.Placement{Status: task.StatusTodo, Before: parent(3)}, cur: todo,
+			expErr: errs.Validation{Field: "before", Reason: "task 3 is not in the column"}},
+		{name: "Neighbours Out Of Order", placement: task.Placement{Status: task.StatusInProgress, After: parent(3), Before: parent(2)},
+			cur: todo, ranks: map[int]string{2: "0000a", 3: "0000b"},
+			expErr: errs.Conflict{Entity: "task", ID: 1, Reason: "after and before are not in this order, reload the board"}},
+		{name: "Neighbours No Longer Adjacent",
+			placement: task.Placement{Status: task.StatusInProgress, After: parent(2), Before: parent(3)},
+			cur:       todo, ranks: map[int]string{2: "0000a", 3: "0000b"}, between: 1,
+			expErr: errs.Conflict{Entity: "task", ID: 1, Reason: "after and before are no longer next to each other, reload the board"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, mock := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		// the task is placed in a transaction, given a copy of ctx
+		mockStore.EXPECT().GetByIDTask(gomock.Any(), 1).Return(tt.cur, nil).AnyTimes()
+		mockStore.EXPECT().GetByIDTask(gomock.Any(), 2).Return(above, nil).AnyTimes()
+		mockStore.EXPECT().GetByIDTask(gomock.Any(), 3).Return(below, nil).AnyTimes()
+
+		if !tt.noTx {
+			mock.SQL.ExpectBegin()
+			mockStore.EXPECT().LockColumnTask(gomock.Any(), tt.placement.Status).Return(nil)
+
+			if tt.expErr != nil {
+				mock.SQL.ExpectRollback()
+			} else {
+				mock.SQL.ExpectCommit()
+			}
+		}
+
+		for id, r := range tt.ranks {
+			mockStore.EXPECT().GetRankTask(gomock.Any(), id).Return(r, nil)
+		}
+
+		if tt.expRank != "" || tt.between > 0 {
+			lo, hi := "", ""
+			if tt.placement.After != nil {
+				lo = tt.ranks[*tt.placement.After]
+			}
+
+			if tt.placement.Before != nil {
+				hi = tt.ranks[*tt.placement.Before]
+			}
+
+			mockStore.EXPECT().CountRankedTask(gomock.Any(), task.Filter{Status: tt.placement.Status, ProjectID: tt.placement.ProjectID},
+				lo, hi, 1).Return(tt.between, nil)
+		}
+
+		if tt.expRank != "" {
+			if tt.placement.Status != tt.cur.Status {
+				mockStore.EXPECT().UpdateStatusTask(gomock.Any(), 1, 2, tt.placement.Status, stamp).Return(nil)
+				mockStore.EXPECT().CreateEventTask(gomock.Any(), event(1, task.EventTransitioned)).Return(nil)
+			}
+
+			mockStore.EXPECT().SetRankTask(gomock.Any(), 1, tt.expRank).Return(nil)
+		}
+
+		res, err := service.Move(ctx, 1, tt.placement)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, tt.placement.Status, res.Status, tt.name)
+		}
+
+		assert.NoError(t, mock.SQL.ExpectationsWereMet(), tt.name)
+	}
+}
+
+func Test_MoveRebalance(t *testing.T) {
+	todo := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2}
+	above := task.Task{ID: 2, Status: task.StatusTodo}
+	below := task.Task{ID: 3, Status: task.StatusTodo}
+
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(gomock.Any(), 1).Return(todo, nil)
+	mockStore.EXPECT().GetByIDTask(gomock.Any(), 2).Return(above, nil).Times(2)
+	mockStore.EXPECT().GetByIDTask(gomock.Any(), 3).Return(below, nil).Times(2)
+
+	mock.SQL.ExpectBegin()
+
+	// neighbours ranked alike leave no room between them until the column is spread out
+	gomock.InOrder(
+		mockStore.EXPECT().LockColumnTask(gomock.Any(), task.StatusTodo).Return(nil),
+		mockStore.EXPECT().GetRankTask(gomock.Any(), 2).Return("0000a", nil),
+		mockStore.EXPECT().GetRankTask(gomock.Any(), 3).Return("0000a", nil),
+		mockStore.EXPECT().RebalanceRankTask(gomock.Any(), task.StatusTodo).Return(nil),
+		mockStore.EXPECT().GetRankTask(gomock.Any(), 2).Return("00001", nil),
+		mockStore.EXPECT().GetRankTask(gomock.Any(), 3).Return("00002", nil),
+		mockStore.EXPECT().CountRankedTask(gomock.Any(), task.Filter{Status: task.StatusTodo}, "00001", "00002", 1).Return(0, nil),
+		mockStore.EXPECT().SetRankTask(gomock.Any(), 1, "00001i").Return(nil),
+	)
+
+	mock.SQL.ExpectCommit()
+
+	_, err := service.Move(ctx, 1, task.Placement{Status: task.StatusTodo, After: parent(2), Before: parent(3)})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.SQL.ExpectationsWereMet())
+}
+
+func Test_MoveFailures(t *testing.T) {
+	todo := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2}
+
+	tests := []struct {
+		name      string
+		neighbour error
+		rankErr   error
+		expErr    error
+	}{
+		{name: "Missing Neighbour", neighbour: errs.NotFound{Entity: "task", ID: 2},
+			expErr: errs.DependencyMissing{Entity: "task", ID: 2}},
+		{name: "Store Error", neighbour: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Rank Error", rankErr: errors.New("db down"), expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, mock := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mock.SQL.ExpectBegin()
+		mock.SQL.ExpectRollback()
+
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(todo, nil)
+		mockStore.EXPECT().LockColumnTask(gomock.Any(), task.StatusTodo).Return(nil)
+		mockStore.EXPECT().GetByIDTask(gomock.Any(), 2).Return(task.Task{ID: 2, Status: task.StatusTodo}, tt.neighbour)
+
+		if tt.neighbour == nil {
+			mockStore.EXPECT().GetRankTask(gomock.Any(), 2).Return("", tt.rankErr)
+		}
+
+		_, err := service.Move(ctx, 1, task.Placement{Status: task.StatusTodo, After: parent(2)})
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_Ranked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	tasks := []task.Task{{ID: 3}, {ID: 1}}
+	f := task.Filter{Status: task.StatusDone, ProjectID: 4}
+
+	mockStore.EXPECT().GetRankedTask(ctx, f, 101).Return(tasks, nil)
+
+	res, err := service.Ranked(ctx, f, 101)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tasks, res)
+}
+
+func Test_HasStatus(t *testing.T) {
+	service := NewService(nil, nil)
+
+	assert.True(t, service.HasStatus(task.StatusInReview))
+	assert.False(t, service.HasStatus("archived"))
+}
@@ -0,0 +1,266 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"net/http"
+	"time"
+)
+
+// planned is an operation of a bulk request once checked: the change to apply, and the task it changes as read.
+type planned struct {
+	index  int
+	change task.Change
+	before task.Task
+}
+
+// Bulk applies the operations of a bulk request, checked as the requests of their own would be, and reports what
+// became of each. In atomic mode one operation failing fails them all and none is applied; in best-effort mode the
+// others are applied all the same. The checks of all operations see the tasks as they were before the request.
+func (s *TaskService) Bulk(c *gofr.Context, b task.Bulk) (task.BulkReport, error) {
+	if b.Mode == "" {
+		b.Mode = task.BulkAtomic
+	}
+
+	if err := b.Validate(s.maxBulk); err != nil {
+		return task.BulkReport{}, err
+	}
+
+	report := task.BulkReport{Mode: b.Mode, Results: make([]task.BulkResult, len(b.Operations))}
+	plans := make([]planned, 0, len(b.Operations))
+	now := s.now()
+
+	for i, op := range b.Operations {
+		report.Results[i] = task.BulkResult{Action: op.Action, ID: op.ID}
+
+		p, err := s.plan(c, op, now)
+		if err != nil {
+			fail(&report, i, err)
+
+			continue
+		}
+
+		p.index = i
+		plans = append(plans, p)
+	}
+
+	if b.Mode == task.BulkBestEffort {
+		for _, p := range plans {
+			err := s.apply(c, &report, []planned{p})
+
+			var be task.BatchError
+			if errors.As(err, &be) {
+				err = be.Err
+			}
+
+			if err != nil {
+				fail(&report, p.index, changed(err, p.change.Task.ID))
+			}
+		}
+
+		return report, nil
+	}
+
+	if report.Failed > 0 {
+		return abort(report), nil
+	}
+
+	err := s.apply(c, &report, plans)
+
+	var be task.BatchError
+	if errors.As(err, &be) {
+		fail(&report, plans[be.Index].index, changed(be.Err, plans[be.Index].change.Task.ID))
+
+		return abort(report), nil
+	}
+
+	if err != nil {
+		return task.BulkReport{}, err
+	}
+
+	return report, nil
+}
+
+// apply applies planned operations all in one transaction and records them in the history of their tasks, then
+// reports them applied. The first change that fails is returned as a task.BatchError, and fails them all, as does
+// failing to audit one.
+func (s *TaskService) apply(c *gofr.Context, report *task.BulkReport, plans []planned) error {
+	changes := make([]task.Change, len(plans))
+	for k := range plans {
+		changes[k] = plans[k].change
+	}
+
+	var done []task.Task
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		var err error
+
+		if done, err = s.str.ApplyAllTask(c, changes); err != nil {
+			return err
+		}
+
+		for k, p := range plans {
+			if err := s.recordApplied(c, p, done[k]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for k, p := range plans {
+		s.applied(c, report, p, done[k])
+	}
+
+	return nil
+}
+
+// plan checks an operation of a bulk request as a request of its own would be, and returns the change it makes.
+func (s *TaskService) plan(c *gofr.Context, op task.Operation, now time.Time) (planned, error) {
+	if op.Action == task.BulkCreate {
+		t, err := s.prepare(c, *op.Task)
+
+		return planned{change: task.Change{Action: op.Action, Task: t}}, err
+	}
+
+	ver := op.Version
+	if ver == 0 {
+		ver = version.Any
+	}
+
+	cur, err := s.get(c, op.ID, ver)
+	if errors.Is(err, version.ErrMismatch) {
+		return planned{}, errs.Conflict{Entity: "task", ID: op.ID, Reason: fmt.Sprintf("is no longer at version %d", op.Version)}
+	}
+
+	if err != nil {
+		return planned{}, err
+	}
+
+	t := cur
+
+	switch op.Action {
+	case task.BulkComplete:
+		err = s.checkComplete(c, cur)
+		t.Status = s.workflow.Done()
+		t.UpdatedAt = now
+	case task.BulkDelete:
+		err = s.authorize(c, policy.TaskDelete, cur.Userid)
+		t.DeletedAt = &now
+	case task.BulkReassign:
+		err = s.checkReassign(c, cur, op.Userid)
+		t.Userid = op.Userid
+		t.UpdatedAt = now
+	}
+
+	return planned{change: task.Change{Action: op.Action, Task: t}, before: cur}, err
+}
+
+// checkComplete checks that a task may be completed, as Transition does.
+func (s *TaskService) checkComplete(c *gofr.Context, t task.Task) error {
+	if err := s.authorize(c, policy.TaskComplete, t.Userid); err != nil {
+		return err
+	}
+
+	if !s.workflow.CanTransition(t.Status, s.workflow.Done()) {
+		return ErrIllegalTransition{From: t.Status, To: s.workflow.Done(), Allowed: s.workflow.Allowed(t.Status)}
+	}
+
+	if err := s.checkChildrenClosed(c, t.ID); err != nil {
+		return err
+	}
+
+	return s.checkBlockersClosed(c, t.ID)
+}
+
+// checkReassign checks that a task may be assigned to userid, as Update does.
+func (s *TaskService) checkReassign(c *gofr.Context, t task.Task, userid int) error {
+	if err := s.authorize(c, policy.TaskUpdate, t.Userid); err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, policy.TaskAssign, userid); err != nil {
+		return err
+	}
+
+	if _, err := s.userServiceref.Get(c, userid); err != nil {
+		return svcutil.Missing(err, "user", userid)
+	}
+
+	return s.checkProject(c, t.ProjectID, userid)
+}
+
+// recordApplied records an applied operation in the history of its task.
+func (s *TaskService) recordApplied(c *gofr.Context, p planned, t task.Task) error {
+	switch p.change.Action {
+	case task.BulkCreate:
+		return s.record(c, task.EventCreated, t.ID, t.CreatedAt, nil, &t)
+	case task.BulkComplete:
+		return s.record(c, task.EventCompleted, t.ID, t.UpdatedAt, &p.before, &t)
+	case task.BulkDelete:
+		return s.record(c, task.EventDeleted, t.ID, *t.DeletedAt, &p.before, nil)
+	default:
+		return s.record(c, task.EventReassigned, t.ID, t.UpdatedAt, &p.before, &t)
+	}
+}
+
+// applied reports an applied operation, rolls up the completion of a completed task to its parents and reopens the
+// closed parents of a created one.
+func (s *TaskService) applied(c *gofr.Context, report *task.BulkReport, p planned, t task.Task) {
+	r := &report.Results[p.index]
+	r.ID, r.Status, r.Task = t.ID, http.StatusOK, &t
+	report.Succeeded++
+
+	switch p.change.Action {
+	case task.BulkCreate:
+		r.Status = http.StatusCreated
+		s.reopened(c, t)
+	case task.BulkComplete:
+		s.rollUp(c, t.ParentID, t.UpdatedAt)
+	case task.BulkDelete:
+		r.Task = nil
+	}
+}
+
+// fail reports a failed operation, with the status and message of its error.
+func fail(report *task.BulkReport, i int, err error) {
+	r := &report.Results[i]
+	r.Status, r.Error = http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)
+	report.Failed++
+
+	var coded interface{ StatusCode() int }
+	if errors.As(err, &coded) {
+		r.Status, r.Error = coded.StatusCode(), err.Error()
+	}
+}
+
+// abort reports the operations of an atomic request that did not fail as not applied, for one that did.
+func abort(report task.BulkReport) task.BulkReport {
+	for i := range report.Results {
+		if r := &report.Results[i]; r.Status == 0 {
+			r.Status, r.Error = http.StatusFailedDependency, "not applied, another operation failed"
+			report.Failed++
+		}
+	}
+
+	return report
+}
+
+// changed turns a change that found its task at another version than read into a conflict, the task having been
+// changed since.
+func changed(err error, id int) error {
+	if errors.Is(err, version.ErrMismatch) {
+		return errs.Conflict{Entity: "task", ID: id, Reason: "changed concurrently, reload and retry"}
+	}
+
+	return err
+}
@@ -0,0 +1,243 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"net/http"
+	"testing"
+)
+
+// bulkTasks are the tasks read by the operations of bulkOps
+var bulkTasks = map[int]task.Task{
+	4: {ID: 4, Desc: "Fix login", Status: task.StatusInProgress, Userid: 10, Version: 2},
+	5: {ID: 5, Desc: "Write docs", Status: task.StatusTodo, Userid: 10, Version: 1},
+	6: {ID: 6, Desc: "Old spike", Status: task.StatusTodo, Userid: 10, Version: 7},
+}
+
+var bulkOps = []task.Operation{
+	{Action: task.BulkCreate, Task: &task.Task{Desc: "Triage", Userid: 10}},
+	{Action: task.BulkComplete, ID: 4},
+	{Action: task.BulkReassign, ID: 5, Userid: 11},
+	{Action: task.BulkDelete, ID: 6, Version: 7},
+}
+
+// bulkChanges are the changes bulkOps make
+func bulkChanges() []task.Change {
+	done, moved, trashed := bulkTasks[4], bulkTasks[5], bulkTasks[6]
+	done.Status, done.UpdatedAt = task.StatusDone, stamp
+	trashed.DeletedAt = &stamp
+	moved.Userid, moved.UpdatedAt = 11, stamp
+
+	return []task.Change{
+		{Action: task.BulkCreate, Task: task.Task{Desc: "Triage", Status: task.StatusTodo, Userid: 10, Priority: task.DefaultPriority,
+			CreatedAt: stamp, UpdatedAt: stamp}},
+		{Action: task.BulkComplete, Task: done},
+		{Action: task.BulkReassign, Task: moved},
+		{Action: task.BulkDelete, Task: trashed},
+	}
+}
+
+// expectBulkChecks expects the reads of checking bulkOps
+func expectBulkChecks(ctx *gofr.Context, mockStore *MockTaskStoreInterface, mockUserServ *MockUserServiceInterface) {
+	mockUserServ.EXPECT().Get(ctx, 10).Return(user.User{ID: 10}, nil)
+	mockUserServ.EXPECT().Get(ctx, 11).Return(user.User{ID: 11}, nil)
+
+	for id, t := range bulkTasks {
+		mockStore.EXPECT().GetByIDTask(ctx, id).Return(t, nil)
+	}
+
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 4).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 4).Return(0, nil)
+}
+
+// applied returns the tasks the changes of bulkOps are stored as
+func applied() []task.Task {
+	ch := bulkChanges()
+	out := make([]task.Task, len(ch))
+
+	for i := range ch {
+		out[i] = ch[i].Task
+		out[i].Version++
+	}
+
+	out[0].ID, out[0].Version = 9, 1
+
+	return out
+}
+
+func Test_Bulk(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	t.Run("Atomic", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
+		expectBulkChecks(ctx, mockStore, mockUserServ)
+		mockStore.EXPECT().ApplyAllTask(ctx, bulkChanges()).Return(applied(), nil)
+		mockStore.EXPECT().CreateEventTask(ctx, event(9, task.EventCreated)).Return(nil)
+		mockStore.EXPECT().CreateEventTask(ctx, event(4, task.EventCompleted)).Return(nil)
+		mockStore.EXPECT().CreateEventTask(ctx, event(5, task.EventReassigned)).Return(nil)
+		mockStore.EXPECT().CreateEventTask(ctx, event(6, task.EventDeleted)).Return(nil)
+
+		report, err := service.Bulk(ctx, task.Bulk{Operations: bulkOps})
+
+		assert.NoError(t, err)
+		assert.Equal(t, task.BulkAtomic, report.Mode)
+		assert.Equal(t, 4, report.Succeeded)
+		assert.Equal(t, 0, report.Failed)
+		assert.Equal(t, task.BulkResult{Action: task.BulkCreate, ID: 9, Status: http.StatusCreated, Task: &applied()[0]}, report.Results[0])
+		assert.Equal(t, task.StatusDone, report.Results[1].Task.Status)
+		assert.Equal(t, 11, report.Results[2].Task.Userid)
+		assert.Equal(t, task.BulkResult{Action: task.BulkDelete, ID: 6, Status: http.StatusOK}, report.Results[3])
+	})
+
+	t.Run("Atomic Check Fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockStore.EXPECT().GetByIDTask(ctx, 4).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 4})
+		mockStore.EXPECT().GetByIDTask(ctx, 6).Return(bulkTasks[6], nil)
+
+		report, err := service.Bulk(ctx, task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{
+			{Action: task.BulkComplete, ID: 4}, {Action: task.BulkDelete, ID: 6}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, report.Succeeded)
+		assert.Equal(t, 2, report.Failed)
+		assert.Equal(t, task.BulkResult{Action: task.BulkComplete, ID: 4, Status: http.StatusNotFound, Error: "task with id 4 not found"},
+			report.Results[0])
+		assert.Equal(t, http.StatusFailedDependency, report.Results[1].Status)
+	})
+
+	t.Run("Atomic Write Fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
+		expectBulkChecks(ctx, mockStore, mockUserServ)
+		mockStore.EXPECT().ApplyAllTask(ctx, bulkChanges()).Return(nil, task.BatchError{Index: 2, Err: version.ErrMismatch})
+
+		report, err := service.Bulk(ctx, task.Bulk{Operations: bulkOps})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, report.Succeeded)
+		assert.Equal(t, 4, report.Failed)
+		assert.Equal(t, task.BulkResult{Action: task.BulkReassign, ID: 5, Status: http.StatusConflict,
+			Error: "task 5: changed concurrently, reload and retry"}, report.Results[2])
+		assert.Equal(t, http.StatusFailedDependency, report.Results[0].Status)
+
+		expectBulkChecks(ctx, mockStore, mockUserServ)
+		mockStore.EXPECT().ApplyAllTask(ctx, bulkChanges()).Return(nil, errors.New("no connection"))
+
+		_, err = service.Bulk(ctx, task.Bulk{Operations: bulkOps})
+
+		assert.EqualError(t, err, "no connection")
+	})
+
+	t.Run("Best Effort", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+		service := NewService(mockStore, mockUserServ, WithPolicy(mockPolicy), fixedClock)
+
+		denied := errs.Forbidden{Permission: string(policy.TaskDelete)}
+
+		mockStore.EXPECT().GetByIDTask(ctx, 4).Return(bulkTasks[4], nil)
+		mockStore.EXPECT().GetByIDTask(ctx, 5).Return(bulkTasks[5], nil)
+		mockStore.EXPECT().GetByIDTask(ctx, 6).Return(bulkTasks[6], nil)
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 4).Return(0, nil)
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 4).Return(0, nil)
+		mockPolicy.EXPECT().Authorize(ctx, policy.TaskComplete, 10).Return(nil)
+		mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 10).Return(nil)
+		mockPolicy.EXPECT().Authorize(ctx, policy.TaskAssign, 11).Return(nil)
+		mockPolicy.EXPECT().Authorize(ctx, policy.TaskDelete, 10).Return(denied)
+		mockUserServ.EXPECT().Get(ctx, 11).Return(user.User{ID: 11}, nil)
+
+		ch, out := bulkChanges(), applied()
+
+		mockStore.EXPECT().ApplyAllTask(ctx, ch[1:2]).Return(out[1:2], nil)
+		mockStore.EXPECT().ApplyAllTask(ctx, ch[2:3]).Return(nil, task.BatchError{Index: 0, Err: version.ErrMismatch})
+		mockStore.EXPECT().CreateEventTask(ctx, event(4, task.EventCompleted)).Return(nil)
+
+		report, err := service.Bulk(ctx, task.Bulk{Mode: task.BulkBestEffort, Operations: bulkOps[1:]})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Succeeded)
+		assert.Equal(t, 2, report.Failed)
+		assert.Equal(t, http.StatusOK, report.Results[0].Status)
+		assert.Equal(t, http.StatusConflict, report.Results[1].Status)
+		assert.Equal(t, task.BulkResult{Action: task.BulkDelete, ID: 6, Status: http.StatusForbidden, Error: denied.Error()},
+			report.Results[2])
+	})
+
+	t.Run("Stale Version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockStore.EXPECT().GetByIDTask(ctx, 6).Return(bulkTasks[6], nil)
+
+		report, err := service.Bulk(ctx, task.Bulk{Mode: task.BulkBestEffort, Operations: []task.Operation{
+			{Action: task.BulkDelete, ID: 6, Version: 3}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, task.BulkResult{Action: task.BulkDelete, ID: 6, Status: http.StatusConflict,
+			Error: "task 6: is no longer at version 3"}, report.Results[0])
+	})
+
+	t.Run("Too Many Operations", func(t *testing.T) {
+		service := NewService(nil, nil, WithMaxBulk(2))
+
+		_, err := service.Bulk(ctx, task.Bulk{Operations: bulkOps})
+
+		assert.Equal(t, errs.Validation{Field: "operations", Reason: "must have between 1 and 2 operations"}, err)
+	})
+}
+
+func Test_BulkValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		bulk   task.Bulk
+		expErr error
+	}{
+		{name: "Valid", bulk: task.Bulk{Mode: task.BulkBestEffort, Operations: bulkOps}},
+		{name: "Unknown Mode", bulk: task.Bulk{Mode: "eventually", Operations: bulkOps},
+			expErr: errs.Validation{Field: "mode", Reason: "must be atomic or best_effort"}},
+		{name: "No Operations", bulk: task.Bulk{Mode: task.BulkAtomic},
+			expErr: errs.Validation{Field: "operations", Reason: "must have between 1 and 100 operations"}},
+		{name: "Unknown Action", bulk: task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{{Action: "archive", ID: 4}}},
+			expErr: errs.Validation{Field: "operations[0].action", Reason: "must be one of create, complete, delete, reassign"}},
+		{name: "Create Without Task", bulk: task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{{Action: task.BulkCreate}}},
+			expErr: errs.Validation{Field: "operations[0].task", Reason: "must be set to create a task"}},
+		{name: "Missing ID", bulk: task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{{Action: task.BulkDelete}}},
+			expErr: errs.Validation{Field: "operations[0].id", Reason: "must be set to delete a task"}},
+		{name: "Reassign Without User", bulk: task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{{Action: task.BulkReassign, ID: 4}}},
+			expErr: errs.Validation{Field: "operations[0].userid", Reason: "must be set to reassign a task"}},
+		{name: "Task Changed Twice", bulk: task.Bulk{Mode: task.BulkAtomic, Operations: []task.Operation{
+			{Action: task.BulkComplete, ID: 4}, {Action: task.BulkDelete, ID: 4}}},
+			expErr: errs.Validation{Field: "operations[1].id", Reason: "is already changed by operations[0]"}},
+	}
+
+	for _, tt := range tests {
+		err := tt.bulk.Validate(task.DefaultMaxBulk)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
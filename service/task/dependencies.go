@@ -0,0 +1,164 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"slices"
+)
+
+// Blockers returns the tasks blocking a task.
+func (s *TaskService) Blockers(c *gofr.Context, id int) ([]task.Task, error) {
+	if _, err := s.str.GetByIDTask(c, id); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetBlockersTask(c, id)
+}
+
+// AddBlocker records that a task is blocked by another one and returns the tasks blocking it. A link that would
+// make a task wait on itself, directly or through other tasks, is refused.
+func (s *TaskService) AddBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error) {
+	if id == blocker {
+		return nil, errs.Validation{Field: "blocker", Reason: "a task cannot block itself"}
+	}
+
+	if err := s.authorizeOn(c, policy.TaskUpdate, id); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.str.GetByIDTask(c, blocker); err != nil {
+		if errors.As(err, &errs.NotFound{}) {
+			return nil, errs.DependencyMissing{Entity: "task", ID: blocker}
+		}
+
+		return nil, err
+	}
+
+	deps, err := s.str.GetBlockerGraphTask(c, blocker)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.ContainsFunc(deps, func(d task.Dependency) bool { return d.BlockerID == id }) {
+		return nil, errs.Validation{Field: "blocker", Reason: fmt.Sprintf("task %d already waits on task %d", blocker, id)}
+	}
+
+	if err := s.str.AddBlockerTask(c, id, blocker); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetBlockersTask(c, id)
+}
+
+// RemoveBlocker removes the link between a task and one of its blockers and returns the tasks still blocking it.
+func (s *TaskService) RemoveBlocker(c *gofr.Context, id, blocker int) ([]task.Task, error) {
+	if err := s.authorizeOn(c, policy.TaskUpdate, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.str.RemoveBlockerTask(c, id, blocker); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetBlockersTask(c, id)
+}
+
+// checkBlockersClosed refuses to close a task while some of the tasks blocking it are still open.
+func (s *TaskService) checkBlockersClosed(c *gofr.Context, id int) error {
+	n, err := s.str.CountOpenBlockersTask(c, id)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		return errs.Conflict{Entity: "task", ID: id, Reason: fmt.Sprintf("blocked by %d open tasks", n)}
+	}
+
+	return nil
+}
+
+// CriticalPath returns the chain of open blockers of a task with the largest total estimate, in the order the
+// work has to be done and ending with the task itself. Tasks without an estimate count for nothing; among chains
+// of the same total the one through the lowest ids wins.
+func (s *TaskService) CriticalPath(c *gofr.Context, id int) (task.Path, error) {
+	root, err := s.str.GetByIDTask(c, id)
+	if err != nil {
+		return task.Path{}, err
+	}
+
+	deps, err := s.str.GetBlockerGraphTask(c, id)
+	if err != nil {
+		return task.Path{}, err
+	}
+
+	blockers, err := s.str.GetTransitiveBlockersTask(c, id)
+	if err != nil {
+		return task.Path{}, err
+	}
+
+	open := map[int]task.Task{root.ID: root}
+
+	for _, t := range blockers {
+		if !s.workflow.IsClosed(t.Status) {
+			open[t.ID] = t
+		}
+	}
+
+	blockedBy := make(map[int][]int)
+
+	for _, d := range deps {
+		blockedBy[d.TaskID] = append(blockedBy[d.TaskID], d.BlockerID)
+	}
+
+	cp := criticalPath{open: open, blockedBy: blockedBy, memo: map[int]task.Path{}, visiting: map[int]bool{}}
+
+	return cp.from(root.ID), nil
+}
+
+// criticalPath finds the longest chains of blockers by depth-first search, remembering the chain found from each
+// task.
+type criticalPath struct {
+	open      map[int]task.Task
+	blockedBy map[int][]int
+	memo      map[int]task.Path
+	visiting  map[int]bool
+}
+
+// from returns the longest chain ending at the task id.
+func (cp *criticalPath) from(id int) task.Path {
+	if p, ok := cp.memo[id]; ok {
+		return p
+	}
+
+	cp.visiting[id] = true
+
+	best := task.Path{Tasks: []task.Task{}}
+
+	for _, b := range cp.blockedBy[id] {
+		// links are checked for cycles as they are added, skipping the ones on the way only guards the search
+		if _, ok := cp.open[b]; !ok || cp.visiting[b] {
+			continue
+		}
+
+		if p := cp.from(b); p.Estimate > best.Estimate || len(best.Tasks) == 0 {
+			best = p
+		}
+	}
+
+	delete(cp.visiting, id)
+
+	t := cp.open[id]
+
+	p := task.Path{Tasks: append(slices.Clone(best.Tasks), t), Estimate: best.Estimate}
+	if t.Estimate != nil {
+		p.Estimate += *t.Estimate
+	}
+
+	cp.memo[id] = p
+
+	return p
+}
@@ -0,0 +1,196 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func hours(h int) *int {
+	return &h
+}
+
+func Test_AddBlocker(t *testing.T) {
+	blockers := []task.Task{{ID: 1, Desc: "Design"}}
+
+	tests := []struct {
+		name       string
+		id         int
+		blocker    int
+		blockerErr error
+		graph      []task.Dependency
+		expRes     []task.Task
+		expErr     error
+	}{
+		{
+			name:    "Valid Link",
+			id:      2,
+			blocker: 1,
+			graph:   []task.Dependency{},
+			expRes:  blockers,
+		},
+		{
+			name:    "Self Link",
+			id:      2,
+			blocker: 2,
+			expErr:  errs.Validation{Field: "blocker", Reason: "a task cannot block itself"},
+		},
+		{
+			name:       "Blocker Not Found",
+			id:         2,
+			blocker:    9,
+			blockerErr: errs.NotFound{Entity: "task", ID: 9},
+			expErr:     errs.DependencyMissing{Entity: "task", ID: 9},
+		},
+		{
+			name:    "Cycle",
+			id:      2,
+			blocker: 4,
+			graph:   []task.Dependency{{TaskID: 3, BlockerID: 2}, {TaskID: 4, BlockerID: 3}},
+			expErr:  errs.Validation{Field: "blocker", Reason: "task 4 already waits on task 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.id != tt.blocker {
+			mockStore.EXPECT().GetByIDTask(ctx, tt.id).Return(task.Task{ID: tt.id}, nil)
+			mockStore.EXPECT().GetByIDTask(ctx, tt.blocker).Return(task.Task{ID: tt.blocker}, tt.blockerErr)
+		}
+
+		if tt.graph != nil {
+			mockStore.EXPECT().GetBlockerGraphTask(ctx, tt.blocker).Return(tt.graph, nil)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().AddBlockerTask(ctx, tt.id, tt.blocker).Return(nil)
+			mockStore.EXPECT().GetBlockersTask(ctx, tt.id).Return(blockers, nil)
+		}
+
+		res, err := service.AddBlocker(ctx, tt.id, tt.blocker)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.Equal(t, tt.expRes, res, tt.name)
+	}
+}
+
+func Test_RemoveBlocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2}, nil)
+	mockStore.EXPECT().RemoveBlockerTask(ctx, 2, 1).Return(nil)
+	mockStore.EXPECT().GetBlockersTask(ctx, 2).Return([]task.Task{}, nil)
+
+	res, err := service.RemoveBlocker(ctx, 2, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []task.Task{}, res)
+
+	mockStore.EXPECT().GetByIDTask(ctx, 9).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 9})
+
+	_, err = service.Blockers(ctx, 9)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 9}, err)
+}
+
+func Test_CompleteBlockedTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Desc: "Build", Status: task.StatusInReview, Version: 3}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 2).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 2).Return(1, nil)
+
+	err := service.Complete(ctx, 2)
+	assert.Equal(t, errs.Conflict{Entity: "task", ID: 2, Reason: "blocked by 1 open tasks"}, err)
+}
+
+func Test_CriticalPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// 5 is blocked by 3 and 4; 3 by 1 and 2; 4 by 2. 1 is done, so the longest open chain is 2, 4, 5
+	root := task.Task{ID: 5, Desc: "Release", Status: task.StatusTodo, Estimate: hours(1)}
+	t1 := task.Task{ID: 1, Desc: "Spec", Status: task.StatusDone, Estimate: hours(40)}
+	t2 := task.Task{ID: 2, Desc: "Schema", Status: task.StatusInProgress, Estimate: hours(3)}
+	t3 := task.Task{ID: 3, Desc: "API", Status: task.StatusTodo, Estimate: hours(2)}
+	t4 := task.Task{ID: 4, Desc: "UI", Status: task.StatusTodo, Estimate: hours(5)}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 5).Return(root, nil)
+	mockStore.EXPECT().GetBlockerGraphTask(ctx, 5).Return([]task.Dependency{
+		{TaskID: 3, BlockerID: 1}, {TaskID: 3, BlockerID: 2}, {TaskID: 4, BlockerID: 2}, {TaskID: 5, BlockerID: 3}, {TaskID: 5, BlockerID: 4},
+	}, nil)
+	mockStore.EXPECT().GetTransitiveBlockersTask(ctx, 5).Return([]task.Task{t1, t2, t3, t4}, nil)
+
+	res, err := service.CriticalPath(ctx, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, task.Path{Tasks: []task.Task{t2, t4, root}, Estimate: 9}, res)
+}
+
+func Test_CriticalPathWithoutBlockers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	root := task.Task{ID: 5, Desc: "Release", Status: task.StatusTodo}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 5).Return(root, nil)
+	mockStore.EXPECT().GetBlockerGraphTask(ctx, 5).Return([]task.Dependency{}, nil)
+	mockStore.EXPECT().GetTransitiveBlockersTask(ctx, 5).Return([]task.Task{}, nil)
+
+	res, err := service.CriticalPath(ctx, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, task.Path{Tasks: []task.Task{root}}, res)
+}
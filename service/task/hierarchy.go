@@ -0,0 +1,256 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"slices"
+	"time"
+)
+
+// Children returns the direct subtasks of a task.
+func (s *TaskService) Children(c *gofr.Context, id int) ([]task.Task, error) {
+	if _, err := s.str.GetByIDTask(c, id); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetChildrenTask(c, id)
+}
+
+// Subtree returns a task with its subtasks, nested to any depth.
+func (s *TaskService) Subtree(c *gofr.Context, id int) (task.Node, error) {
+	root, err := s.str.GetByIDTask(c, id)
+	if err != nil {
+		return task.Node{}, err
+	}
+
+	descendants, err := s.str.GetSubtreeTask(c, id)
+	if err != nil {
+		return task.Node{}, err
+	}
+
+	return nest(root, childrenOf(descendants)), nil
+}
+
+// childrenOf groups tasks by the id of their parent.
+func childrenOf(tasks []task.Task) map[int][]task.Task {
+	children := make(map[int][]task.Task)
+
+	for _, t := range tasks {
+		if t.ParentID != nil {
+			children[*t.ParentID] = append(children[*t.ParentID], t)
+		}
+	}
+
+	return children
+}
+
+func nest(t task.Task, children map[int][]task.Task) task.Node {
+	n := task.Node{Task: t, Children: []task.Node{}}
+
+	for _, child := range children[t.ID] {
+		n.Children = append(n.Children, nest(child, children))
+	}
+
+	return n
+}
+
+// height returns the number of levels of the subtree rooted at id, 1 for a task without subtasks.
+func height(id int, children map[int][]task.Task) int {
+	h := 0
+
+	for _, child := range children[id] {
+		h = max(h, height(child.ID, children))
+	}
+
+	return h + 1
+}
+
+// checkParent checks that t, a new task if its ID is 0, can be placed under parentID: the parent exists, t is not
+// one of its ancestors, and the hierarchy stays within task.MaxDepth levels.
+func (s *TaskService) checkParent(c *gofr.Context, t task.Task, parentID *int) error {
+	if parentID == nil {
+		return nil
+	}
+
+	pid := *parentID
+
+	if pid == t.ID {
+		return errs.Validation{Field: "parent_id", Reason: "a task cannot be its own parent"}
+	}
+
+	if _, err := s.str.GetByIDTask(c, pid); err != nil {
+		if errors.As(err, &errs.NotFound{}) {
+			return errs.DependencyMissing{Entity: "task", ID: pid}
+		}
+
+		return err
+	}
+
+	ancestors, err := s.str.GetAncestorsTask(c, pid)
+	if err != nil {
+		return err
+	}
+
+	if t.ID != 0 && slices.Contains(ancestors, t.ID) {
+		return errs.Validation{Field: "parent_id", Reason: "a task cannot be moved under its own subtask"}
+	}
+
+	levels := 1
+
+	if t.ID != 0 {
+		descendants, err := s.str.GetSubtreeTask(c, t.ID)
+		if err != nil {
+			return err
+		}
+
+		levels = height(t.ID, childrenOf(descendants))
+	}
+
+	if len(ancestors)+1+levels > task.MaxDepth {
+		return errs.Validation{Field: "parent_id", Reason: fmt.Sprintf("hierarchies may be at most %d levels deep", task.MaxDepth)}
+	}
+
+	return nil
+}
+
+// checkChildrenClosed refuses to close a task while some of its subtasks are still open.
+func (s *TaskService) checkChildrenClosed(c *gofr.Context, id int) error {
+	n, err := s.str.CountOpenChildrenTask(c, id)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		return errs.Conflict{Entity: "task", ID: id, Reason: fmt.Sprintf("has %d open subtasks", n)}
+	}
+
+	return nil
+}
+
+// rollUp completes the parent of a task that was just closed once none of its subtasks nor blockers are open,
+// and so on up the hierarchy. Roll-up is best effort: the closed task stays closed if a parent cannot be completed.
+func (s *TaskService) rollUp(c *gofr.Context, parentID *int, at time.Time) {
+	for parentID != nil {
+		parent, err := s.str.GetByIDTask(c, *parentID)
+		if err != nil {
+			c.Errorf("rolling up completion to task %d: %v", *parentID, err)
+
+			return
+		}
+
+		if s.workflow.IsClosed(parent.Status) || !s.workflow.CanTransition(parent.Status, s.workflow.Done()) {
+			return
+		}
+
+		open, err := s.hasOpenWork(c, parent.ID)
+		if err != nil {
+			c.Errorf("rolling up completion to task %d: %v", parent.ID, err)
+
+			return
+		}
+
+		if open {
+			return
+		}
+
+		closed := parent
+		closed.Status, closed.UpdatedAt, closed.Version = s.workflow.Done(), at, parent.Version+1
+
+		err = s.audited(c, func(c *gofr.Context) error {
+			if err := s.str.UpdateStatusTask(c, parent.ID, parent.Version, closed.Status, at); err != nil {
+				return err
+			}
+
+			return s.record(c, task.EventCompleted, parent.ID, at, &parent, &closed)
+		})
+		if err != nil {
+			c.Errorf("rolling up completion to task %d: %v", parent.ID, err)
+
+			return
+		}
+
+		parentID = parent.ParentID
+	}
+}
+
+// hasOpenWork reports whether some of the subtasks or blockers of a task are still open.
+func (s *TaskService) hasOpenWork(c *gofr.Context, id int) (bool, error) {
+	n, err := s.str.CountOpenChildrenTask(c, id)
+	if err != nil || n > 0 {
+		return n > 0, err
+	}
+
+	n, err = s.str.CountOpenBlockersTask(c, id)
+
+	return n > 0, err
+}
+
+// checkReopen refuses to give an open subtask to parentID if a closed task it would reopen, the parent or one of its
+// closed ancestors up to the first open one, cannot move back to the initial state.
+func (s *TaskService) checkReopen(c *gofr.Context, parentID *int) error {
+	for parentID != nil {
+		parent, err := s.str.GetByIDTask(c, *parentID)
+		if err != nil {
+			return err
+		}
+
+		if !s.workflow.IsClosed(parent.Status) {
+			return nil
+		}
+
+		if !s.workflow.CanTransition(parent.Status, s.workflow.Initial()) {
+			return errs.Conflict{Entity: "task", ID: parent.ID,
+				Reason: fmt.Sprintf("is %s and cannot be reopened for an open subtask", parent.Status)}
+		}
+
+		parentID = parent.ParentID
+	}
+
+	return nil
+}
+
+// reopened reopens the closed parents of a task just created, reopened, restored or moved under them, if it is open.
+func (s *TaskService) reopened(c *gofr.Context, t task.Task) {
+	if !s.workflow.IsClosed(t.Status) {
+		s.reopen(c, t.ParentID, s.now())
+	}
+}
+
+// reopen moves the parent of a task that was just opened, or placed under it while open, back to the initial state
+// if it is closed, and so on up the hierarchy to the first open task. Like roll-up, reopening is best effort: the
+// task stays open if a parent cannot be reopened.
+func (s *TaskService) reopen(c *gofr.Context, parentID *int, at time.Time) {
+	for parentID != nil {
+		parent, err := s.str.GetByIDTask(c, *parentID)
+		if err != nil {
+			c.Errorf("reopening task %d: %v", *parentID, err)
+
+			return
+		}
+
+		if !s.workflow.IsClosed(parent.Status) || !s.workflow.CanTransition(parent.Status, s.workflow.Initial()) {
+			return
+		}
+
+		opened := parent
+		opened.Status, opened.UpdatedAt, opened.Version = s.workflow.Initial(), at, parent.Version+1
+
+		err = s.audited(c, func(c *gofr.Context) error {
+			if err := s.str.UpdateStatusTask(c, parent.ID, parent.Version, opened.Status, at); err != nil {
+				return err
+			}
+
+			return s.record(c, task.EventTransitioned, parent.ID, at, &parent, &opened)
+		})
+		if err != nil {
+			c.Errorf("reopening task %d: %v", parent.ID, err)
+
+			return
+		}
+
+		parentID = parent.ParentID
+	}
+}
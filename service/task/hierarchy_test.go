@@ -0,0 +1,460 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func parent(id int) *int {
+	return &id
+}
+
+func Test_CreateSubtask(t *testing.T) {
+	tests := []struct {
+		name      string
+		parentErr error
+		ancestors []int
+		expErr    error
+	}{
+		{
+			name:      "Valid Subtask",
+			ancestors: []int{1, 7},
+		},
+		{
+			name:      "Parent Not Found",
+			parentErr: errs.NotFound{Entity: "task", ID: 2},
+			expErr:    errs.DependencyMissing{Entity: "task", ID: 2},
+		},
+		{
+			name:      "Too Deep",
+			ancestors: []int{1, 7, 8, 9},
+			expErr:    errs.Validation{Field: "parent_id", Reason: "hierarchies may be at most 5 levels deep"},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		in := task.Task{Desc: "Write tests", Userid: 10, ParentID: parent(2)}
+
+		mockUserServ.EXPECT().Get(ctx, 10).Return(user.User{ID: 10}, nil)
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2}, tt.parentErr)
+
+		if tt.parentErr == nil {
+			mockStore.EXPECT().GetAncestorsTask(ctx, 2).Return(tt.ancestors, nil)
+		}
+
+		if tt.expErr == nil {
+			// the parent is open, before and after, so there is nothing to reopen
+			mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusInProgress}, nil).Times(2)
+			mockStore.EXPECT().CreateTask(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, t task.Task) (task.Task, error) {
+				t.ID = 3
+
+				return t, nil
+			})
+			mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventCreated)).Return(nil)
+		}
+
+		res, err := service.Create(ctx, in)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, parent(2), res.ParentID, tt.name)
+		}
+	}
+}
+
+func Test_MoveTask(t *testing.T) {
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Priority: task.PriorityP2, Version: 2}
+
+	// task 1 has a subtask 4, which has a subtask 5
+	subtree := []task.Task{{ID: 4, ParentID: parent(1)}, {ID: 5, ParentID: parent(4)}}
+
+	tests := []struct {
+		name      string
+		parentID  *int
+		ancestors []int
+		expErr    error
+	}{
+		{
+			name:      "Valid Move",
+			parentID:  parent(3),
+			ancestors: []int{6},
+		},
+		{
+			name:     "Own Parent",
+			parentID: parent(1),
+			expErr:   errs.Validation{Field: "parent_id", Reason: "a task cannot be its own parent"},
+		},
+		{
+			name:      "Under Own Subtask",
+			parentID:  parent(5),
+			ancestors: []int{4, 1},
+			expErr:    errs.Validation{Field: "parent_id", Reason: "a task cannot be moved under its own subtask"},
+		},
+		{
+			name:      "Too Deep",
+			parentID:  parent(3),
+			ancestors: []int{6, 7},
+			expErr:    errs.Validation{Field: "parent_id", Reason: "hierarchies may be at most 5 levels deep"},
+		},
+		{
+			name: "Detach From Parent",
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		current := cur
+		if tt.parentID == nil {
+			current.ParentID = parent(3)
+		}
+
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(current, nil)
+
+		if tt.ancestors != nil {
+			mockStore.EXPECT().GetByIDTask(ctx, *tt.parentID).Return(task.Task{ID: *tt.parentID}, nil)
+			mockStore.EXPECT().GetAncestorsTask(ctx, *tt.parentID).Return(tt.ancestors, nil)
+		}
+
+		if tt.ancestors != nil && tt.name != "Under Own Subtask" {
+			mockStore.EXPECT().GetSubtreeTask(ctx, 1).Return(subtree, nil)
+		}
+
+		if tt.expErr == nil && tt.parentID != nil {
+			mockStore.EXPECT().GetByIDTask(ctx, *tt.parentID).Return(task.Task{ID: *tt.parentID, Status: task.StatusTodo}, nil).Times(2)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().UpdateTask(ctx, gomock.Any()).Return(nil)
+			mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventUpdated)).Return(nil)
+		}
+
+		res, err := service.Update(ctx, 1, 2, task.Task{Desc: "Work", Userid: 1, ParentID: tt.parentID})
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, tt.parentID, res.ParentID, tt.name)
+		}
+	}
+}
+
+func Test_CompleteWithSubtasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Desc: "Epic", Status: task.StatusInReview, Version: 3}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(2, nil)
+
+	err := service.Complete(ctx, 1)
+	assert.Equal(t, errs.Conflict{Entity: "task", ID: 1, Reason: "has 2 open subtasks"}, err)
+}
+
+func Test_CompletionRollUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// 3 is the last open subtask of 2, which is the last open subtask of 1; 1 is a subtask of 9, which is done
+	gomock.InOrder(
+		mockStore.EXPECT().GetByIDTask(ctx, 3).Return(task.Task{ID: 3, Status: task.StatusInReview, ParentID: parent(2), Version: 1}, nil),
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 3).Return(0, nil),
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 3).Return(0, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 3, 1, task.StatusDone, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventCompleted)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusInReview, ParentID: parent(1), Version: 4}, nil),
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 2).Return(0, nil),
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 2).Return(0, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 2, 4, task.StatusDone, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(2, task.EventCompleted)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Status: task.StatusInReview, ParentID: parent(9), Version: 2}, nil),
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil),
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(0, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 1, 2, task.StatusDone, stamp).Return(errors.New("db write failed")),
+	)
+
+	assert.NoError(t, service.Complete(ctx, 3))
+}
+
+func Test_CompletionRollUpStopsAtOpenSiblings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 3).Return(task.Task{ID: 3, Status: task.StatusInProgress, ParentID: parent(2), Version: 1}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 3).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 3).Return(0, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 3, 1, task.StatusCancelled, stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventTransitioned)).Return(nil)
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusInReview, Version: 4}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 2).Return(1, nil)
+
+	res, err := service.Transition(ctx, 3, 1, task.StatusCancelled)
+
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCancelled, res.Status)
+}
+
+func Test_CancelWithSubtasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// every closed status is guarded, not only the one tasks are completed in
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Desc: "Epic", Status: task.StatusInProgress, Version: 3}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(1, nil)
+
+	_, err := service.Transition(ctx, 1, 3, task.StatusCancelled)
+	assert.Equal(t, errs.Conflict{Entity: "task", ID: 1, Reason: "blocked by 1 open tasks"}, err)
+}
+
+func Test_CompletionRollUpCountFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// the parent is left as it is, the subtask stays done
+	gomock.InOrder(
+		mockStore.EXPECT().GetByIDTask(ctx, 3).Return(task.Task{ID: 3, Status: task.StatusInReview, ParentID: parent(2), Version: 1}, nil),
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 3).Return(0, nil),
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 3).Return(0, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 3, 1, task.StatusDone, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventCompleted)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusInReview, Version: 4}, nil),
+		mockStore.EXPECT().CountOpenChildrenTask(ctx, 2).Return(0, nil),
+		mockStore.EXPECT().CountOpenBlockersTask(ctx, 2).Return(0, errors.New("db down")),
+	)
+
+	assert.NoError(t, service.Complete(ctx, 3))
+}
+
+func Test_ReopenParents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// 3 is reopened under 2, which is done under 1, which is cancelled under 9, which is open
+	done := task.Task{ID: 2, Status: task.StatusDone, ParentID: parent(1), Version: 4}
+	cancelled := task.Task{ID: 1, Status: task.StatusCancelled, ParentID: parent(9), Version: 2}
+	open := task.Task{ID: 9, Status: task.StatusInProgress, Version: 7}
+
+	gomock.InOrder(
+		mockStore.EXPECT().GetByIDTask(ctx, 3).Return(task.Task{ID: 3, Status: task.StatusDone, ParentID: parent(2), Version: 1}, nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(done, nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cancelled, nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 9).Return(open, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 3, 1, task.StatusInProgress, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventTransitioned)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(done, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 2, 4, task.StatusTodo, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(2, task.EventTransitioned)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cancelled, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 1, 2, task.StatusTodo, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventTransitioned)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 9).Return(open, nil),
+	)
+
+	res, err := service.Transition(ctx, 3, 1, task.StatusInProgress)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusInProgress, res.Status)
+
+	// a new open subtask reopens its parent as well
+	gomock.InOrder(
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusDone, Version: 4}, nil).Times(2),
+		mockStore.EXPECT().CreateTask(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, t task.Task) (task.Task, error) {
+			t.ID = 5
+
+			return t, nil
+		}),
+		mockStore.EXPECT().CreateEventTask(ctx, event(5, task.EventCreated)).Return(nil),
+		mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: task.StatusDone, Version: 4}, nil),
+		mockStore.EXPECT().UpdateStatusTask(ctx, 2, 4, task.StatusTodo, stamp).Return(nil),
+		mockStore.EXPECT().CreateEventTask(ctx, event(2, task.EventTransitioned)).Return(nil),
+	)
+
+	mockStore.EXPECT().GetAncestorsTask(ctx, 2).Return(nil, nil)
+
+	mockUserServ := NewMockUserServiceInterface(ctrl)
+	mockUserServ.EXPECT().Get(ctx, 10).Return(user.User{ID: 10}, nil)
+
+	service = NewService(mockStore, mockUserServ, fixedClock)
+
+	_, err = service.Create(ctx, task.Task{Desc: "Fix regression", Userid: 10, ParentID: parent(2)})
+	assert.NoError(t, err)
+}
+
+func Test_ReopenParentsRefused(t *testing.T) {
+	// shipped tasks can never be reopened in this workflow
+	wf, err := ParseWorkflow("todo:doing|shipped;doing:shipped|todo;shipped*:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock, WithWorkflow(wf))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Status: "doing", Userid: 1, Version: 2}, nil)
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: "shipped", Version: 3}, nil).Times(2)
+	mockStore.EXPECT().GetAncestorsTask(ctx, 2).Return(nil, nil)
+	mockStore.EXPECT().GetSubtreeTask(ctx, 1).Return(nil, nil)
+
+	_, err = service.Update(ctx, 1, 2, task.Task{Desc: "Work", Userid: 1, ParentID: parent(2)})
+	assert.Equal(t, errs.Conflict{Entity: "task", ID: 2, Reason: "is shipped and cannot be reopened for an open subtask"}, err)
+}
+
+func Test_Subtree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	root := task.Task{ID: 1, Desc: "Epic"}
+	a := task.Task{ID: 2, Desc: "Story", ParentID: parent(1)}
+	b := task.Task{ID: 3, Desc: "Story", ParentID: parent(1)}
+	c := task.Task{ID: 4, Desc: "Subtask", ParentID: parent(2)}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(root, nil)
+	mockStore.EXPECT().GetSubtreeTask(ctx, 1).Return([]task.Task{a, b, c}, nil)
+
+	res, err := service.Subtree(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, task.Node{Task: root, Children: []task.Node{
+		{Task: a, Children: []task.Node{{Task: c, Children: []task.Node{}}}},
+		{Task: b, Children: []task.Node{}},
+	}}, res)
+
+	mockStore.EXPECT().GetByIDTask(ctx, 5).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 5})
+
+	_, err = service.Subtree(ctx, 5)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 5}, err)
+}
+
+func Test_Children(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	children := []task.Task{{ID: 2, ParentID: parent(1)}}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1}, nil)
+	mockStore.EXPECT().GetChildrenTask(ctx, 1).Return(children, nil)
+
+	res, err := service.Children(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, children, res)
+
+	mockStore.EXPECT().GetByIDTask(ctx, 5).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 5})
+
+	_, err = service.Children(ctx, 5)
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 5}, err)
+}
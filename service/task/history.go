@@ -0,0 +1,55 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+// History returns a page of the events recorded for a task. The history of a task outlives it, so it can still be
+// read once the task is in the trash or purged.
+func (s *TaskService) History(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error) {
+	events, err := s.str.GetEventsTask(c, id, q)
+	if err != nil {
+		return events, err
+	}
+
+	if events.Total == 0 {
+		if _, err := s.str.GetByIDTask(c, id); err != nil {
+			return page.Page[task.Event]{}, err
+		}
+	}
+
+	return events, nil
+}
+
+// record appends an event made at the given time by the user making the request to the history of a task, and to
+// the audit log if the service has one. The event is best effort, but the audit entry is not: its error is returned
+// for the change it describes to be rolled back, see audited.
+func (s *TaskService) record(c *gofr.Context, typ task.EventType, id int, at time.Time, before, after *task.Task) error {
+	e := task.Event{TaskID: id, Type: typ, ActorID: middleware.GetActor(c), At: at, Before: before, After: after}
+
+	if err := s.str.CreateEventTask(c, e); err != nil {
+		c.Errorf("recording %s event of task %d: %v", typ, id, err)
+	}
+
+	if s.auditor == nil {
+		return nil
+	}
+
+	return s.auditor.Record(c, audit.EntityTask, id, string(typ), audit.Change{Before: before, After: after})
+}
+
+// audited makes a change that records itself. If the service has an auditor, the change runs in a transaction along
+// with its audit entry, so that no change is kept without one.
+func (s *TaskService) audited(c *gofr.Context, change func(c *gofr.Context) error) error {
+	if s.auditor == nil {
+		return change(c)
+	}
+
+	return sqlutil.InTx(c, change)
+}
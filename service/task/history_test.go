@@ -0,0 +1,235 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// event matches the event of the given type recorded for task id
+func event(id int, typ task.EventType) gomock.Matcher {
+	return gomock.Cond(func(e task.Event) bool {
+		return e.TaskID == id && e.Type == typ
+	})
+}
+
+// secret signs the access tokens of actorRequest
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+// actorRequest is a request made by the user with the given id, as seen after the Authenticate middleware with the
+// public routes of the service
+func actorRequest(id int) *gofrHttp.Request {
+	req := httptest.NewRequest(http.MethodPut, "/task/1", http.NoBody)
+
+	token, _ := auth.Issue(secret, id, workspace.Default, auth.TypeAccess, "", time.Now(), time.Hour)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var out *http.Request
+
+	middleware.Authenticate(secret, nil, middleware.PublicRoutes...)(nil, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		out = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	return gofrHttp.NewRequest(out)
+}
+
+func Test_History(t *testing.T) {
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+
+	tests := []struct {
+		name   string
+		events page.Page[task.Event]
+		getErr error
+		expErr error
+	}{
+		{
+			name:   "Live Task",
+			events: page.Page[task.Event]{Items: []task.Event{{ID: 1, TaskID: 1, Type: task.EventCreated}}, Total: 1, Limit: 20},
+		},
+		{
+			name:   "Purged Task",
+			events: page.Page[task.Event]{Items: []task.Event{{ID: 7, TaskID: 1, Type: task.EventDeleted}}, Total: 1, Limit: 20},
+		},
+		{
+			name:   "No Events Yet",
+			events: page.Page[task.Event]{Items: []task.Event{}, Limit: 20},
+		},
+		{
+			name:   "Unknown Task",
+			events: page.Page[task.Event]{Items: []task.Event{}, Limit: 20},
+			getErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr: errs.NotFound{Entity: "task", ID: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetEventsTask(ctx, 1, q).Return(tt.events, nil)
+
+		if tt.events.Total == 0 {
+			mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1}, tt.getErr)
+		}
+
+		res, err := service.History(ctx, 1, q)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, tt.events, res, tt.name)
+		}
+	}
+}
+
+func Test_RecordReassignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+	mockUserServ := NewMockUserServiceInterface(ctrl)
+
+	service := NewService(mockStore, mockUserServ, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+		Request:   actorRequest(7),
+	}
+
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Priority: task.PriorityP2, Version: 2}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+	mockUserServ.EXPECT().Get(ctx, 2).Return(user.User{ID: 2}, nil)
+	mockStore.EXPECT().UpdateTask(ctx, gomock.Any()).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, e task.Event) error {
+		assert.Equal(t, task.EventReassigned, e.Type)
+		assert.Equal(t, 7, *e.ActorID)
+		assert.Equal(t, stamp, e.At)
+		assert.Equal(t, cur, *e.Before)
+		assert.Equal(t, 2, e.After.Userid)
+		assert.Equal(t, 3, e.After.Version)
+
+		return nil
+	})
+
+	_, err := service.Update(ctx, 1, 2, task.Task{Desc: "Work", Userid: 2})
+
+	assert.NoError(t, err)
+}
+
+func Test_RecordAnonymousDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	// a job, such as purging the trash, acts on behalf of nobody
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+	mockStore.EXPECT().DeleteTask(ctx, 1, 2, stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, task.Event{TaskID: 1, Type: task.EventDeleted, At: stamp, Before: &cur}).Return(nil)
+
+	assert.NoError(t, service.Delete(ctx, 1, 2))
+}
+
+func Test_RecordFailureKeepsChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Status: task.StatusTodo, Version: 2}, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 1, 2, task.StatusInProgress, stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventTransitioned)).Return(errors.New("db write failed"))
+
+	res, err := service.Transition(ctx, 1, 2, task.StatusInProgress)
+
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusInProgress, res.Status)
+}
+
+func Test_RecordAudited(t *testing.T) {
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2}
+
+	tests := []struct {
+		name     string
+		auditErr error
+	}{
+		{name: "Audited With The Change"},
+		{name: "Audit Failure Rolls Back The Change", auditErr: errors.New("db write failed")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockAuditor := NewMockAuditor(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock, WithAuditor(mockAuditor))
+
+		mockContainer, mock := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		// the change and its records are given the context of the transaction they run in
+		mock.SQL.ExpectBegin()
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+		mockStore.EXPECT().DeleteTask(gomock.Any(), 1, 2, stamp).Return(nil)
+		mockStore.EXPECT().CreateEventTask(gomock.Any(), event(1, task.EventDeleted)).Return(errors.New("db write failed"))
+		mockAuditor.EXPECT().Record(gomock.Any(), audit.EntityTask, 1, "deleted", audit.Change{Before: &cur, After: (*task.Task)(nil)}).
+			Return(tt.auditErr)
+
+		if tt.auditErr != nil {
+			mock.SQL.ExpectRollback()
+		} else {
+			mock.SQL.ExpectCommit()
+		}
+
+		err := service.Delete(ctx, 1, 2)
+
+		assert.Equal(t, tt.auditErr, err, tt.name)
+	}
+}
@@ -1,20 +1,80 @@
 package task
 
 import (
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
 	"github.com/MGajendra22/GoFr/model/task"
 	userModel "github.com/MGajendra22/GoFr/model/user"
 	"gofr.dev/pkg/gofr"
+	"time"
 )
 
 type TaskStoreInterface interface {
 	CreateTask(c *gofr.Context, task task.Task) (task.Task, error)
+	CreateManyTask(c *gofr.Context, ts []task.Task) ([]task.Task, error)
+	ApplyAllTask(c *gofr.Context, changes []task.Change) ([]task.Task, error)
 	GetByIDTask(c *gofr.Context, id int) (task.Task, error)
-	GetAllTask(c *gofr.Context) ([]task.Task, error)
-	CompleteTask(c *gofr.Context, id int) error
-	DeleteTask(c *gofr.Context, id int) error
+	GetAllTask(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error)
+	UpdateTask(c *gofr.Context, t task.Task) error
+	UpdateStatusTask(c *gofr.Context, id, ver int, to task.Status, at time.Time) error
+	DeleteTask(c *gofr.Context, id, ver int, at time.Time) error
+	RestoreTask(c *gofr.Context, id int) error
+	PurgeTask(c *gofr.Context, before time.Time) (int64, error)
 	GetTasksByUserIDTask(c *gofr.Context, userId int) ([]task.Task, error)
+	AttachTagTask(c *gofr.Context, id int, name string) error
+	DetachTagTask(c *gofr.Context, id int, name string) error
+	GetTagsByTaskIDTask(c *gofr.Context, id int) ([]string, error)
+	GetAllTagsTask(c *gofr.Context) ([]task.Tag, error)
+	GetChildrenTask(c *gofr.Context, id int) ([]task.Task, error)
+	GetSubtreeTask(c *gofr.Context, id int) ([]task.Task, error)
+	GetAncestorsTask(c *gofr.Context, id int) ([]int, error)
+	CountOpenChildrenTask(c *gofr.Context, id int) (int, error)
+	AddBlockerTask(c *gofr.Context, id, blocker int) error
+	RemoveBlockerTask(c *gofr.Context, id, blocker int) error
+	GetBlockersTask(c *gofr.Context, id int) ([]task.Task, error)
+	CountOpenBlockersTask(c *gofr.Context, id int) (int, error)
+	GetBlockerGraphTask(c *gofr.Context, id int) ([]task.Dependency, error)
+	GetTransitiveBlockersTask(c *gofr.Context, id int) ([]task.Task, error)
+	GetNextTask(c *gofr.Context, userid int) (task.Task, error)
+	GetDueTask(c *gofr.Context, now time.Time) ([]task.Task, error)
+	MarkRemindedTask(c *gofr.Context, id int, at time.Time) error
+	CreateEventTask(c *gofr.Context, e task.Event) error
+	GetEventsTask(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error)
+	GetRankedTask(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error)
+	GetRankTask(c *gofr.Context, id int) (string, error)
+	SetRankTask(c *gofr.Context, id int, r string) error
+	CountRankedTask(c *gofr.Context, f task.Filter, lo, hi string, exclude int) (int, error)
+	LockColumnTask(c *gofr.Context, status task.Status) error
+	RebalanceRankTask(c *gofr.Context, status task.Status) error
+	CreateRecurrenceTask(c *gofr.Context, r task.Recurrence) (task.Recurrence, error)
+	GetByIDRecurrenceTask(c *gofr.Context, id int) (task.Recurrence, error)
+	GetAllRecurrenceTask(c *gofr.Context) ([]task.Recurrence, error)
+	GetPendingRecurrenceTask(c *gofr.Context, now time.Time) ([]task.Recurrence, error)
+	UpdateRecurrenceTask(c *gofr.Context, r task.Recurrence) error
+	AdvanceRecurrenceTask(c *gofr.Context, r task.Recurrence) error
+	DeleteRecurrenceTask(c *gofr.Context, id, ver int) error
 }
 
 type UserServiceInterface interface {
 	Get(c *gofr.Context, id int) (userModel.User, error)
 }
+
+// Projects tells whether a user is a member of a project, and so may be assigned its tasks.
+type Projects interface {
+	IsMemberProject(c *gofr.Context, id, userID int) (bool, error)
+}
+
+// Notifier delivers the reminders of tasks passing their due date.
+type Notifier interface {
+	Notify(c *gofr.Context, r task.Reminder) error
+}
+
+// Auditor appends the changes made by the service to the audit log.
+type Auditor interface {
+	Record(c *gofr.Context, entity string, id int, action string, data any) error
+}
+
+// Policy decides whether the user making a request may use a permission on a task assigned to owner.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
@@ -11,7 +11,10 @@ package task
 
 import (
 	reflect "reflect"
+	time "time"
 
+	page "github.com/MGajendra22/GoFr/model/page"
+	policy "github.com/MGajendra22/GoFr/model/policy"
 	task "github.com/MGajendra22/GoFr/model/task"
 	user "github.com/MGajendra22/GoFr/model/user"
 	gomock "go.uber.org/mock/gomock"
@@ -42,18 +45,150 @@ func (m *MockTaskStoreInterface) EXPECT() *MockTaskStoreInterfaceMockRecorder {
 	return m.recorder
 }
 
-// CompleteTask mocks base method.
-func (m *MockTaskStoreInterface) CompleteTask(c *gofr.Context, id int) error {
+// AddBlockerTask mocks base method.
+func (m *MockTaskStoreInterface) AddBlockerTask(c *gofr.Context, id, blocker int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompleteTask", c, id)
+	ret := m.ctrl.Call(m, "AddBlockerTask", c, id, blocker)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CompleteTask indicates an expected call of CompleteTask.
-func (mr *MockTaskStoreInterfaceMockRecorder) CompleteTask(c, id any) *gomock.Call {
+// AddBlockerTask indicates an expected call of AddBlockerTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) AddBlockerTask(c, id, blocker any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CompleteTask), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlockerTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).AddBlockerTask), c, id, blocker)
+}
+
+// AdvanceRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) AdvanceRecurrenceTask(c *gofr.Context, r task.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceRecurrenceTask", c, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdvanceRecurrenceTask indicates an expected call of AdvanceRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) AdvanceRecurrenceTask(c, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).AdvanceRecurrenceTask), c, r)
+}
+
+// ApplyAllTask mocks base method.
+func (m *MockTaskStoreInterface) ApplyAllTask(c *gofr.Context, changes []task.Change) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyAllTask", c, changes)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyAllTask indicates an expected call of ApplyAllTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) ApplyAllTask(c, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAllTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).ApplyAllTask), c, changes)
+}
+
+// AttachTagTask mocks base method.
+func (m *MockTaskStoreInterface) AttachTagTask(c *gofr.Context, id int, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTagTask", c, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachTagTask indicates an expected call of AttachTagTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) AttachTagTask(c, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTagTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).AttachTagTask), c, id, name)
+}
+
+// CountOpenBlockersTask mocks base method.
+func (m *MockTaskStoreInterface) CountOpenBlockersTask(c *gofr.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenBlockersTask", c, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenBlockersTask indicates an expected call of CountOpenBlockersTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CountOpenBlockersTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenBlockersTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CountOpenBlockersTask), c, id)
+}
+
+// CountOpenChildrenTask mocks base method.
+func (m *MockTaskStoreInterface) CountOpenChildrenTask(c *gofr.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenChildrenTask", c, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenChildrenTask indicates an expected call of CountOpenChildrenTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CountOpenChildrenTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenChildrenTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CountOpenChildrenTask), c, id)
+}
+
+// CountRankedTask mocks base method.
+func (m *MockTaskStoreInterface) CountRankedTask(c *gofr.Context, f task.Filter, lo, hi string, exclude int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRankedTask", c, f, lo, hi, exclude)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRankedTask indicates an expected call of CountRankedTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CountRankedTask(c, f, lo, hi, exclude any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRankedTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CountRankedTask), c, f, lo, hi, exclude)
+}
+
+// CreateEventTask mocks base method.
+func (m *MockTaskStoreInterface) CreateEventTask(c *gofr.Context, e task.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTask", c, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventTask indicates an expected call of CreateEventTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CreateEventTask(c, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CreateEventTask), c, e)
+}
+
+// CreateManyTask mocks base method.
+func (m *MockTaskStoreInterface) CreateManyTask(c *gofr.Context, ts []task.Task) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyTask", c, ts)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyTask indicates an expected call of CreateManyTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CreateManyTask(c, ts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CreateManyTask), c, ts)
+}
+
+// CreateRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) CreateRecurrenceTask(c *gofr.Context, r task.Recurrence) (task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurrenceTask", c, r)
+	ret0, _ := ret[0].(task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurrenceTask indicates an expected call of CreateRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) CreateRecurrenceTask(c, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CreateRecurrenceTask), c, r)
 }
 
 // CreateTask mocks base method.
@@ -71,33 +206,151 @@ func (mr *MockTaskStoreInterfaceMockRecorder) CreateTask(c, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).CreateTask), c, arg1)
 }
 
+// DeleteRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) DeleteRecurrenceTask(c *gofr.Context, id, ver int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurrenceTask", c, id, ver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurrenceTask indicates an expected call of DeleteRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) DeleteRecurrenceTask(c, id, ver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).DeleteRecurrenceTask), c, id, ver)
+}
+
 // DeleteTask mocks base method.
-func (m *MockTaskStoreInterface) DeleteTask(c *gofr.Context, id int) error {
+func (m *MockTaskStoreInterface) DeleteTask(c *gofr.Context, id, ver int, at time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTask", c, id)
+	ret := m.ctrl.Call(m, "DeleteTask", c, id, ver, at)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTask indicates an expected call of DeleteTask.
-func (mr *MockTaskStoreInterfaceMockRecorder) DeleteTask(c, id any) *gomock.Call {
+func (mr *MockTaskStoreInterfaceMockRecorder) DeleteTask(c, id, ver, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).DeleteTask), c, id, ver, at)
+}
+
+// DetachTagTask mocks base method.
+func (m *MockTaskStoreInterface) DetachTagTask(c *gofr.Context, id int, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTagTask", c, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachTagTask indicates an expected call of DetachTagTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) DetachTagTask(c, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTagTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).DetachTagTask), c, id, name)
+}
+
+// GetAllRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) GetAllRecurrenceTask(c *gofr.Context) ([]task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRecurrenceTask", c)
+	ret0, _ := ret[0].([]task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRecurrenceTask indicates an expected call of GetAllRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetAllRecurrenceTask(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).DeleteTask), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetAllRecurrenceTask), c)
+}
+
+// GetAllTagsTask mocks base method.
+func (m *MockTaskStoreInterface) GetAllTagsTask(c *gofr.Context) ([]task.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTagsTask", c)
+	ret0, _ := ret[0].([]task.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTagsTask indicates an expected call of GetAllTagsTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetAllTagsTask(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTagsTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetAllTagsTask), c)
 }
 
 // GetAllTask mocks base method.
-func (m *MockTaskStoreInterface) GetAllTask(c *gofr.Context) ([]task.Task, error) {
+func (m *MockTaskStoreInterface) GetAllTask(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTask", c)
-	ret0, _ := ret[0].([]task.Task)
+	ret := m.ctrl.Call(m, "GetAllTask", c, f, q)
+	ret0, _ := ret[0].(page.Page[task.Task])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllTask indicates an expected call of GetAllTask.
-func (mr *MockTaskStoreInterfaceMockRecorder) GetAllTask(c any) *gomock.Call {
+func (mr *MockTaskStoreInterfaceMockRecorder) GetAllTask(c, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetAllTask), c, f, q)
+}
+
+// GetAncestorsTask mocks base method.
+func (m *MockTaskStoreInterface) GetAncestorsTask(c *gofr.Context, id int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestorsTask", c, id)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestorsTask indicates an expected call of GetAncestorsTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetAncestorsTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestorsTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetAncestorsTask), c, id)
+}
+
+// GetBlockerGraphTask mocks base method.
+func (m *MockTaskStoreInterface) GetBlockerGraphTask(c *gofr.Context, id int) ([]task.Dependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockerGraphTask", c, id)
+	ret0, _ := ret[0].([]task.Dependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockerGraphTask indicates an expected call of GetBlockerGraphTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetBlockerGraphTask(c, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetAllTask), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockerGraphTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetBlockerGraphTask), c, id)
+}
+
+// GetBlockersTask mocks base method.
+func (m *MockTaskStoreInterface) GetBlockersTask(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockersTask", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockersTask indicates an expected call of GetBlockersTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetBlockersTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockersTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetBlockersTask), c, id)
+}
+
+// GetByIDRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) GetByIDRecurrenceTask(c *gofr.Context, id int) (task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDRecurrenceTask", c, id)
+	ret0, _ := ret[0].(task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDRecurrenceTask indicates an expected call of GetByIDRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetByIDRecurrenceTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetByIDRecurrenceTask), c, id)
 }
 
 // GetByIDTask mocks base method.
@@ -115,6 +368,141 @@ func (mr *MockTaskStoreInterfaceMockRecorder) GetByIDTask(c, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetByIDTask), c, id)
 }
 
+// GetChildrenTask mocks base method.
+func (m *MockTaskStoreInterface) GetChildrenTask(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildrenTask", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildrenTask indicates an expected call of GetChildrenTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetChildrenTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildrenTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetChildrenTask), c, id)
+}
+
+// GetDueTask mocks base method.
+func (m *MockTaskStoreInterface) GetDueTask(c *gofr.Context, now time.Time) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueTask", c, now)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueTask indicates an expected call of GetDueTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetDueTask(c, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetDueTask), c, now)
+}
+
+// GetEventsTask mocks base method.
+func (m *MockTaskStoreInterface) GetEventsTask(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsTask", c, id, q)
+	ret0, _ := ret[0].(page.Page[task.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsTask indicates an expected call of GetEventsTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetEventsTask(c, id, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetEventsTask), c, id, q)
+}
+
+// GetNextTask mocks base method.
+func (m *MockTaskStoreInterface) GetNextTask(c *gofr.Context, userid int) (task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextTask", c, userid)
+	ret0, _ := ret[0].(task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextTask indicates an expected call of GetNextTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetNextTask(c, userid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetNextTask), c, userid)
+}
+
+// GetPendingRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) GetPendingRecurrenceTask(c *gofr.Context, now time.Time) ([]task.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRecurrenceTask", c, now)
+	ret0, _ := ret[0].([]task.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRecurrenceTask indicates an expected call of GetPendingRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetPendingRecurrenceTask(c, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetPendingRecurrenceTask), c, now)
+}
+
+// GetRankTask mocks base method.
+func (m *MockTaskStoreInterface) GetRankTask(c *gofr.Context, id int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankTask", c, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankTask indicates an expected call of GetRankTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetRankTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetRankTask), c, id)
+}
+
+// GetRankedTask mocks base method.
+func (m *MockTaskStoreInterface) GetRankedTask(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankedTask", c, f, limit)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankedTask indicates an expected call of GetRankedTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetRankedTask(c, f, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankedTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetRankedTask), c, f, limit)
+}
+
+// GetSubtreeTask mocks base method.
+func (m *MockTaskStoreInterface) GetSubtreeTask(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtreeTask", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtreeTask indicates an expected call of GetSubtreeTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetSubtreeTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtreeTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetSubtreeTask), c, id)
+}
+
+// GetTagsByTaskIDTask mocks base method.
+func (m *MockTaskStoreInterface) GetTagsByTaskIDTask(c *gofr.Context, id int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByTaskIDTask", c, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByTaskIDTask indicates an expected call of GetTagsByTaskIDTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetTagsByTaskIDTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByTaskIDTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetTagsByTaskIDTask), c, id)
+}
+
 // GetTasksByUserIDTask mocks base method.
 func (m *MockTaskStoreInterface) GetTasksByUserIDTask(c *gofr.Context, userId int) ([]task.Task, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +518,162 @@ func (mr *MockTaskStoreInterfaceMockRecorder) GetTasksByUserIDTask(c, userId any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByUserIDTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetTasksByUserIDTask), c, userId)
 }
 
+// GetTransitiveBlockersTask mocks base method.
+func (m *MockTaskStoreInterface) GetTransitiveBlockersTask(c *gofr.Context, id int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitiveBlockersTask", c, id)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitiveBlockersTask indicates an expected call of GetTransitiveBlockersTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) GetTransitiveBlockersTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitiveBlockersTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).GetTransitiveBlockersTask), c, id)
+}
+
+// LockColumnTask mocks base method.
+func (m *MockTaskStoreInterface) LockColumnTask(c *gofr.Context, status task.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockColumnTask", c, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockColumnTask indicates an expected call of LockColumnTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) LockColumnTask(c, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockColumnTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).LockColumnTask), c, status)
+}
+
+// MarkRemindedTask mocks base method.
+func (m *MockTaskStoreInterface) MarkRemindedTask(c *gofr.Context, id int, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRemindedTask", c, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRemindedTask indicates an expected call of MarkRemindedTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) MarkRemindedTask(c, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRemindedTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).MarkRemindedTask), c, id, at)
+}
+
+// PurgeTask mocks base method.
+func (m *MockTaskStoreInterface) PurgeTask(c *gofr.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTask", c, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTask indicates an expected call of PurgeTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) PurgeTask(c, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).PurgeTask), c, before)
+}
+
+// RebalanceRankTask mocks base method.
+func (m *MockTaskStoreInterface) RebalanceRankTask(c *gofr.Context, status task.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceRankTask", c, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalanceRankTask indicates an expected call of RebalanceRankTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) RebalanceRankTask(c, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceRankTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).RebalanceRankTask), c, status)
+}
+
+// RemoveBlockerTask mocks base method.
+func (m *MockTaskStoreInterface) RemoveBlockerTask(c *gofr.Context, id, blocker int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlockerTask", c, id, blocker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlockerTask indicates an expected call of RemoveBlockerTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) RemoveBlockerTask(c, id, blocker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlockerTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).RemoveBlockerTask), c, id, blocker)
+}
+
+// RestoreTask mocks base method.
+func (m *MockTaskStoreInterface) RestoreTask(c *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTask", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTask indicates an expected call of RestoreTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) RestoreTask(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).RestoreTask), c, id)
+}
+
+// SetRankTask mocks base method.
+func (m *MockTaskStoreInterface) SetRankTask(c *gofr.Context, id int, r string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRankTask", c, id, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRankTask indicates an expected call of SetRankTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) SetRankTask(c, id, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRankTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).SetRankTask), c, id, r)
+}
+
+// UpdateRecurrenceTask mocks base method.
+func (m *MockTaskStoreInterface) UpdateRecurrenceTask(c *gofr.Context, r task.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurrenceTask", c, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecurrenceTask indicates an expected call of UpdateRecurrenceTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) UpdateRecurrenceTask(c, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurrenceTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).UpdateRecurrenceTask), c, r)
+}
+
+// UpdateStatusTask mocks base method.
+func (m *MockTaskStoreInterface) UpdateStatusTask(c *gofr.Context, id, ver int, to task.Status, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusTask", c, id, ver, to, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatusTask indicates an expected call of UpdateStatusTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) UpdateStatusTask(c, id, ver, to, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).UpdateStatusTask), c, id, ver, to, at)
+}
+
+// UpdateTask mocks base method.
+func (m *MockTaskStoreInterface) UpdateTask(c *gofr.Context, t task.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", c, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockTaskStoreInterfaceMockRecorder) UpdateTask(c, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockTaskStoreInterface)(nil).UpdateTask), c, t)
+}
+
 // MockUserServiceInterface is a mock of UserServiceInterface interface.
 type MockUserServiceInterface struct {
 	ctrl     *gomock.Controller
@@ -168,3 +712,156 @@ func (mr *MockUserServiceInterfaceMockRecorder) Get(c, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserServiceInterface)(nil).Get), c, id)
 }
+
+// MockProjects is a mock of Projects interface.
+type MockProjects struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsMockRecorder is the mock recorder for MockProjects.
+type MockProjectsMockRecorder struct {
+	mock *MockProjects
+}
+
+// NewMockProjects creates a new mock instance.
+func NewMockProjects(ctrl *gomock.Controller) *MockProjects {
+	mock := &MockProjects{ctrl: ctrl}
+	mock.recorder = &MockProjectsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjects) EXPECT() *MockProjectsMockRecorder {
+	return m.recorder
+}
+
+// IsMemberProject mocks base method.
+func (m *MockProjects) IsMemberProject(c *gofr.Context, id, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMemberProject", c, id, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMemberProject indicates an expected call of IsMemberProject.
+func (mr *MockProjectsMockRecorder) IsMemberProject(c, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMemberProject", reflect.TypeOf((*MockProjects)(nil).IsMemberProject), c, id, userID)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(c *gofr.Context, r task.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", c, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(c, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), c, r)
+}
+
+// MockAuditor is a mock of Auditor interface.
+type MockAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditorMockRecorder is the mock recorder for MockAuditor.
+type MockAuditorMockRecorder struct {
+	mock *MockAuditor
+}
+
+// NewMockAuditor creates a new mock instance.
+func NewMockAuditor(ctrl *gomock.Controller) *MockAuditor {
+	mock := &MockAuditor{ctrl: ctrl}
+	mock.recorder = &MockAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditor) EXPECT() *MockAuditorMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditor) Record(c *gofr.Context, entity string, id int, action string, data any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", c, entity, id, action, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditorMockRecorder) Record(c, entity, id, action, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditor)(nil).Record), c, entity, id, action, data)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
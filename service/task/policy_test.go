@@ -0,0 +1,123 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func Test_PolicyDenies(t *testing.T) {
+	// task 1 is assigned to user 5
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 5, Priority: task.PriorityP2, Version: 2}
+
+	tests := []struct {
+		name  string
+		perm  policy.Permission
+		owner int
+		// read says whether the task is read before the policy is asked
+		read bool
+		call func(s *TaskService, c *gofr.Context) error
+	}{
+		{name: "Create", perm: policy.TaskAssign, owner: 6, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.Create(c, task.Task{Desc: "Work", Userid: 6})
+			return err
+		}},
+		{name: "Update", perm: policy.TaskUpdate, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.Update(c, 1, 2, task.Task{Desc: "Rework", Userid: 5})
+			return err
+		}},
+		{name: "Complete", perm: policy.TaskComplete, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.Transition(c, 1, version.Any, task.StatusDone)
+			return err
+		}},
+		{name: "Start", perm: policy.TaskUpdate, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.Transition(c, 1, version.Any, task.StatusInProgress)
+			return err
+		}},
+		{name: "Delete", perm: policy.TaskDelete, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			return s.Delete(c, 1, version.Any)
+		}},
+		{name: "Restore", perm: policy.TaskRestore, owner: 0, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.Restore(c, 1)
+			return err
+		}},
+		{name: "Attach Tag", perm: policy.TaskUpdate, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.AttachTag(c, 1, "backend")
+			return err
+		}},
+		{name: "Add Blocker", perm: policy.TaskUpdate, owner: 5, read: true, call: func(s *TaskService, c *gofr.Context) error {
+			_, err := s.AddBlocker(c, 1, 2)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.read {
+			mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+		}
+
+		denied := errs.Forbidden{Permission: string(tt.perm)}
+
+		mockPolicy.EXPECT().Authorize(ctx, tt.perm, tt.owner).Return(denied)
+
+		// nothing is written once the policy denies the change
+		assert.Equal(t, denied, tt.call(service, ctx), tt.name)
+	}
+}
+
+func Test_PolicyReassign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+	mockUserServ := NewMockUserServiceInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewService(mockStore, mockUserServ, WithPolicy(mockPolicy), fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 5, Priority: task.PriorityP2, Version: 2}
+
+	// a member may edit their own task but not hand it over to someone else
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 5).Return(nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskAssign, 6).Return(errs.Forbidden{Permission: "task:assign"})
+
+	_, err := service.Update(ctx, 1, 2, task.Task{Desc: "Work", Userid: 6})
+	assert.Equal(t, errs.Forbidden{Permission: "task:assign"}, err)
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskUpdate, 5).Return(nil)
+	mockPolicy.EXPECT().Authorize(ctx, policy.TaskAssign, 6).Return(nil)
+	mockUserServ.EXPECT().Get(ctx, 6).Return(user.User{ID: 6}, nil)
+	mockStore.EXPECT().UpdateTask(ctx, gomock.Any()).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventReassigned)).Return(nil)
+
+	res, err := service.Update(ctx, 1, 2, task.Task{Desc: "Work", Userid: 6})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, res.Userid)
+}
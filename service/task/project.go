@@ -0,0 +1,31 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"gofr.dev/pkg/gofr"
+)
+
+// checkProject checks that a task put in projectID can be assigned to userid: the project exists and the user is one
+// of its members. Tasks in no project may be assigned to anyone.
+func (s *TaskService) checkProject(c *gofr.Context, projectID *int, userid int) error {
+	if projectID == nil || s.projects == nil {
+		return nil
+	}
+
+	member, err := s.projects.IsMemberProject(c, *projectID, userid)
+	if errors.As(err, &errs.NotFound{}) {
+		return errs.DependencyMissing{Entity: "project", ID: *projectID}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !member {
+		return errs.Validation{Field: "userid", Reason: fmt.Sprintf("must be a member of project %d", *projectID)}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func Test_CreateInProject(t *testing.T) {
+	tests := []struct {
+		name      string
+		member    bool
+		memberErr error
+		expErr    error
+	}{
+		{name: "Member Assignee", member: true},
+		{name: "Not A Member", expErr: errs.Validation{Field: "userid", Reason: "must be a member of project 4"}},
+		{name: "Project Not Found", memberErr: errs.NotFound{Entity: "project", ID: 4},
+			expErr: errs.DependencyMissing{Entity: "project", ID: 4}},
+		{name: "Store Error", memberErr: errors.New("db down"), expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+		mockProjects := NewMockProjects(ctrl)
+
+		service := NewService(mockStore, mockUserServ, WithProjects(mockProjects), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockUserServ.EXPECT().Get(ctx, 10).Return(user.User{ID: 10}, nil)
+		mockProjects.EXPECT().IsMemberProject(ctx, 4, 10).Return(tt.member, tt.memberErr)
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().CreateTask(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, t task.Task) (task.Task, error) {
+				t.ID = 3
+
+				return t, nil
+			})
+			mockStore.EXPECT().CreateEventTask(ctx, event(3, task.EventCreated)).Return(nil)
+		}
+
+		res, err := service.Create(ctx, task.Task{Desc: "Write tests", Userid: 10, ProjectID: parent(4)})
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, parent(4), res.ProjectID, tt.name)
+		}
+	}
+}
+
+func Test_UpdateInProject(t *testing.T) {
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Priority: task.PriorityP2, Version: 2,
+		ProjectID: parent(4)}
+
+	tests := []struct {
+		name      string
+		input     task.Task
+		checked   bool
+		member    bool
+		expErr    error
+		expectEvt task.EventType
+	}{
+		{name: "Unchanged Project And Assignee", input: task.Task{Desc: "More work", Userid: 1, ProjectID: parent(4)},
+			expectEvt: task.EventUpdated},
+		{name: "Leave Project", input: task.Task{Desc: "Work", Userid: 1}, expectEvt: task.EventUpdated},
+		{name: "Move To Project Of Assignee", input: task.Task{Desc: "Work", Userid: 1, ProjectID: parent(5)}, checked: true,
+			member: true, expectEvt: task.EventUpdated},
+		{name: "Move To Project Of Others", input: task.Task{Desc: "Work", Userid: 1, ProjectID: parent(5)}, checked: true,
+			expErr: errs.Validation{Field: "userid", Reason: "must be a member of project 5"}},
+		{name: "Reassign To Member", input: task.Task{Desc: "Work", Userid: 2, ProjectID: parent(4)}, checked: true,
+			member: true, expectEvt: task.EventReassigned},
+		{name: "Reassign To Non-member", input: task.Task{Desc: "Work", Userid: 2, ProjectID: parent(4)}, checked: true,
+			expErr: errs.Validation{Field: "userid", Reason: "must be a member of project 4"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+		mockProjects := NewMockProjects(ctrl)
+
+		service := NewService(mockStore, mockUserServ, WithProjects(mockProjects), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+
+		if tt.input.Userid != cur.Userid {
+			mockUserServ.EXPECT().Get(ctx, tt.input.Userid).Return(user.User{ID: tt.input.Userid}, nil)
+		}
+
+		if tt.checked {
+			mockProjects.EXPECT().IsMemberProject(ctx, *tt.input.ProjectID, tt.input.Userid).Return(tt.member, nil)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().UpdateTask(ctx, gomock.Any()).Return(nil)
+			mockStore.EXPECT().CreateEventTask(ctx, event(1, tt.expectEvt)).Return(nil)
+		}
+
+		res, err := service.Update(ctx, 1, 2, tt.input)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+			assert.Equal(t, tt.input.ProjectID, res.ProjectID, tt.name)
+		}
+	}
+}
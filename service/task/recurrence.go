@@ -0,0 +1,371 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"slices"
+	"time"
+)
+
+// CreateRecurrence starts creating tasks on the schedule of a recurrence, from the first occurrence due from now on.
+func (s *TaskService) CreateRecurrence(c *gofr.Context, r task.Recurrence) (task.Recurrence, error) {
+	if err := r.Validate(); err != nil {
+		return r, err
+	}
+
+	r = normalize(r)
+
+	if err := s.authorize(c, policy.TaskAssign, r.Userid); err != nil {
+		return r, err
+	}
+
+	if _, err := s.userServiceref.Get(c, r.Userid); err != nil {
+		return r, svcutil.Missing(err, "user", r.Userid)
+	}
+
+	if err := s.checkProject(c, r.ProjectID, r.Userid); err != nil {
+		return r, err
+	}
+
+	r.Occurrences, r.LastAt, r.LastTaskID = 0, nil, nil
+	r.CreatedAt = s.now()
+	r.UpdatedAt = r.CreatedAt
+	r.NextAt = following(r, r.CreatedAt.Add(-time.Second))
+
+	return s.str.CreateRecurrenceTask(c, r)
+}
+
+func (s *TaskService) GetRecurrence(c *gofr.Context, id int) (task.Recurrence, error) {
+	return s.str.GetByIDRecurrenceTask(c, id)
+}
+
+func (s *TaskService) AllRecurrences(c *gofr.Context) ([]task.Recurrence, error) {
+	return s.str.GetAllRecurrenceTask(c)
+}
+
+// UpdateRecurrence replaces the template, rule and start of a recurrence at version ver. The occurrences already
+// created are left as they are, the next one is rescheduled from now on, after the latest one.
+func (s *TaskService) UpdateRecurrence(c *gofr.Context, id, ver int, r task.Recurrence) (task.Recurrence, error) {
+	cur, err := s.getRecurrence(c, id, ver)
+	if err != nil {
+		return task.Recurrence{}, err
+	}
+
+	if err := s.authorize(c, policy.TaskUpdate, cur.Userid); err != nil {
+		return task.Recurrence{}, err
+	}
+
+	if err := r.Validate(); err != nil {
+		return task.Recurrence{}, err
+	}
+
+	r = normalize(r)
+
+	if r.Userid != cur.Userid {
+		if err := s.authorize(c, policy.TaskAssign, r.Userid); err != nil {
+			return task.Recurrence{}, err
+		}
+
+		if _, err := s.userServiceref.Get(c, r.Userid); err != nil {
+			return task.Recurrence{}, svcutil.Missing(err, "user", r.Userid)
+		}
+	}
+
+	if r.Userid != cur.Userid || !sameRef(r.ProjectID, cur.ProjectID) {
+		if err := s.checkProject(c, r.ProjectID, r.Userid); err != nil {
+			return task.Recurrence{}, err
+		}
+	}
+
+	r.ID, r.Version, r.CreatedAt = cur.ID, cur.Version, cur.CreatedAt
+	r.Occurrences, r.LastAt, r.LastTaskID = cur.Occurrences, cur.LastAt, cur.LastTaskID
+	r.UpdatedAt = s.now()
+
+	after := r.UpdatedAt.Add(-time.Second)
+	if r.LastAt != nil && r.LastAt.After(after) {
+		after = *r.LastAt
+	}
+
+	r.NextAt = following(r, after)
+
+	if err := s.str.UpdateRecurrenceTask(c, r); err != nil {
+		return task.Recurrence{}, err
+	}
+
+	r.Version++
+
+	return r, nil
+}
+
+// DeleteRecurrence stops a recurrence at version ver, or at any version if ver is version.Any. The tasks it created
+// are left as they are.
+func (s *TaskService) DeleteRecurrence(c *gofr.Context, id, ver int) error {
+	cur, err := s.getRecurrence(c, id, ver)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, policy.TaskDelete, cur.Userid); err != nil {
+		return err
+	}
+
+	return s.str.DeleteRecurrenceTask(c, id, ver)
+}
+
+// getRecurrence reads a recurrence and checks it is still at version ver, unless ver is version.Any.
+func (s *TaskService) getRecurrence(c *gofr.Context, id, ver int) (task.Recurrence, error) {
+	r, err := s.str.GetByIDRecurrenceTask(c, id)
+	if err == nil && ver != version.Any && r.Version != ver {
+		return r, version.ErrMismatch
+	}
+
+	return r, err
+}
+
+// Occurrences returns when the next n occurrences of a recurrence are due, the one to be created next first. There
+// are fewer if the rule ends before.
+func (s *TaskService) Occurrences(c *gofr.Context, id, n int) ([]time.Time, error) {
+	r, err := s.str.GetByIDRecurrenceTask(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return upcoming(r, n), nil
+}
+
+// PreviewRecurrence returns when the first n occurrences of a recurrence would be due if it were created now,
+// without creating it.
+func (s *TaskService) PreviewRecurrence(r task.Recurrence, n int) ([]time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	r = normalize(r)
+	r.Occurrences = 0
+	r.NextAt = following(r, s.now().Add(-time.Second))
+
+	return upcoming(r, n), nil
+}
+
+// Recur creates the task of the next occurrence of every recurrence it has fallen due for, and returns how many were
+// created. Should runs be missed, the next occurrence is created overdue and the ones due since
+// are skipped, rather than all created overdue.
+func (s *TaskService) Recur(c *gofr.Context) (int, error) {
+	now := s.now()
+
+	recurrences, err := s.str.GetPendingRecurrenceTask(c, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		created int
+		fails   []error
+	)
+
+	for _, r := range recurrences {
+		ok, err := s.materialize(c, r, now)
+		if ok {
+			created++
+		}
+
+		if err != nil {
+			fails = append(fails, fmt.Errorf("recurrence %d: %w", r.ID, err))
+		}
+	}
+
+	return created, errors.Join(fails...)
+}
+
+// materialize creates the task of the next occurrence of r, unless r changed since read, or another run created it
+// first. The task is created and the occurrence claimed in one transaction, so an occurrence whose task cannot be
+// created is left for the next run, and one claimed by another run meanwhile is not created twice.
+func (s *TaskService) materialize(c *gofr.Context, r task.Recurrence, now time.Time) (bool, error) {
+	at := *r.NextAt
+
+	r.Occurrences++
+	r.LastAt = &at
+
+	after := now.Add(-time.Second)
+	if at.After(after) {
+		after = at
+	}
+
+	r.NextAt = following(r, after)
+
+	t := r.Occurrence(at)
+	t.Status = s.workflow.Initial()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+
+	err := sqlutil.InTx(c, func(c *gofr.Context) error {
+		var err error
+
+		if t, err = s.str.CreateTask(c, t); err != nil {
+			return err
+		}
+
+		if err := s.record(c, task.EventCreated, t.ID, t.CreatedAt, nil, &t); err != nil {
+			return err
+		}
+
+		r.LastTaskID = &t.ID
+
+		return s.str.AdvanceRecurrenceTask(c, r)
+	})
+	if errors.Is(err, version.ErrMismatch) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// normalize keeps the times of a recurrence in UTC to the second, as stored, and gives it the default priority if it
+// has none.
+func normalize(r task.Recurrence) task.Recurrence {
+	r.Start = r.Start.UTC().Truncate(time.Second)
+
+	if r.Rule.Until != nil {
+		until := r.Rule.Until.UTC().Truncate(time.Second)
+		r.Rule.Until = &until
+	}
+
+	if r.Priority == "" {
+		r.Priority = task.DefaultPriority
+	}
+
+	return r
+}
+
+// following returns when the first occurrence of r due after the given time is due, or nil if r has no more: its
+// rule has ended, or it has had as many occurrences as its count.
+func following(r task.Recurrence, after time.Time) *time.Time {
+	if r.Rule.Count > 0 && r.Occurrences >= r.Rule.Count {
+		return nil
+	}
+
+	at, ok := nextOccurrence(r.Rule, r.Start, after)
+	if !ok {
+		return nil
+	}
+
+	return &at
+}
+
+// upcoming returns when the next n occurrences of r are due, from r.NextAt on.
+func upcoming(r task.Recurrence, n int) []time.Time {
+	out := []time.Time{}
+
+	for next := r.NextAt; next != nil && len(out) < n; next = following(r, *next) {
+		out = append(out, *next)
+		r.Occurrences++
+	}
+
+	return out
+}
+
+// maxPeriods bounds the search for an occurrence, in intervals of a rule. The weekday of the days a daily rule falls on
+// comes back every 7 intervals at most, and the month of the year of a monthly one every 12, so an occurrence not
+// found by then never comes.
+const maxPeriods = 14
+
+// nextOccurrence returns when the first occurrence of a rule counted from start due after the given time is due, or
+// false if the rule ends before. The count of the rule is left to the caller, who knows how many occurred.
+func nextOccurrence(r task.Rule, start, after time.Time) (time.Time, bool) {
+	step := max(r.Interval, 1)
+
+	from := after
+	if from.Before(start) {
+		from = start
+	}
+
+	k := periodOf(r.Freq, start, from)
+	k -= k % step
+
+	for i := 0; i < maxPeriods; i, k = i+1, k+step {
+		for _, t := range candidates(r, start, k) {
+			if t.Before(start) || !t.After(after) {
+				continue
+			}
+
+			if r.Until != nil && t.After(*r.Until) {
+				return time.Time{}, false
+			}
+
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// periodOf returns the number of days, weeks or months between start and t, weeks starting on Monday.
+func periodOf(f task.Frequency, start, t time.Time) int {
+	switch f {
+	case task.FrequencyMonthly:
+		return (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	case task.FrequencyWeekly:
+		return daysBetween(weekOf(start), t) / 7
+	default:
+		return daysBetween(start, t)
+	}
+}
+
+// candidates returns the times in order the rule may fall on in the k-th day, week or month from start. Weeks hold a
+// day for each weekday of the rule, and months none if they are too short for the day of the start.
+func candidates(r task.Rule, start time.Time, k int) []time.Time {
+	switch r.Freq {
+	case task.FrequencyMonthly:
+		t := start.AddDate(0, k, 0)
+		if t.Day() != start.Day() {
+			return nil
+		}
+
+		return []time.Time{t}
+	case task.FrequencyWeekly:
+		days := r.ByWeekday
+		if len(days) == 0 {
+			days = []task.Weekday{task.WeekdayOf(start)}
+		}
+
+		monday := weekOf(start).AddDate(0, 0, 7*k).Add(start.Sub(midnight(start)))
+
+		var out []time.Time
+
+		for _, d := range task.Weekdays {
+			if slices.Contains(days, d) {
+				out = append(out, monday.AddDate(0, 0, d.Offset()))
+			}
+		}
+
+		return out
+	default:
+		t := start.AddDate(0, 0, k)
+		if len(r.ByWeekday) > 0 && !slices.Contains(r.ByWeekday, task.WeekdayOf(t)) {
+			return nil
+		}
+
+		return []time.Time{t}
+	}
+}
+
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// weekOf returns the midnight starting the Monday of the week of t.
+func weekOf(t time.Time) time.Time {
+	return midnight(t).AddDate(0, 0, -task.WeekdayOf(t).Offset())
+}
+
+func daysBetween(a, b time.Time) int {
+	return int(midnight(b).Sub(midnight(a)).Hours()) / 24
+}
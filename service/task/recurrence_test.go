@@ -0,0 +1,302 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+// monday is the Monday after stamp, at 9 in the morning
+var monday = time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+func day(n int) time.Time {
+	return monday.AddDate(0, 0, n)
+}
+
+func Test_NextOccurrence(t *testing.T) {
+	until := day(1).Add(3 * time.Hour)
+
+	tests := []struct {
+		name  string
+		rule  task.Rule
+		start time.Time
+		after time.Time
+		exp   time.Time
+		expOK bool
+	}{
+		{"Daily From The Start", task.Rule{Freq: task.FrequencyDaily}, monday, monday.Add(-time.Second), monday, true},
+		{"Daily After The Start", task.Rule{Freq: task.FrequencyDaily}, monday, monday, day(1), true},
+		{"Every Other Day", task.Rule{Freq: task.FrequencyDaily, Interval: 2}, monday, day(5).Add(time.Hour), day(6), true},
+		{"Daily On Some Weekdays", task.Rule{Freq: task.FrequencyDaily, ByWeekday: []task.Weekday{"MO", "WE", "FR"}},
+			monday, monday, day(2), true},
+		{"Every Other Week On Two Days", task.Rule{Freq: task.FrequencyWeekly, Interval: 2, ByWeekday: []task.Weekday{"TH", "TU"}},
+			monday, day(3), day(15), true},
+		{"Weekly Days Before The Start", task.Rule{Freq: task.FrequencyWeekly, ByWeekday: []task.Weekday{"MO", "FR"}},
+			day(2), day(2).Add(-time.Second), day(4), true},
+		{"Weekly On The Day Of The Start", task.Rule{Freq: task.FrequencyWeekly}, monday, monday, day(7), true},
+		{"Monthly Skipping Short Months", task.Rule{Freq: task.FrequencyMonthly},
+			time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC), true},
+		{"Every Third Month", task.Rule{Freq: task.FrequencyMonthly, Interval: 3},
+			time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 7, 15, 9, 0, 0, 0, time.UTC), true},
+		{"Past Until", task.Rule{Freq: task.FrequencyDaily, Until: &until}, monday, day(1), time.Time{}, false},
+		{"Never Falling On Its Weekdays", task.Rule{Freq: task.FrequencyDaily, Interval: 7, ByWeekday: []task.Weekday{"TU"}},
+			monday, monday, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		next, ok := nextOccurrence(tt.rule, tt.start, tt.after)
+
+		assert.Equal(t, tt.expOK, ok, tt.name)
+		assert.Equal(t, tt.exp, next, tt.name)
+	}
+}
+
+func Test_CreateRecurrence(t *testing.T) {
+	weekly := task.Rule{Freq: task.FrequencyWeekly}
+	past := monday.AddDate(0, 0, -14)
+	thursday := day(3)
+
+	tests := []struct {
+		name    string
+		input   task.Recurrence
+		ifUser  bool
+		userErr error
+		expNext *time.Time
+		expErr  error
+	}{
+		{name: "Starting Tomorrow", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Rule: weekly, Start: monday},
+			ifUser: true, expNext: &monday},
+		{name: "Started Weeks Ago", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Rule: weekly, Start: past},
+			ifUser: true, expNext: &monday},
+		{name: "No Frequency", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Start: monday},
+			expErr: errs.Validation{Field: "rule.freq", Reason: "must be one of daily, weekly, monthly"}},
+		{name: "Never Falling On Its Weekdays", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Start: monday,
+			Rule: task.Rule{Freq: task.FrequencyDaily, Interval: 7, ByWeekday: []task.Weekday{"TU"}}},
+			expErr: errs.Validation{Field: "rule", Reason: "never falls due"}},
+		{name: "Ending Before Its First Weekday", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Start: monday,
+			Rule: task.Rule{Freq: task.FrequencyWeekly, ByWeekday: []task.Weekday{"FR"}, Until: &thursday}},
+			expErr: errs.Validation{Field: "rule", Reason: "never falls due"}},
+		{name: "Unknown User", input: task.Recurrence{Desc: "Rotate on-call", Userid: 1, Rule: weekly, Start: monday},
+			ifUser: true, userErr: errs.NotFound{Entity: "user", ID: 1}, expErr: errs.DependencyMissing{Entity: "user", ID: 1}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.ifUser {
+			mockUserServ.EXPECT().Get(ctx, 1).Return(user.User{ID: 1}, tt.userErr)
+		}
+
+		if tt.expErr == nil {
+			exp := tt.input
+			exp.Priority, exp.NextAt, exp.CreatedAt, exp.UpdatedAt = task.DefaultPriority, tt.expNext, stamp, stamp
+
+			mockStore.EXPECT().CreateRecurrenceTask(ctx, exp).DoAndReturn(func(_ *gofr.Context, r task.Recurrence) (task.Recurrence, error) {
+				r.ID, r.Version = 5, 1
+
+				return r, nil
+			})
+		}
+
+		r, err := service.CreateRecurrence(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 5, r.ID, tt.name)
+			assert.Equal(t, tt.expNext, r.NextAt, tt.name)
+		}
+	}
+}
+
+func Test_UpdateRecurrence(t *testing.T) {
+	last, lastTask := day(7), 12
+	cur := task.Recurrence{ID: 5, Desc: "Rotate on-call", Userid: 1, Priority: task.PriorityP2, Start: monday,
+		Rule: task.Rule{Freq: task.FrequencyWeekly}, NextAt: &last, Occurrences: 2, LastAt: &last, LastTaskID: &lastTask,
+		Version: 3, CreatedAt: stamp, UpdatedAt: stamp}
+
+	tests := []struct {
+		name   string
+		ver    int
+		input  task.Recurrence
+		expErr error
+	}{
+		{"Desc Changed", 3, task.Recurrence{Desc: "Hand over on-call", Userid: 1, Start: monday,
+			Rule: task.Rule{Freq: task.FrequencyWeekly}}, nil},
+		{"Stale Version", 2, task.Recurrence{}, version.ErrMismatch},
+		{"Invalid Rule", version.Any, task.Recurrence{Desc: "Hand over on-call", Userid: 1, Start: monday,
+			Rule: task.Rule{Freq: task.FrequencyMonthly, ByWeekday: []task.Weekday{"MO"}}},
+			errs.Validation{Field: "rule.by_weekday", Reason: "is only allowed in daily and weekly rules"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDRecurrenceTask(ctx, 5).Return(cur, nil)
+
+		// the occurrence of the week after is created already, so the next is the one after it
+		next := day(14)
+		exp := cur
+		exp.Desc, exp.NextAt, exp.UpdatedAt = "Hand over on-call", &next, stamp
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().UpdateRecurrenceTask(ctx, exp).Return(nil)
+		}
+
+		r, err := service.UpdateRecurrence(ctx, 5, tt.ver, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			exp.Version++
+			assert.Equal(t, exp, r, tt.name)
+		}
+	}
+}
+
+func Test_Recur(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	missed, claimed, failing := day(-14), day(-7), day(-1)
+	weekly := task.Rule{Freq: task.FrequencyWeekly}
+
+	pending := []task.Recurrence{
+		// two weeks behind: the occurrence is created overdue and the one of last week skipped
+		{ID: 1, Desc: "Rotate on-call", Userid: 2, Priority: task.PriorityP1, Rule: weekly, Start: missed, NextAt: &missed, Version: 1},
+		{ID: 2, Desc: "Water plants", Userid: 2, Rule: weekly, Start: claimed, NextAt: &claimed, Version: 1},
+		{ID: 3, Desc: "Pay rent", Userid: 2, Rule: task.Rule{Freq: task.FrequencyDaily, Count: 3}, Start: day(-3), NextAt: &failing,
+			Occurrences: 2, Version: 4},
+	}
+
+	mockStore.EXPECT().GetPendingRecurrenceTask(ctx, stamp).Return(pending, nil)
+
+	created := func(id int) func(*gofr.Context, task.Task) (task.Task, error) {
+		return func(_ *gofr.Context, t task.Task) (task.Task, error) {
+			t.ID = id
+
+			return t, nil
+		}
+	}
+
+	lastTask := 12
+	advanced := pending[0]
+	advanced.Occurrences, advanced.LastAt, advanced.LastTaskID, advanced.NextAt = 1, &missed, &lastTask, &monday
+
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().CreateTask(gomock.Any(), task.Task{Desc: "Rotate on-call", Userid: 2, Priority: task.PriorityP1,
+		Status: task.StatusTodo, DueAt: &missed, CreatedAt: stamp, UpdatedAt: stamp}).DoAndReturn(created(12))
+	mockStore.EXPECT().CreateEventTask(gomock.Any(), event(12, task.EventCreated)).Return(nil)
+	mockStore.EXPECT().AdvanceRecurrenceTask(gomock.Any(), advanced).Return(nil)
+	mock.SQL.ExpectCommit()
+
+	// claimed by another run meanwhile: the task created for it is rolled back
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().CreateTask(gomock.Any(), gomock.Cond(func(t task.Task) bool { return t.Desc == "Water plants" })).
+		DoAndReturn(created(13))
+	mockStore.EXPECT().CreateEventTask(gomock.Any(), event(13, task.EventCreated)).Return(nil)
+	mockStore.EXPECT().AdvanceRecurrenceTask(gomock.Any(), gomock.Cond(func(r task.Recurrence) bool { return r.ID == 2 })).
+		Return(version.ErrMismatch)
+	mock.SQL.ExpectRollback()
+
+	// the task cannot be created: the occurrence is not claimed and is left for the next run
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().CreateTask(gomock.Any(), gomock.Cond(func(t task.Task) bool { return t.Desc == "Pay rent" })).
+		Return(task.Task{}, errors.New("db down"))
+	mock.SQL.ExpectRollback()
+
+	n, err := service.Recur(ctx)
+
+	assert.Equal(t, 1, n)
+	assert.ErrorContains(t, err, "recurrence 3: db down")
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_Occurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	next := day(7)
+
+	mockStore.EXPECT().GetByIDRecurrenceTask(ctx, 5).Return(task.Recurrence{ID: 5, Start: monday, NextAt: &next, Occurrences: 1,
+		Rule: task.Rule{Freq: task.FrequencyWeekly, Count: 3}}, nil)
+
+	dates, err := service.Occurrences(ctx, 5, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{day(7), day(14)}, dates)
+
+	mockStore.EXPECT().GetByIDRecurrenceTask(ctx, 6).Return(task.Recurrence{}, errs.NotFound{Entity: "recurrence", ID: 6})
+
+	_, err = service.Occurrences(ctx, 6, 10)
+
+	assert.Equal(t, errs.NotFound{Entity: "recurrence", ID: 6}, err)
+}
+
+func Test_PreviewRecurrence(t *testing.T) {
+	service := NewService(nil, nil, fixedClock)
+
+	r := task.Recurrence{Desc: "Stand-up", Userid: 1, Start: monday.In(time.FixedZone("CEST", 2*60*60)),
+		Rule: task.Rule{Freq: task.FrequencyDaily, ByWeekday: []task.Weekday{"MO", "TU", "WE", "TH", "FR"}}}
+
+	dates, err := service.PreviewRecurrence(r, 6)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{day(0), day(1), day(2), day(3), day(4), day(7)}, dates)
+
+	_, err = service.PreviewRecurrence(task.Recurrence{Userid: 1}, 6)
+
+	assert.Equal(t, errs.Validation{Field: "desc", Reason: "must not be empty"}, err)
+}
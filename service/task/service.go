@@ -1,50 +1,506 @@
 package task
 
 import (
+	"errors"
 	"fmt"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/patch"
+	"github.com/MGajendra22/GoFr/model/policy"
 	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/notifier"
 	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"time"
 )
 
 type TaskService struct {
 	str            TaskStoreInterface
 	userServiceref UserServiceInterface
+	workflow       *Workflow
+	notifier       Notifier
+	auditor        Auditor
+	policy         Policy
+	projects       Projects
+	maxBulk        int
+	now            func() time.Time
 }
 
-func NewService(s TaskStoreInterface, us UserServiceInterface) *TaskService {
-	return &TaskService{
+// Option configures optional collaborators of TaskService.
+type Option func(*TaskService)
+
+// WithWorkflow replaces the default task workflow.
+func WithWorkflow(wf *Workflow) Option {
+	return func(s *TaskService) {
+		s.workflow = wf
+	}
+}
+
+// WithNotifier replaces the notifier reminders are sent through, which logs them by default.
+func WithNotifier(n Notifier) Option {
+	return func(s *TaskService) {
+		s.notifier = n
+	}
+}
+
+// WithAuditor records every change to a task in the audit log as well as in its history.
+func WithAuditor(a Auditor) Option {
+	return func(s *TaskService) {
+		s.auditor = a
+	}
+}
+
+// WithPolicy checks every change to a task against an access policy. Without one, anyone may change any task.
+func WithPolicy(p Policy) Option {
+	return func(s *TaskService) {
+		s.policy = p
+	}
+}
+
+// WithProjects only lets the tasks of a project be assigned to its members. Without it, projects are not checked.
+func WithProjects(p Projects) Option {
+	return func(s *TaskService) {
+		s.projects = p
+	}
+}
+
+// WithMaxBulk limits the number of operations of a bulk request, task.DefaultMaxBulk by default.
+func WithMaxBulk(n int) Option {
+	return func(s *TaskService) {
+		s.maxBulk = n
+	}
+}
+
+// WithClock replaces the clock used to stamp tasks and to decide what is due.
+func WithClock(now func() time.Time) Option {
+	return func(s *TaskService) {
+		s.now = now
+	}
+}
+
+func NewService(s TaskStoreInterface, us UserServiceInterface, opts ...Option) *TaskService {
+	svc := &TaskService{
 		str:            s,
 		userServiceref: us,
+		workflow:       DefaultWorkflow(),
+		notifier:       notifier.NewLog(),
+		maxBulk:        task.DefaultMaxBulk,
+		now:            svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
 	}
+
+	return svc
 }
 
 func (s *TaskService) Create(c *gofr.Context, t task.Task) (task.Task, error) {
+	t, err := s.prepare(c, t)
+	if err != nil {
+		return t, err
+	}
+
+	err = s.audited(c, func(c *gofr.Context) error {
+		if t, err = s.str.CreateTask(c, t); err != nil {
+			return err
+		}
+
+		return s.record(c, task.EventCreated, t.ID, t.CreatedAt, nil, &t)
+	})
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	s.reopened(c, t)
+
+	return t, nil
+}
+
+// CreateMany adds several tasks at once, all or none of them, and returns them in the same order. The first task
+// that cannot be added fails them all.
+func (s *TaskService) CreateMany(c *gofr.Context, ts []task.Task) ([]task.Task, error) {
+	prepared := make([]task.Task, len(ts))
+
+	for i := range ts {
+		t, err := s.prepare(c, ts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		prepared[i] = t
+	}
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		var err error
+
+		if ts, err = s.str.CreateManyTask(c, prepared); err != nil {
+			return err
+		}
+
+		for i := range ts {
+			if err := s.record(c, task.EventCreated, ts[i].ID, ts[i].CreatedAt, nil, &ts[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range ts {
+		s.reopened(c, t)
+	}
+
+	return ts, nil
+}
+
+// prepare checks a new task and fills in its defaults and timestamps before it is stored.
+func (s *TaskService) prepare(c *gofr.Context, t task.Task) (task.Task, error) {
 	if err := t.Validate(); err != nil {
 		return t, err
 	}
 
+	if t.Status == "" {
+		t.Status = s.workflow.Initial()
+	}
+
+	if t.Priority == "" {
+		t.Priority = task.DefaultPriority
+	}
+
+	if !s.workflow.HasState(t.Status) {
+		return t, errs.Validation{Field: "status", Reason: "unknown status " + string(t.Status)}
+	}
+
+	if err := s.authorize(c, policy.TaskAssign, t.Userid); err != nil {
+		return t, err
+	}
+
 	_, err := s.userServiceref.Get(c, t.Userid)
 	if err != nil {
-		return t, fmt.Errorf("user with ID %d does not exist: %v", t.Userid, err)
+		return t, svcutil.Missing(err, "user", t.Userid)
 	}
 
-	return s.str.CreateTask(c, t)
+	if err := s.checkProject(c, t.ProjectID, t.Userid); err != nil {
+		return t, err
+	}
+
+	if err := s.checkParent(c, task.Task{}, t.ParentID); err != nil {
+		return t, err
+	}
+
+	if !s.workflow.IsClosed(t.Status) {
+		if err := s.checkReopen(c, t.ParentID); err != nil {
+			return t, err
+		}
+	}
+
+	t.CreatedAt = s.now()
+	t.UpdatedAt = t.CreatedAt
+	t.DueAt = utc(t.DueAt)
+
+	return t, nil
 }
 
 func (s *TaskService) GetTask(c *gofr.Context, id int) (task.Task, error) {
 	return s.str.GetByIDTask(c, id)
 }
 
+// Update replaces the description and assignee of a task at version ver. The status can only be changed through Transition.
+func (s *TaskService) Update(c *gofr.Context, id, ver int, t task.Task) (task.Task, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	return s.update(c, cur, t)
+}
+
+// Patch applies a JSON merge patch to a task at version ver. The status can only be changed through Transition.
+func (s *TaskService) Patch(c *gofr.Context, id, ver int, p map[string]any) (task.Task, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	t := cur
+
+	if err := patch.Merge(&t, p); err != nil {
+		return task.Task{}, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return s.update(c, cur, t)
+}
+
+func (s *TaskService) update(c *gofr.Context, cur, t task.Task) (task.Task, error) {
+	if err := s.authorize(c, policy.TaskUpdate, cur.Userid); err != nil {
+		return task.Task{}, err
+	}
+
+	if t.Status != "" && t.Status != cur.Status {
+		return task.Task{}, errs.Validation{Field: "status", Reason: "can only be changed through a transition"}
+	}
+
+	t.ID, t.Status, t.Version, t.CreatedAt, t.Comments = cur.ID, cur.Status, cur.Version, cur.CreatedAt, cur.Comments
+
+	if t.Priority == "" {
+		t.Priority = cur.Priority
+	}
+
+	t.UpdatedAt = s.now()
+	t.DueAt = utc(t.DueAt)
+
+	if err := t.Validate(); err != nil {
+		return task.Task{}, err
+	}
+
+	if t.Userid != cur.Userid {
+		if err := s.authorize(c, policy.TaskAssign, t.Userid); err != nil {
+			return task.Task{}, err
+		}
+
+		if _, err := s.userServiceref.Get(c, t.Userid); err != nil {
+			return task.Task{}, svcutil.Missing(err, "user", t.Userid)
+		}
+	}
+
+	if t.Userid != cur.Userid || !sameRef(t.ProjectID, cur.ProjectID) {
+		if err := s.checkProject(c, t.ProjectID, t.Userid); err != nil {
+			return task.Task{}, err
+		}
+	}
+
+	if !sameRef(t.ParentID, cur.ParentID) {
+		if err := s.checkParent(c, cur, t.ParentID); err != nil {
+			return task.Task{}, err
+		}
+
+		if !s.workflow.IsClosed(cur.Status) {
+			if err := s.checkReopen(c, t.ParentID); err != nil {
+				return task.Task{}, err
+			}
+		}
+	}
+
+	event := task.EventUpdated
+	if t.Userid != cur.Userid {
+		event = task.EventReassigned
+	}
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		if err := s.str.UpdateTask(c, t); err != nil {
+			return err
+		}
+
+		t.Version++
+
+		return s.record(c, event, t.ID, t.UpdatedAt, &cur, &t)
+	})
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	if !sameRef(t.ParentID, cur.ParentID) {
+		s.reopened(c, t)
+	}
+
+	return t, nil
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may use perm on a
+// task assigned to owner.
+func (s *TaskService) authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, perm, owner)
+}
+
+// authorizeOn reads a task and authorizes perm on it.
+func (s *TaskService) authorizeOn(c *gofr.Context, perm policy.Permission, id int) error {
+	t, err := s.str.GetByIDTask(c, id)
+	if err != nil {
+		return err
+	}
+
+	return s.authorize(c, perm, t.Userid)
+}
+
+// get reads a task and checks it is still at version ver, unless ver is version.Any.
+func (s *TaskService) get(c *gofr.Context, id, ver int) (task.Task, error) {
+	t, err := s.str.GetByIDTask(c, id)
+	if err == nil && ver != version.Any && t.Version != ver {
+		return t, version.ErrMismatch
+	}
+
+	return t, err
+}
+
+// Transition moves a task at version ver to another status if the workflow allows it.
+func (s *TaskService) Transition(c *gofr.Context, id, ver int, to task.Status) (task.Task, error) {
+	if !s.workflow.HasState(to) {
+		return task.Task{}, errs.Validation{Field: "status", Reason: "unknown status " + string(to)}
+	}
+
+	t, err := s.get(c, id, ver)
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	perm := policy.TaskUpdate
+	if to == s.workflow.Done() {
+		perm = policy.TaskComplete
+	}
+
+	if err := s.authorize(c, perm, t.Userid); err != nil {
+		return task.Task{}, err
+	}
+
+	if !s.workflow.CanTransition(t.Status, to) {
+		return task.Task{}, ErrIllegalTransition{From: t.Status, To: to, Allowed: s.workflow.Allowed(t.Status)}
+	}
+
+	if s.workflow.IsClosed(to) {
+		if err := s.checkChildrenClosed(c, id); err != nil {
+			return task.Task{}, err
+		}
+
+		if err := s.checkBlockersClosed(c, id); err != nil {
+			return task.Task{}, err
+		}
+	} else if s.workflow.IsClosed(t.Status) {
+		if err := s.checkReopen(c, t.ParentID); err != nil {
+			return task.Task{}, err
+		}
+	}
+
+	at := s.now()
+	before := t
+
+	event := task.EventTransitioned
+	if to == s.workflow.Done() {
+		event = task.EventCompleted
+	}
+
+	err = s.audited(c, func(c *gofr.Context) error {
+		if err := s.str.UpdateStatusTask(c, id, before.Version, to, at); err != nil {
+			return err
+		}
+
+		t.Status = to
+		t.UpdatedAt = at
+		t.Version++
+
+		return s.record(c, event, id, at, &before, &t)
+	})
+	if errors.Is(err, version.ErrMismatch) && ver == version.Any {
+		return task.Task{}, ErrStatusChanged{ID: id}
+	}
+
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	if s.workflow.IsClosed(to) {
+		s.rollUp(c, t.ParentID, at)
+	} else if s.workflow.IsClosed(before.Status) {
+		s.reopened(c, t)
+	}
+
+	return t, nil
+}
+
+// Complete moves a task to the status tasks are completed in, whatever its version.
 func (s *TaskService) Complete(c *gofr.Context, id int) error {
-	return s.str.CompleteTask(c, id)
+	_, err := s.Transition(c, id, version.Any, s.workflow.Done())
+
+	return err
 }
 
-func (s *TaskService) Delete(c *gofr.Context, id int) error {
-	return s.str.DeleteTask(c, id)
+// Delete moves a task at version ver, or at any version if ver is version.Any, to the trash.
+func (s *TaskService) Delete(c *gofr.Context, id, ver int) error {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, policy.TaskDelete, cur.Userid); err != nil {
+		return err
+	}
+
+	now := s.now()
+
+	return s.audited(c, func(c *gofr.Context) error {
+		if err := s.str.DeleteTask(c, id, ver, now); err != nil {
+			return err
+		}
+
+		return s.record(c, task.EventDeleted, id, now, &cur, nil)
+	})
 }
 
-func (s *TaskService) All(c *gofr.Context) ([]task.Task, error) {
-	return s.str.GetAllTask(c)
+// Restore takes a task out of the trash and returns it.
+func (s *TaskService) Restore(c *gofr.Context, id int) (task.Task, error) {
+	// trashed tasks cannot be read, so restoring is not granted on the tasks of one's own
+	if err := s.authorize(c, policy.TaskRestore, 0); err != nil {
+		return task.Task{}, err
+	}
+
+	var t task.Task
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		if err := s.str.RestoreTask(c, id); err != nil {
+			return err
+		}
+
+		var err error
+
+		if t, err = s.str.GetByIDTask(c, id); err != nil {
+			return err
+		}
+
+		return s.record(c, task.EventRestored, id, s.now(), nil, &t)
+	})
+	if err != nil {
+		return task.Task{}, err
+	}
+
+	s.reopened(c, t)
+
+	return t, nil
+}
+
+// Purge permanently removes the tasks that have been in the trash for longer than retention. Those with attachments
+// are kept until their attachments are purged.
+func (s *TaskService) Purge(c *gofr.Context, retention time.Duration) (int64, error) {
+	return s.str.PurgeTask(c, s.now().Add(-retention))
+}
+
+func (s *TaskService) All(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error) {
+	if f.Status != "" && !s.workflow.HasState(f.Status) {
+		return page.Page[task.Task]{}, gofrHttp.ErrorInvalidParam{Params: []string{"status"}}
+	}
+
+	if f.Overdue || f.DueWithin > 0 {
+		f.Now = s.now()
+	}
+
+	if len(f.Tags) > 0 {
+		tags, err := normalizeTags(f.Tags)
+		if err != nil {
+			return page.Page[task.Task]{}, gofrHttp.ErrorInvalidParam{Params: []string{"tags"}}
+		}
+
+		f.Tags = tags
+	}
+
+	return s.str.GetAllTask(c, f, q)
 }
 
 func (s *TaskService) GetTasksByUserID(c *gofr.Context, userid int) ([]task.Task, error) {
@@ -56,3 +512,139 @@ func (s *TaskService) GetTasksByUserID(c *gofr.Context, userid int) ([]task.Task
 
 	return s.str.GetTasksByUserIDTask(c, userid)
 }
+
+// Next returns the task the user should work on next: among their open tasks, the one with the highest
+// priority, then the earliest due date (tasks without one come last), then the oldest.
+func (s *TaskService) Next(c *gofr.Context, userid int) (task.Task, error) {
+	if _, err := s.userServiceref.Get(c, userid); err != nil {
+		return task.Task{}, err
+	}
+
+	return s.str.GetNextTask(c, userid)
+}
+
+// Tags returns the tags of a task.
+func (s *TaskService) Tags(c *gofr.Context, id int) ([]string, error) {
+	if _, err := s.str.GetByIDTask(c, id); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetTagsByTaskIDTask(c, id)
+}
+
+// AttachTag attaches a tag to a task and returns the tags of the task.
+func (s *TaskService) AttachTag(c *gofr.Context, id int, name string) ([]string, error) {
+	name, err := task.NormalizeTag(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeOn(c, policy.TaskUpdate, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.str.AttachTagTask(c, id, name); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetTagsByTaskIDTask(c, id)
+}
+
+// DetachTag removes a tag from a task and returns the tags left on the task.
+func (s *TaskService) DetachTag(c *gofr.Context, id int, name string) ([]string, error) {
+	name, err := task.NormalizeTag(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeOn(c, policy.TaskUpdate, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.str.DetachTagTask(c, id, name); err != nil {
+		return nil, err
+	}
+
+	return s.str.GetTagsByTaskIDTask(c, id)
+}
+
+// AllTags returns every tag with the number of tasks carrying it.
+func (s *TaskService) AllTags(c *gofr.Context) ([]task.Tag, error) {
+	return s.str.GetAllTagsTask(c)
+}
+
+// normalizeTags normalizes the tags of a filter and drops duplicates, which would break matching all of them.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+
+	for _, t := range tags {
+		name, err := task.NormalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+
+	return out, nil
+}
+
+// Remind sends a reminder for every open task that passed its due date since the last run, and returns how
+// many were sent. A task whose reminder fails is retried on the next run.
+func (s *TaskService) Remind(c *gofr.Context) (int, error) {
+	now := s.now()
+
+	tasks, err := s.str.GetDueTask(c, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		sent  int
+		fails []error
+	)
+
+	for _, t := range tasks {
+		r := task.Reminder{TaskID: t.ID, Userid: t.Userid, Desc: t.Desc, DueAt: *t.DueAt}
+
+		if err := s.notifier.Notify(c, r); err != nil {
+			fails = append(fails, fmt.Errorf("task %d: %w", t.ID, err))
+
+			continue
+		}
+
+		if err := s.str.MarkRemindedTask(c, t.ID, now); err != nil {
+			fails = append(fails, fmt.Errorf("task %d: %w", t.ID, err))
+
+			continue
+		}
+
+		sent++
+	}
+
+	return sent, errors.Join(fails...)
+}
+
+// sameRef reports whether two optional references, such as parents or projects, point at the same entity.
+func sameRef(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// utc returns t in UTC, or nil if t is nil.
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	u := t.UTC()
+
+	return &u
+}
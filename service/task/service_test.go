@@ -2,16 +2,31 @@ package task
 
 import (
 	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/notifier"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
 	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
 )
 
 func Test_Create(t *testing.T) {
+	dueIST := time.Date(2026, 10, 20, 17, 0, 0, 0, time.FixedZone("IST", 5*3600+1800))
+	dueUTC := time.Date(2026, 10, 20, 11, 30, 0, 0, time.UTC)
+
 	tests := []struct {
 		name        string
 		input       task.Task
@@ -23,11 +38,33 @@ func Test_Create(t *testing.T) {
 	}{
 		{
 			name:        "Valid Task Creation",
-			input:       task.Task{ID: 1, Desc: "Do Work", Status: false, Userid: 10},
+			input:       task.Task{ID: 1, Desc: "Do Work", Status: task.StatusTodo, Userid: 10},
 			mockUser:    user.User{ID: 10, Name: "Alice", Email: "alice@example.com"},
-			mockTaskOut: task.Task{ID: 1, Desc: "Do Work", Status: false, Userid: 10},
+			mockTaskOut: task.Task{ID: 1, Desc: "Do Work", Status: task.StatusTodo, Userid: 10},
 			expErr:      false,
 		},
+		{
+			name:        "Due Date Normalized To UTC",
+			input:       task.Task{ID: 5, Desc: "Ship", Status: task.StatusTodo, Userid: 10, DueAt: &dueIST},
+			mockUser:    user.User{ID: 10, Name: "Alice", Email: "alice@example.com"},
+			mockTaskOut: task.Task{ID: 5, Desc: "Ship", Status: task.StatusTodo, Userid: 10, DueAt: &dueUTC},
+		},
+		{
+			name:        "Explicit Priority",
+			input:       task.Task{ID: 6, Desc: "Hotfix", Status: task.StatusTodo, Userid: 10, Priority: task.PriorityP0},
+			mockUser:    user.User{ID: 10, Name: "Alice", Email: "alice@example.com"},
+			mockTaskOut: task.Task{ID: 6, Desc: "Hotfix", Status: task.StatusTodo, Userid: 10, Priority: task.PriorityP0},
+		},
+		{
+			name:   "Validation Error - Unknown Priority",
+			input:  task.Task{ID: 7, Desc: "Hotfix", Userid: 10, Priority: "P9"},
+			expErr: true,
+		},
+		{
+			name:   "Validation Error - Non-positive Estimate",
+			input:  task.Task{ID: 8, Desc: "Hotfix", Userid: 10, Estimate: new(int)},
+			expErr: true,
+		},
 		{
 			name:   "Validation Error - Empty Desc",
 			input:  task.Task{ID: 2, Desc: "", Userid: 10},
@@ -36,12 +73,12 @@ func Test_Create(t *testing.T) {
 		{
 			name:    "User Not Found",
 			input:   task.Task{ID: 3, Desc: "Plan", Userid: 20},
-			userErr: errors.New("user not found"),
+			userErr: errs.NotFound{Entity: "user", ID: 20},
 			expErr:  true,
 		},
 		{
 			name:     "Task Store Error",
-			input:    task.Task{ID: 4, Desc: "Build", Userid: 11},
+			input:    task.Task{ID: 4, Desc: "Build", Status: task.StatusTodo, Userid: 11},
 			mockUser: user.User{ID: 11, Name: "Bob", Email: "bob@example.com"},
 			taskErr:  errors.New("db write failed"),
 			expErr:   true,
@@ -55,7 +92,7 @@ func Test_Create(t *testing.T) {
 
 		mockUserServ := NewMockUserServiceInterface(ctrl)
 
-		service := NewService(mockStore, mockUserServ)
+		service := NewService(mockStore, mockUserServ, fixedClock)
 
 		mockContainer, _ := container.NewMockContainer(t)
 
@@ -69,14 +106,33 @@ func Test_Create(t *testing.T) {
 				Return(tt.mockUser, tt.userErr)
 
 			if tt.userErr == nil {
+				stored := tt.input
+				stored.CreatedAt, stored.UpdatedAt = stamp, stamp
+
+				if stored.Priority == "" {
+					stored.Priority = task.DefaultPriority
+				}
+
+				if stored.DueAt != nil {
+					stored.DueAt = &dueUTC
+				}
+
 				mockStore.EXPECT().
-					CreateTask(ctx, tt.input).
+					CreateTask(ctx, stored).
 					Return(tt.mockTaskOut, tt.taskErr)
+
+				if tt.taskErr == nil {
+					mockStore.EXPECT().CreateEventTask(ctx, event(tt.mockTaskOut.ID, task.EventCreated)).Return(nil)
+				}
 			}
 		}
 
 		result, err := service.Create(ctx, tt.input)
 
+		if tt.userErr != nil {
+			assert.Equal(t, errs.DependencyMissing{Entity: "user", ID: tt.input.Userid}, err, tt.name)
+		}
+
 		if tt.expErr {
 			assert.Error(t, err, tt.name)
 		} else {
@@ -86,6 +142,53 @@ func Test_Create(t *testing.T) {
 	}
 }
 
+func Test_CreateMany(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockTaskStoreInterface(ctrl)
+	mockUserServ := NewMockUserServiceInterface(ctrl)
+	service := NewService(mockStore, mockUserServ, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	alice := user.User{ID: 10, Name: "Alice", Email: "alice@example.com"}
+	input := []task.Task{{Desc: "Set up laptop", Userid: 10}, {Desc: "Read handbook", Userid: 10, Priority: task.PriorityP1}}
+	stored := []task.Task{
+		{Desc: "Set up laptop", Status: task.StatusTodo, Userid: 10, Priority: task.DefaultPriority, CreatedAt: stamp, UpdatedAt: stamp},
+		{Desc: "Read handbook", Status: task.StatusTodo, Userid: 10, Priority: task.PriorityP1, CreatedAt: stamp, UpdatedAt: stamp},
+	}
+	created := []task.Task{stored[0], stored[1]}
+	created[0].ID, created[1].ID = 7, 8
+
+	mockUserServ.EXPECT().Get(ctx, 10).Return(alice, nil).Times(2)
+	mockStore.EXPECT().CreateManyTask(ctx, stored).Return(created, nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(7, task.EventCreated)).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(8, task.EventCreated)).Return(nil)
+
+	res, err := service.CreateMany(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+
+	// one task that cannot be added fails them all, before any is stored
+	mockUserServ.EXPECT().Get(ctx, 10).Return(alice, nil)
+	mockUserServ.EXPECT().Get(ctx, 20).Return(user.User{}, errs.NotFound{Entity: "user", ID: 20})
+
+	_, err = service.CreateMany(ctx, []task.Task{{Desc: "Set up laptop", Userid: 10}, {Desc: "Read handbook", Userid: 20}})
+
+	assert.Equal(t, errs.DependencyMissing{Entity: "user", ID: 20}, err)
+
+	mockUserServ.EXPECT().Get(ctx, 10).Return(alice, nil).Times(2)
+	mockStore.EXPECT().CreateManyTask(ctx, stored).Return(nil, errors.New("db down"))
+
+	_, err = service.CreateMany(ctx, []task.Task{{Desc: "Set up laptop", Userid: 10}, {Desc: "Read handbook", Userid: 10,
+		Priority: task.PriorityP1}})
+
+	assert.EqualError(t, err, "db down")
+}
+
 func Test_GetTask(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -94,7 +197,7 @@ func Test_GetTask(t *testing.T) {
 		mockErr    error
 		expErr     bool
 	}{
-		{"Valid Id", 1, task.Task{1, "Working", false, 1}, nil, false},
+		{"Valid Id", 1, task.Task{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}, nil, false},
 		{"Task Not found", 1, task.Task{}, errors.New("task not found"), true},
 	}
 
@@ -105,7 +208,7 @@ func Test_GetTask(t *testing.T) {
 
 		mockUserServ := NewMockUserServiceInterface(ctrl)
 
-		service := NewService(mockStore, mockUserServ)
+		service := NewService(mockStore, mockUserServ, fixedClock)
 
 		mockContainer, _ := container.NewMockContainer(t)
 
@@ -131,12 +234,21 @@ func Test_GetTask(t *testing.T) {
 func Test_AllTasks(t *testing.T) {
 	tests := []struct {
 		name       string
-		mockOutput []task.Task
+		filter     task.Filter
+		mockOutput page.Page[task.Task]
 		mockErr    error
+		ifMock     bool
 		expErr     bool
 	}{
-		{"Data fetched", []task.Task{{1, "Working", false, 1}}, nil, false},
-		{"Unable to fetch", []task.Task{}, errors.New("task not found"), true},
+		{"Data fetched", task.Filter{Status: task.StatusTodo},
+			page.Page[task.Task]{Items: []task.Task{{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}}, Total: 1},
+			nil, true, false},
+		{"Overdue", task.Filter{Overdue: true},
+			page.Page[task.Task]{Items: []task.Task{{ID: 2, Desc: "Late", Status: task.StatusTodo, Userid: 1}}, Total: 1},
+			nil, true, false},
+		{"Unknown status filter", task.Filter{Status: "archived"}, page.Page[task.Task]{}, nil, false, true},
+		{"Invalid tag filter", task.Filter{Tags: []string{"no spaces"}}, page.Page[task.Task]{}, nil, false, true},
+		{"Unable to fetch", task.Filter{}, page.Page[task.Task]{}, errors.New("task not found"), true, true},
 	}
 
 	for _, tt := range tests {
@@ -146,7 +258,7 @@ func Test_AllTasks(t *testing.T) {
 
 		mockUserServ := NewMockUserServiceInterface(ctrl)
 
-		service := NewService(mockStore, mockUserServ)
+		service := NewService(mockStore, mockUserServ, fixedClock)
 
 		mockContainer, _ := container.NewMockContainer(t)
 
@@ -154,61 +266,381 @@ func Test_AllTasks(t *testing.T) {
 			Container: mockContainer,
 		}
 
-		mockStore.EXPECT().GetAllTask(ctx).Return(tt.mockOutput, tt.mockErr)
+		q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
 
-		res, err := service.All(ctx)
+		if tt.ifMock {
+			stored := tt.filter
+			if stored.Overdue {
+				stored.Now = stamp
+			}
+
+			mockStore.EXPECT().GetAllTask(ctx, stored, q).Return(tt.mockOutput, tt.mockErr)
+		}
+
+		res, err := service.All(ctx, tt.filter, q)
 
 		if tt.expErr {
 			assert.Error(t, err, tt.name)
 		} else {
 			assert.NoError(t, err, tt.name)
-			assert.ElementsMatch(t, tt.mockOutput, res, tt.name)
+			assert.Equal(t, tt.mockOutput, res, tt.name)
 		}
 
 	}
 }
 
-func Test_CompleteTask(t *testing.T) {
+func Test_UpdateTask(t *testing.T) {
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusInProgress, Userid: 1, Priority: task.PriorityP1, Version: 2}
+
 	tests := []struct {
-		name    string
-		input   int
-		taskErr error
-		expErr  bool
+		name      string
+		ver       int
+		input     task.Task
+		getErr    error
+		checkUser bool
+		userErr   error
+		ifUpdate  bool
+		updateErr error
+		expOut    task.Task
+		expErr    error
 	}{
 		{
-			name:   "Valid Task Creation",
-			input:  1,
-			expErr: false,
+			name:     "Valid Update",
+			ver:      2,
+			input:    task.Task{Desc: "Rework", Userid: 1},
+			ifUpdate: true,
+			expOut: task.Task{ID: 1, Desc: "Rework", Status: task.StatusInProgress, Userid: 1, Priority: task.PriorityP1, Version: 3,
+				UpdatedAt: stamp},
 		},
 		{
-			name:    "Task Not Found",
-			input:   1,
-			taskErr: errors.New("user not found"),
-			expErr:  true,
+			name:     "Raise Priority",
+			input:    task.Task{Desc: "Work", Userid: 1, Priority: task.PriorityP0},
+			ifUpdate: true,
+			expOut: task.Task{ID: 1, Desc: "Work", Status: task.StatusInProgress, Userid: 1, Priority: task.PriorityP0, Version: 3,
+				UpdatedAt: stamp},
+		},
+		{
+			name:      "Reassign To Existing User",
+			input:     task.Task{Desc: "Work", Status: task.StatusInProgress, Userid: 2},
+			checkUser: true,
+			ifUpdate:  true,
+			expOut: task.Task{ID: 1, Desc: "Work", Status: task.StatusInProgress, Userid: 2, Priority: task.PriorityP1, Version: 3,
+				UpdatedAt: stamp},
+		},
+		{
+			name:   "Stale Version",
+			ver:    1,
+			input:  task.Task{Desc: "Rework", Userid: 1},
+			expErr: version.ErrMismatch,
+		},
+		{
+			name:      "Changed Concurrently",
+			ver:       2,
+			input:     task.Task{Desc: "Rework", Userid: 1},
+			ifUpdate:  true,
+			updateErr: version.ErrMismatch,
+			expErr:    version.ErrMismatch,
+		},
+		{
+			name:      "Reassign To Missing User",
+			input:     task.Task{Desc: "Work", Userid: 3},
+			checkUser: true,
+			userErr:   errs.NotFound{Entity: "user", ID: 3},
+			expErr:    errs.DependencyMissing{Entity: "user", ID: 3},
+		},
+		{
+			name:   "Task Not Found",
+			input:  task.Task{Desc: "Work", Userid: 1},
+			getErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr: errs.NotFound{Entity: "task", ID: 1},
+		},
+		{
+			name:   "Status Change Rejected",
+			input:  task.Task{Desc: "Work", Status: task.StatusDone, Userid: 1},
+			expErr: errs.Validation{Field: "status", Reason: "can only be changed through a transition"},
+		},
+		{
+			name:   "Validation Error",
+			input:  task.Task{Userid: 1},
+			expErr: errs.Validation{Field: "desc", Reason: "must not be empty"},
 		},
 	}
 
 	for _, tt := range tests {
 		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+
 		mockStore := NewMockTaskStoreInterface(ctrl)
 
-		service := NewService(mockStore, nil)
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
 		mockContainer, _ := container.NewMockContainer(t)
+
 		ctx := &gofr.Context{
 			Container: mockContainer,
 		}
-		mockStore.EXPECT().CompleteTask(ctx, tt.input).Return(tt.taskErr).AnyTimes()
 
-		err := service.Complete(ctx, tt.input)
-		if tt.expErr {
-			assert.Error(t, err, tt.name)
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, tt.getErr)
+
+		if tt.checkUser {
+			mockUserServ.EXPECT().Get(ctx, tt.input.Userid).Return(user.User{ID: tt.input.Userid}, tt.userErr)
+		}
+
+		if tt.ifUpdate {
+			stored := task.Task{ID: 1, Desc: tt.input.Desc, Status: cur.Status, Userid: tt.input.Userid, Priority: cur.Priority,
+				Version: cur.Version, UpdatedAt: stamp}
+
+			if tt.input.Priority != "" {
+				stored.Priority = tt.input.Priority
+			}
+			mockStore.EXPECT().UpdateTask(ctx, stored).Return(tt.updateErr)
+
+			if tt.updateErr == nil {
+				mockStore.EXPECT().CreateEventTask(ctx, gomock.Any()).Return(nil)
+			}
+		}
+
+		res, err := service.Update(ctx, 1, tt.ver, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.Equal(t, tt.expOut, res, tt.name)
+	}
+}
+
+func Test_PatchTask(t *testing.T) {
+	cur := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 4}
+
+	tests := []struct {
+		name     string
+		ver      int
+		patch    map[string]any
+		ifUpdate bool
+		expOut   task.Task
+		expErr   error
+	}{
+		{
+			name:     "Change Description Only",
+			ver:      4,
+			patch:    map[string]any{"desc": "Rework"},
+			ifUpdate: true,
+			expOut:   task.Task{ID: 1, Desc: "Rework", Status: task.StatusTodo, Userid: 1, Version: 5, UpdatedAt: stamp},
+		},
+		{
+			name:   "Stale Version",
+			ver:    3,
+			patch:  map[string]any{"desc": "Rework"},
+			expErr: version.ErrMismatch,
+		},
+		{
+			name:   "Null Removes Required Member",
+			patch:  map[string]any{"desc": nil},
+			expErr: errs.Validation{Field: "desc", Reason: "must not be empty"},
+		},
+		{
+			name:   "Wrong Type",
+			patch:  map[string]any{"userid": "two"},
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, NewMockUserServiceInterface(ctrl), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDTask(ctx, 1).Return(cur, nil)
+
+		if tt.ifUpdate {
+			stored := tt.expOut
+			stored.Version = cur.Version
+			mockStore.EXPECT().UpdateTask(ctx, stored).Return(nil)
+			mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventUpdated)).Return(nil)
+		}
+
+		res, err := service.Patch(ctx, 1, tt.ver, tt.patch)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.Equal(t, tt.expOut, res, tt.name)
+	}
+}
+
+func Test_Transition(t *testing.T) {
+	tests := []struct {
+		name      string
+		ver       int
+		to        task.Status
+		current   task.Task
+		getErr    error
+		updateErr error
+		ifUpdate  bool
+		expErr    error
+	}{
+		{
+			name:     "Valid Transition",
+			ver:      1,
+			to:       task.StatusInProgress,
+			current:  task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 1},
+			ifUpdate: true,
+		},
+		{
+			name:    "Stale Version",
+			ver:     1,
+			to:      task.StatusInProgress,
+			current: task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Version: 2},
+			expErr:  version.ErrMismatch,
+		},
+		{
+			name:   "Unknown Status",
+			to:     "archived",
+			expErr: errs.Validation{Field: "status", Reason: "unknown status archived"},
+		},
+		{
+			name:   "Task Not Found",
+			to:     task.StatusDone,
+			getErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr: errs.NotFound{Entity: "task", ID: 1},
+		},
+		{
+			name:    "Illegal Transition",
+			to:      task.StatusInReview,
+			current: task.Task{ID: 1, Desc: "Work", Status: task.StatusCancelled, Userid: 1},
+			expErr: ErrIllegalTransition{From: task.StatusCancelled, To: task.StatusInReview,
+				Allowed: []task.Status{task.StatusTodo}},
+		},
+		{
+			name:      "Status Changed Concurrently",
+			to:        task.StatusDone,
+			current:   task.Task{ID: 1, Desc: "Work", Status: task.StatusInProgress, Userid: 1, Version: 1},
+			updateErr: version.ErrMismatch,
+			ifUpdate:  true,
+			expErr:    ErrStatusChanged{ID: 1},
+		},
+		{
+			name:      "Version Changed Concurrently",
+			ver:       1,
+			to:        task.StatusDone,
+			current:   task.Task{ID: 1, Desc: "Work", Status: task.StatusInProgress, Userid: 1, Version: 1},
+			updateErr: version.ErrMismatch,
+			ifUpdate:  true,
+			expErr:    version.ErrMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.to != "archived" {
+			mockStore.EXPECT().GetByIDTask(ctx, 1).Return(tt.current, tt.getErr)
+		}
+
+		if tt.ifUpdate {
+			if tt.to == task.StatusDone {
+				mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil)
+				mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(0, nil)
+			}
+
+			mockStore.EXPECT().UpdateStatusTask(ctx, 1, tt.current.Version, tt.to, stamp).Return(tt.updateErr)
+
+			if tt.updateErr == nil {
+				mockStore.EXPECT().CreateEventTask(ctx, gomock.Any()).Return(nil)
+			}
+		}
+
+		res, err := service.Transition(ctx, 1, tt.ver, tt.to)
+
+		if tt.expErr != nil {
+			assert.Equal(t, tt.expErr, err, tt.name)
 		} else {
 			assert.NoError(t, err, tt.name)
-
+			assert.Equal(t, tt.to, res.Status, tt.name)
+			assert.Equal(t, tt.current.Version+1, res.Version, tt.name)
+			assert.Equal(t, stamp, res.UpdatedAt, tt.name)
 		}
+	}
+}
 
+func Test_CompleteTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
 	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Desc: "Work", Status: task.StatusInReview, Userid: 1, Version: 5}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 1, 5, task.StatusDone, stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventCompleted)).Return(nil)
+
+	assert.NoError(t, service.Complete(ctx, 1))
+}
+
+func Test_CompleteCustomWorkflow(t *testing.T) {
+	wf, err := ParseWorkflow("todo:shipped|dropped;shipped*:todo;dropped*:todo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock, WithWorkflow(wf))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// tasks are completed in the first closed state of the workflow
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Status: "todo", Userid: 1, Version: 5}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 1, 5, task.Status("shipped"), stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventCompleted)).Return(nil)
+
+	assert.NoError(t, service.Complete(ctx, 1))
+
+	// closing the last open subtask in any closed state completes its parent
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{ID: 2, Status: "todo", Userid: 1, ParentID: parent(1), Version: 1}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 2).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 2).Return(0, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 2, 1, task.Status("dropped"), stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(2, task.EventTransitioned)).Return(nil)
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1, Status: "todo", Userid: 1, Version: 5}, nil)
+	mockStore.EXPECT().CountOpenChildrenTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().CountOpenBlockersTask(ctx, 1).Return(0, nil)
+	mockStore.EXPECT().UpdateStatusTask(ctx, 1, 5, task.Status("shipped"), stamp).Return(nil)
+	mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventCompleted)).Return(nil)
+
+	_, err = service.Transition(ctx, 2, 1, "dropped")
+	assert.NoError(t, err)
 }
 
 func Test_DeleteTask(t *testing.T) {
@@ -238,7 +670,7 @@ func Test_DeleteTask(t *testing.T) {
 
 		mockStore := NewMockTaskStoreInterface(ctrl)
 
-		service := NewService(mockStore, nil)
+		service := NewService(mockStore, nil, fixedClock)
 
 		mockContainer, _ := container.NewMockContainer(t)
 
@@ -246,9 +678,14 @@ func Test_DeleteTask(t *testing.T) {
 			Container: mockContainer,
 		}
 
-		mockStore.EXPECT().DeleteTask(ctx, tt.input).Return(tt.taskErr).AnyTimes()
+		mockStore.EXPECT().GetByIDTask(ctx, tt.input).Return(task.Task{ID: tt.input, Version: 2}, nil)
+		mockStore.EXPECT().DeleteTask(ctx, tt.input, 2, stamp).Return(tt.taskErr).AnyTimes()
+
+		if !tt.expErr {
+			mockStore.EXPECT().CreateEventTask(ctx, event(tt.input, task.EventDeleted)).Return(nil)
+		}
 
-		err := service.Delete(ctx, tt.input)
+		err := service.Delete(ctx, tt.input, 2)
 
 		if tt.expErr {
 			assert.Error(t, err, tt.name)
@@ -258,6 +695,319 @@ func Test_DeleteTask(t *testing.T) {
 	}
 }
 
+func Test_RestoreTask(t *testing.T) {
+	tests := []struct {
+		name       string
+		restoreErr error
+		expOut     task.Task
+		expErr     error
+	}{
+		{
+			name:   "Restored",
+			expOut: task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2, Version: 3},
+		},
+		{
+			name:       "Not in trash",
+			restoreErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr:     errs.NotFound{Entity: "task", ID: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockStore := NewMockTaskStoreInterface(ctrl)
+
+			service := NewService(mockStore, nil, fixedClock)
+
+			mockContainer, _ := container.NewMockContainer(t)
+
+			ctx := &gofr.Context{
+				Container: mockContainer,
+			}
+
+			mockStore.EXPECT().RestoreTask(ctx, 1).Return(tt.restoreErr)
+
+			if tt.restoreErr == nil {
+				mockStore.EXPECT().GetByIDTask(ctx, 1).Return(tt.expOut, nil)
+				mockStore.EXPECT().CreateEventTask(ctx, event(1, task.EventRestored)).Return(nil)
+			}
+
+			res, err := service.Restore(ctx, 1)
+
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expOut, res)
+		})
+	}
+}
+
+func Test_PurgeTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().PurgeTask(ctx, stamp.Add(-48*time.Hour)).Return(int64(4), nil)
+
+	n, err := service.Purge(ctx, 48*time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_NextTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		userErr error
+		nextErr error
+		expOut  task.Task
+		expErr  error
+	}{
+		{
+			name:   "Next Task",
+			expOut: task.Task{ID: 8, Desc: "Fix outage", Status: task.StatusInProgress, Userid: 3, Priority: task.PriorityP0},
+		},
+		{
+			name:    "User Not Found",
+			userErr: errs.NotFound{Entity: "user", ID: 3},
+			expErr:  errs.NotFound{Entity: "user", ID: 3},
+		},
+		{
+			name:    "No Open Task",
+			nextErr: errs.NotFound{Entity: "open task for user", ID: 3},
+			expErr:  errs.NotFound{Entity: "open task for user", ID: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		mockUserServ := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockUserServ, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockUserServ.EXPECT().Get(ctx, 3).Return(user.User{ID: 3}, tt.userErr)
+
+		if tt.userErr == nil {
+			mockStore.EXPECT().GetNextTask(ctx, 3).Return(tt.expOut, tt.nextErr)
+		}
+
+		res, err := service.Next(ctx, 3)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, tt.expOut, res, tt.name)
+		}
+	}
+}
+
+func Test_AllTasksByTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+
+	mockStore.EXPECT().GetAllTask(ctx, task.Filter{Tags: []string{"backend", "bug"}, AllTags: true}, q).
+		Return(page.Page[task.Task]{Items: []task.Task{}, Limit: 20}, nil)
+
+	_, err := service.All(ctx, task.Filter{Tags: []string{" Backend", "bug", "BUG"}, AllTags: true}, q)
+
+	assert.NoError(t, err)
+}
+
+func Test_AttachTag(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		getErr    error
+		attachErr error
+		expOut    []string
+		expErr    error
+	}{
+		{
+			name:   "Attached",
+			tag:    "Backend",
+			expOut: []string{"backend", "bug"},
+		},
+		{
+			name:   "Invalid Tag",
+			tag:    "two words",
+			expErr: errs.Validation{Field: "tag", Reason: "may only contain letters, digits, '-', '_' and ':'"},
+		},
+		{
+			name:   "Task Not Found",
+			tag:    "bug",
+			getErr: errs.NotFound{Entity: "task", ID: 1},
+			expErr: errs.NotFound{Entity: "task", ID: 1},
+		},
+		{
+			name:      "Store Error",
+			tag:       "bug",
+			attachErr: errors.New("db down"),
+			expErr:    errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTaskStoreInterface(ctrl)
+
+		service := NewService(mockStore, nil, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		name, err := task.NormalizeTag(tt.tag)
+		if err == nil {
+			mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1}, tt.getErr)
+
+			if tt.getErr == nil {
+				mockStore.EXPECT().AttachTagTask(ctx, 1, name).Return(tt.attachErr)
+			}
+
+			if tt.expErr == nil {
+				mockStore.EXPECT().GetTagsByTaskIDTask(ctx, 1).Return(tt.expOut, nil)
+			}
+		}
+
+		res, err := service.AttachTag(ctx, 1, tt.tag)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.Equal(t, tt.expOut, res, tt.name)
+	}
+}
+
+func Test_DetachAndListTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockTaskStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockStore.EXPECT().GetByIDTask(ctx, 1).Return(task.Task{ID: 1}, nil)
+	mockStore.EXPECT().DetachTagTask(ctx, 1, "bug").Return(nil)
+	mockStore.EXPECT().GetTagsByTaskIDTask(ctx, 1).Return([]string{"backend"}, nil)
+
+	res, err := service.DetachTag(ctx, 1, "BUG")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"backend"}, res)
+
+	mockStore.EXPECT().GetByIDTask(ctx, 2).Return(task.Task{}, errs.NotFound{Entity: "task", ID: 2})
+
+	_, err = service.Tags(ctx, 2)
+
+	assert.Equal(t, errs.NotFound{Entity: "task", ID: 2}, err)
+
+	mockStore.EXPECT().GetAllTagsTask(ctx).Return([]task.Tag{{Name: "backend", Tasks: 1}}, nil)
+
+	tags, err := service.AllTags(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []task.Tag{{Name: "backend", Tasks: 1}}, tags)
+}
+
+func Test_Remind(t *testing.T) {
+	due := stamp.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		due     []task.Task
+		dueErr  error
+		markErr error
+		expSent int
+		expErr  bool
+	}{
+		{
+			name: "Reminders sent",
+			due: []task.Task{{ID: 1, Desc: "Ship", Userid: 2, DueAt: &due},
+				{ID: 3, Desc: "Test", Userid: 4, DueAt: &due}},
+			expSent: 2,
+		},
+		{
+			name:   "Store error",
+			dueErr: errors.New("db down"),
+			expErr: true,
+		},
+		{
+			name:    "Mark fails",
+			due:     []task.Task{{ID: 1, Desc: "Ship", Userid: 2, DueAt: &due}},
+			markErr: errors.New("db down"),
+			expErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockStore := NewMockTaskStoreInterface(ctrl)
+
+			memory := notifier.NewMemory()
+
+			service := NewService(mockStore, nil, fixedClock, WithNotifier(memory))
+
+			mockContainer, _ := container.NewMockContainer(t)
+
+			ctx := &gofr.Context{
+				Container: mockContainer,
+			}
+
+			mockStore.EXPECT().GetDueTask(ctx, stamp).Return(tt.due, tt.dueErr)
+
+			for _, d := range tt.due {
+				mockStore.EXPECT().MarkRemindedTask(ctx, d.ID, stamp).Return(tt.markErr)
+			}
+
+			sent, err := service.Remind(ctx)
+
+			assert.Equal(t, tt.expSent, sent)
+			assert.Equal(t, tt.expErr, err != nil, tt.name)
+			assert.Len(t, memory.Reminders(), len(tt.due))
+
+			for i, r := range memory.Reminders() {
+				assert.Equal(t, task.Reminder{TaskID: tt.due[i].ID, Userid: tt.due[i].Userid, Desc: tt.due[i].Desc, DueAt: due}, r)
+			}
+		})
+	}
+}
+
 func Test_GetTasksByUserId(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -273,7 +1023,7 @@ func Test_GetTasksByUserId(t *testing.T) {
 			input:      1,
 			mockUser:   user.User{ID: 1, Name: "Test", Email: "test@test.com"},
 			userErr:    nil,
-			mockOutput: []task.Task{{ID: 1, Desc: "Working", Status: false, Userid: 1}},
+			mockOutput: []task.Task{{ID: 1, Desc: "Working", Status: task.StatusTodo, Userid: 1}},
 			mockErr:    nil,
 			expErr:     false,
 		},
@@ -295,7 +1045,7 @@ func Test_GetTasksByUserId(t *testing.T) {
 
 		mockUserServ := NewMockUserServiceInterface(ctrl)
 
-		service := NewService(mockStore, mockUserServ)
+		service := NewService(mockStore, mockUserServ, fixedClock)
 
 		mockContainer, _ := container.NewMockContainer(t)
 
@@ -0,0 +1,184 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/model/task"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+var errInvalidWorkflow = errors.New("invalid task workflow")
+
+// Workflow is the state machine a task moves through: the set of states and the
+// transitions allowed between them. The first state is the one new tasks start in.
+// The closed states are those of finished tasks, the first of them the one tasks are
+// completed in.
+type Workflow struct {
+	initial     task.Status
+	closed      []task.Status
+	transitions map[task.Status][]task.Status
+}
+
+// DefaultWorkflow returns the built-in workflow used when none is configured.
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		initial: task.StatusTodo,
+		closed:  []task.Status{task.StatusDone, task.StatusCancelled},
+		transitions: map[task.Status][]task.Status{
+			task.StatusTodo:       {task.StatusInProgress, task.StatusBlocked, task.StatusDone, task.StatusCancelled},
+			task.StatusInProgress: {task.StatusTodo, task.StatusBlocked, task.StatusInReview, task.StatusDone, task.StatusCancelled},
+			task.StatusBlocked:    {task.StatusTodo, task.StatusInProgress, task.StatusCancelled},
+			task.StatusInReview:   {task.StatusInProgress, task.StatusDone, task.StatusCancelled},
+			task.StatusDone:       {task.StatusTodo, task.StatusInProgress},
+			task.StatusCancelled:  {task.StatusTodo},
+		},
+	}
+}
+
+// ParseWorkflow builds a workflow from a spec such as
+// "todo:in_progress|cancelled;in_progress:done|todo;done*:todo;cancelled*:todo".
+// Each entry lists a state and the states it may move to; the first entry is the initial state.
+// Every state that appears as a target must also be declared as an entry. States declared with
+// a trailing "*" are closed, and there must be at least one besides the initial state: the first
+// is where tasks are completed.
+func ParseWorkflow(spec string) (*Workflow, error) {
+	wf := &Workflow{transitions: make(map[task.Status][]task.Status)}
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		from, targets, _ := strings.Cut(entry, ":")
+
+		name, closed := strings.CutSuffix(strings.TrimSpace(from), "*")
+
+		state := task.Status(strings.TrimSpace(name))
+		if state == "" {
+			return nil, fmt.Errorf("%w: empty state in %q", errInvalidWorkflow, entry)
+		}
+
+		if _, ok := wf.transitions[state]; ok {
+			return nil, fmt.Errorf("%w: state %q declared twice", errInvalidWorkflow, state)
+		}
+
+		if wf.initial == "" {
+			wf.initial = state
+		}
+
+		if closed {
+			wf.closed = append(wf.closed, state)
+		}
+
+		wf.transitions[state] = []task.Status{}
+
+		for _, to := range strings.Split(targets, "|") {
+			if to = strings.TrimSpace(to); to != "" {
+				wf.transitions[state] = append(wf.transitions[state], task.Status(to))
+			}
+		}
+	}
+
+	if wf.initial == "" {
+		return nil, fmt.Errorf("%w: no states declared", errInvalidWorkflow)
+	}
+
+	if len(wf.closed) == 0 {
+		return nil, fmt.Errorf("%w: no closed state declared", errInvalidWorkflow)
+	}
+
+	if wf.IsClosed(wf.initial) {
+		return nil, fmt.Errorf("%w: initial state %q is closed", errInvalidWorkflow, wf.initial)
+	}
+
+	for from, targets := range wf.transitions {
+		for _, to := range targets {
+			if !wf.HasState(to) {
+				return nil, fmt.Errorf("%w: %q moves to undeclared state %q", errInvalidWorkflow, from, to)
+			}
+		}
+	}
+
+	return wf, nil
+}
+
+// Initial returns the state new tasks are created in.
+func (w *Workflow) Initial() task.Status {
+	return w.initial
+}
+
+// HasState reports whether s is a state of the workflow.
+func (w *Workflow) HasState(s task.Status) bool {
+	_, ok := w.transitions[s]
+
+	return ok
+}
+
+// Closed returns the states of finished tasks, which are never overdue nor next to work on, in the order they were
+// declared.
+func (w *Workflow) Closed() []task.Status {
+	return w.closed
+}
+
+// IsClosed reports whether s is one of the closed states.
+func (w *Workflow) IsClosed(s task.Status) bool {
+	return slices.Contains(w.closed, s)
+}
+
+// Done returns the state tasks are completed in, the first closed state. Completing a task, alone, in bulk or by
+// completing its subtasks, moves it there.
+func (w *Workflow) Done() task.Status {
+	return w.closed[0]
+}
+
+// Allowed returns the states a task in state s may move to.
+func (w *Workflow) Allowed(s task.Status) []task.Status {
+	return w.transitions[s]
+}
+
+// CanTransition reports whether a task may move from one state to another.
+func (w *Workflow) CanTransition(from, to task.Status) bool {
+	for _, s := range w.transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErrIllegalTransition is returned when the workflow does not allow the requested status change.
+type ErrIllegalTransition struct {
+	From    task.Status
+	To      task.Status
+	Allowed []task.Status
+}
+
+func (e ErrIllegalTransition) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, s := range e.Allowed {
+		allowed[i] = string(s)
+	}
+
+	return fmt.Sprintf("illegal transition from %q to %q, allowed: [%s]", e.From, e.To, strings.Join(allowed, ", "))
+}
+
+func (ErrIllegalTransition) StatusCode() int {
+	return http.StatusConflict
+}
+
+// ErrStatusChanged is returned when a task's status was changed by someone else during a transition.
+type ErrStatusChanged struct {
+	ID int
+}
+
+func (e ErrStatusChanged) Error() string {
+	return fmt.Sprintf("status of task %d changed concurrently, reload and retry", e.ID)
+}
+
+func (ErrStatusChanged) StatusCode() int {
+	return http.StatusConflict
+}
@@ -0,0 +1,65 @@
+package task
+
+import (
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_DefaultWorkflow(t *testing.T) {
+	wf := DefaultWorkflow()
+
+	assert.Equal(t, task.StatusTodo, wf.Initial())
+	assert.True(t, wf.CanTransition(task.StatusTodo, task.StatusInProgress))
+	assert.True(t, wf.CanTransition(task.StatusDone, task.StatusTodo), "done tasks can be reopened")
+	assert.False(t, wf.CanTransition(task.StatusCancelled, task.StatusDone))
+	assert.False(t, wf.HasState("archived"))
+	assert.Equal(t, []task.Status{task.StatusDone, task.StatusCancelled}, wf.Closed())
+	assert.Equal(t, task.StatusDone, wf.Done())
+	assert.True(t, wf.IsClosed(task.StatusCancelled))
+	assert.False(t, wf.IsClosed(task.StatusInReview))
+}
+
+func Test_ParseWorkflow(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		expErr bool
+	}{
+		{"Valid Spec", "open:closed; closed*:open", false},
+		{"Terminal State", "open:closed;closed*", false},
+		{"Empty Spec", " ", true},
+		{"Empty State", ":closed;closed*:", true},
+		{"Closed Empty State", "open:closed;*:open;closed*", true},
+		{"Duplicate State", "open:closed;open:closed;closed*:", true},
+		{"Undeclared Target", "open:closed", true},
+		{"Closed Target", "open:closed*;closed*", true},
+		{"No Closed State", "open:closed;closed:open", true},
+		{"Initial State Closed", "open*:closed;closed*", true},
+	}
+
+	for _, tt := range tests {
+		wf, err := ParseWorkflow(tt.spec)
+
+		if tt.expErr {
+			assert.ErrorIs(t, err, errInvalidWorkflow, tt.name)
+			continue
+		}
+
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, task.Status("open"), wf.Initial(), tt.name)
+		assert.True(t, wf.CanTransition("open", "closed"), tt.name)
+		assert.False(t, wf.CanTransition("closed", "closed"), tt.name)
+		assert.Equal(t, task.Status("closed"), wf.Done(), tt.name)
+		assert.False(t, wf.IsClosed("open"), tt.name)
+	}
+}
+
+func Test_ParseWorkflowClosed(t *testing.T) {
+	wf, err := ParseWorkflow("todo:shipped|dropped;shipped*:todo;dropped*:todo")
+	assert.NoError(t, err)
+
+	// tasks are completed in the first closed state
+	assert.Equal(t, []task.Status{"shipped", "dropped"}, wf.Closed())
+	assert.Equal(t, task.Status("shipped"), wf.Done())
+}
@@ -0,0 +1,32 @@
+package template
+
+import (
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/user"
+	"gofr.dev/pkg/gofr"
+)
+
+type TemplateStoreInterface interface {
+	CreateTemplate(c *gofr.Context, t template.Template) (template.Template, error)
+	GetByIDTemplate(c *gofr.Context, id int) (template.Template, error)
+	GetAllTemplate(c *gofr.Context) ([]template.Template, error)
+	UpdateTemplate(c *gofr.Context, t template.Template) error
+	DeleteTemplate(c *gofr.Context, id, ver int) error
+}
+
+// TaskServiceInterface creates the tasks of an instantiated template.
+type TaskServiceInterface interface {
+	CreateMany(c *gofr.Context, ts []task.Task) ([]task.Task, error)
+}
+
+// UserServiceInterface finds the user a template is instantiated for.
+type UserServiceInterface interface {
+	Get(c *gofr.Context, id int) (user.User, error)
+}
+
+// Policy decides whether the user making a request may use a permission.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
+}
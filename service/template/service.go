@@ -0,0 +1,155 @@
+package template
+
+import (
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type TemplateService struct {
+	str    TemplateStoreInterface
+	tasks  TaskServiceInterface
+	users  UserServiceInterface
+	policy Policy
+	now    func() time.Time
+}
+
+// Option configures optional collaborators of TemplateService.
+type Option func(*TemplateService)
+
+// WithPolicy checks every change to a template against an access policy. Without one, anyone may manage templates.
+// The tasks of an instantiated template are checked by the task service.
+func WithPolicy(p Policy) Option {
+	return func(s *TemplateService) {
+		s.policy = p
+	}
+}
+
+// WithClock replaces the clock used to stamp templates and to fill in the date of their tasks.
+func WithClock(now func() time.Time) Option {
+	return func(s *TemplateService) {
+		s.now = now
+	}
+}
+
+func NewService(s TemplateStoreInterface, tasks TaskServiceInterface, users UserServiceInterface, opts ...Option) *TemplateService {
+	svc := &TemplateService{
+		str:   s,
+		tasks: tasks,
+		users: users,
+		now:   svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Create adds a template along with its tasks.
+func (s *TemplateService) Create(c *gofr.Context, t template.Template) (template.Template, error) {
+	if err := t.Validate(); err != nil {
+		return template.Template{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return template.Template{}, err
+	}
+
+	t.ID = 0
+	t.CreatedAt = s.now()
+	t.UpdatedAt = t.CreatedAt
+
+	return s.str.CreateTemplate(c, t)
+}
+
+func (s *TemplateService) Get(c *gofr.Context, id int) (template.Template, error) {
+	return s.str.GetByIDTemplate(c, id)
+}
+
+// All returns every template of the workspace, in name order.
+func (s *TemplateService) All(c *gofr.Context) ([]template.Template, error) {
+	return s.str.GetAllTemplate(c)
+}
+
+// Update replaces the name and tasks of a template at version ver. The tasks already created from it are left as
+// they are.
+func (s *TemplateService) Update(c *gofr.Context, id, ver int, t template.Template) (template.Template, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return template.Template{}, err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return template.Template{}, err
+	}
+
+	if err := t.Validate(); err != nil {
+		return template.Template{}, err
+	}
+
+	t.ID, t.Version, t.CreatedAt = cur.ID, cur.Version, cur.CreatedAt
+	t.UpdatedAt = s.now()
+
+	if err := s.str.UpdateTemplate(c, t); err != nil {
+		return template.Template{}, err
+	}
+
+	t.Version++
+
+	return t, nil
+}
+
+// Delete removes a template at version ver, or at any version if ver is version.Any. The tasks created from it are
+// left as they are.
+func (s *TemplateService) Delete(c *gofr.Context, id, ver int) error {
+	if _, err := s.get(c, id, ver); err != nil {
+		return err
+	}
+
+	if err := s.authorize(c); err != nil {
+		return err
+	}
+
+	return s.str.DeleteTemplate(c, id, ver)
+}
+
+// Instantiate creates the tasks of a template for a user, its placeholders filled in for them and today, all or none
+// of them. They are returned in the order of the template.
+func (s *TemplateService) Instantiate(c *gofr.Context, id int, in template.Instantiation) ([]task.Task, error) {
+	t, err := s.str.GetByIDTemplate(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.users.Get(c, in.Userid)
+	if err != nil {
+		return nil, svcutil.Missing(err, "user", in.Userid)
+	}
+
+	return s.tasks.CreateMany(c, t.Render(u, in, s.now()))
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may manage templates.
+func (s *TemplateService) authorize(c *gofr.Context) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, policy.TemplateManage, 0)
+}
+
+// get reads a template and checks it is still at version ver, unless ver is version.Any.
+func (s *TemplateService) get(c *gofr.Context, id, ver int) (template.Template, error) {
+	t, err := s.str.GetByIDTemplate(c, id)
+	if err == nil && ver != version.Any && t.Version != ver {
+		return t, version.ErrMismatch
+	}
+
+	return t, err
+}
@@ -0,0 +1,259 @@
+package template
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+var denied = errs.Forbidden{Permission: string(policy.TemplateManage)}
+
+var items = []template.Item{{Desc: "Set up a laptop for {{user.name}}"}, {Desc: "Read handbook", Priority: task.PriorityP1}}
+
+func ref(id int) *int {
+	return &id
+}
+
+func Test_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     template.Template
+		policyErr error
+		ifStore   bool
+		expErr    error
+	}{
+		{name: "Valid Template", input: template.Template{ID: 7, Name: "Onboarding", Tasks: items}, ifStore: true},
+		{name: "Missing Name", input: template.Template{Tasks: items},
+			expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "No Tasks", input: template.Template{Name: "Onboarding"},
+			expErr: errs.Validation{Field: "tasks", Reason: "must have between 1 and 50 tasks"}},
+		{name: "Empty Task", input: template.Template{Name: "Onboarding", Tasks: []template.Item{{Desc: "Meet the team"}, {}}},
+			expErr: errs.Validation{Field: "tasks[1].desc", Reason: "must not be empty"}},
+		{name: "Unknown Priority", input: template.Template{Name: "Onboarding", Tasks: []template.Item{{Desc: "Meet", Priority: "P9"}}},
+			expErr: errs.Validation{Field: "tasks[0].priority", Reason: "must be one of P0, P1, P2, P3"}},
+		{name: "Unknown Placeholder", input: template.Template{Name: "Onboarding", Tasks: []template.Item{{Desc: "Call {{user.phone}}"}}},
+			expErr: errs.Validation{Field: "tasks[0].desc", Reason: "unknown placeholder {{user.phone}}"}},
+		{name: "Not Allowed", input: template.Template{Name: "Onboarding", Tasks: items}, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTemplateStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.ifStore || tt.policyErr != nil {
+			mockPolicy.EXPECT().Authorize(ctx, policy.TemplateManage, 0).Return(tt.policyErr)
+		}
+
+		if tt.ifStore {
+			want := tt.input
+			want.ID, want.CreatedAt, want.UpdatedAt = 0, stamp, stamp
+
+			created := want
+			created.ID, created.Version = 2, 1
+
+			mockStore.EXPECT().CreateTemplate(ctx, want).Return(created, nil)
+		}
+
+		res, err := service.Create(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 2, res.ID, tt.name)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	cur := template.Template{ID: 3, Name: "Onboarding", Version: 2, Tasks: items, CreatedAt: stamp.Add(-time.Hour)}
+	renamed := []template.Item{{Desc: "Meet the team"}}
+
+	tests := []struct {
+		name      string
+		ver       int
+		input     template.Template
+		getErr    error
+		policyErr error
+		storeErr  error
+		expErr    error
+	}{
+		{name: "Valid Update", ver: 2, input: template.Template{Name: "Welcome", Tasks: renamed}},
+		{name: "Any Version", ver: version.Any, input: template.Template{Name: "Welcome", Tasks: renamed}},
+		{name: "Stale Version", ver: 1, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: 2, getErr: errs.NotFound{Entity: "template", ID: 3}, expErr: errs.NotFound{Entity: "template", ID: 3}},
+		{name: "Not Allowed", ver: 2, input: template.Template{Name: "Welcome", Tasks: renamed}, policyErr: denied, expErr: denied},
+		{name: "Invalid Template", ver: 2, input: template.Template{Name: "Welcome"},
+			expErr: errs.Validation{Field: "tasks", Reason: "must have between 1 and 50 tasks"}},
+		{name: "Store Error", ver: 2, input: template.Template{Name: "Welcome", Tasks: renamed}, storeErr: version.ErrMismatch,
+			expErr: version.ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTemplateStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy), fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDTemplate(ctx, 3).Return(cur, tt.getErr)
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.TemplateManage, 0).Return(tt.policyErr)
+		}
+
+		want := template.Template{ID: 3, Name: "Welcome", Version: 2, Tasks: renamed, CreatedAt: cur.CreatedAt, UpdatedAt: stamp}
+
+		if tt.input.Tasks != nil && tt.policyErr == nil {
+			mockStore.EXPECT().UpdateTemplate(ctx, want).Return(tt.storeErr)
+		}
+
+		res, err := service.Update(ctx, 3, tt.ver, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, 3, res.Version, tt.name)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ver       int
+		getErr    error
+		policyErr error
+		expErr    error
+	}{
+		{name: "Valid Delete", ver: 2},
+		{name: "Stale Version", ver: 1, expErr: version.ErrMismatch},
+		{name: "Not Found", ver: version.Any, getErr: errs.NotFound{Entity: "template", ID: 3},
+			expErr: errs.NotFound{Entity: "template", ID: 3}},
+		{name: "Not Allowed", ver: version.Any, policyErr: denied, expErr: denied},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTemplateStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewService(mockStore, nil, nil, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDTemplate(ctx, 3).Return(template.Template{ID: 3, Version: 2}, tt.getErr)
+
+		if tt.getErr == nil && tt.ver != 1 {
+			mockPolicy.EXPECT().Authorize(ctx, policy.TemplateManage, 0).Return(tt.policyErr)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().DeleteTemplate(ctx, 3, tt.ver).Return(nil)
+		}
+
+		err := service.Delete(ctx, 3, tt.ver)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_Instantiate(t *testing.T) {
+	tpl := template.Template{ID: 3, Name: "Onboarding", Tasks: []template.Item{
+		{Desc: "Set up a laptop for {{user.name}} <{{user.email}}>", Priority: task.PriorityP1, Estimate: ref(2)},
+		{Desc: "Welcome lunch with user {{user.id}} on {{date}}"},
+	}}
+	alice := user.User{ID: 10, Name: "Alice", Email: "alice@example.com"}
+
+	rendered := []task.Task{
+		{Desc: "Set up a laptop for Alice <alice@example.com>", Userid: 10, Priority: task.PriorityP1, Estimate: ref(2), ProjectID: ref(4)},
+		{Desc: "Welcome lunch with user 10 on 2026-10-18", Userid: 10, ProjectID: ref(4)},
+	}
+
+	tests := []struct {
+		name     string
+		getErr   error
+		userErr  error
+		tasksErr error
+		expErr   error
+	}{
+		{name: "Tasks Created"},
+		{name: "Missing Template", getErr: errs.NotFound{Entity: "template", ID: 3}, expErr: errs.NotFound{Entity: "template", ID: 3}},
+		{name: "Missing User", userErr: errs.NotFound{Entity: "user", ID: 10}, expErr: errs.DependencyMissing{Entity: "user", ID: 10}},
+		{name: "User Store Error", userErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Tasks Not Created", tasksErr: errs.Forbidden{Permission: "task:assign"}, expErr: errs.Forbidden{Permission: "task:assign"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockTemplateStoreInterface(ctrl)
+		mockTasks := NewMockTaskServiceInterface(ctrl)
+		mockUsers := NewMockUserServiceInterface(ctrl)
+
+		service := NewService(mockStore, mockTasks, mockUsers, fixedClock)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDTemplate(ctx, 3).Return(tpl, tt.getErr)
+
+		if tt.getErr == nil {
+			mockUsers.EXPECT().Get(ctx, 10).Return(alice, tt.userErr)
+		}
+
+		created := []task.Task{{ID: 7}, {ID: 8}}
+
+		if tt.getErr == nil && tt.userErr == nil {
+			mockTasks.EXPECT().CreateMany(ctx, rendered).Return(created, tt.tasksErr)
+		}
+
+		res, err := service.Instantiate(ctx, 3, template.Instantiation{Userid: 10, ProjectID: ref(4)})
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, created, res, tt.name)
+		}
+	}
+}
@@ -1,13 +1,39 @@
 package user
 
 import (
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
 	"github.com/MGajendra22/GoFr/model/user"
 	"gofr.dev/pkg/gofr"
+	"time"
 )
 
 type UserStoreInterface interface {
 	CreateUser(c *gofr.Context, u user.User) (user.User, error)
 	GetByIDUser(c *gofr.Context, id int) (user.User, error)
-	DeleteUser(c *gofr.Context, id int) error
-	GetAllUser(c *gofr.Context) ([]user.User, error)
+	GetByEmailUser(c *gofr.Context, email string) (user.User, error)
+	UpdateUser(c *gofr.Context, u user.User) error
+	DeleteUser(c *gofr.Context, id, ver int, p user.DeletePolicy, at time.Time) error
+	GetAllUser(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error)
+	CreateRefreshTokenUser(c *gofr.Context, t auth.RefreshToken) error
+	DeleteRefreshTokenUser(c *gofr.Context, userID int, id string) error
+	DeleteRefreshTokensUser(c *gofr.Context, userID int) error
+}
+
+// Auditor appends the changes made by the service to the audit log.
+type Auditor interface {
+	Record(c *gofr.Context, entity string, id int, action string, data any) error
+}
+
+// Tasks reads the tasks of a user being deleted, and records in their history what the delete policy does to them.
+type Tasks interface {
+	GetTasksByUserIDTask(c *gofr.Context, userid int) ([]task.Task, error)
+	CreateEventTask(c *gofr.Context, e task.Event) error
+}
+
+// Policy decides whether the user making a request may use a permission on the account of owner.
+type Policy interface {
+	Authorize(c *gofr.Context, perm policy.Permission, owner int) error
 }
@@ -11,7 +11,12 @@ package user
 
 import (
 	reflect "reflect"
+	time "time"
 
+	auth "github.com/MGajendra22/GoFr/model/auth"
+	page "github.com/MGajendra22/GoFr/model/page"
+	policy "github.com/MGajendra22/GoFr/model/policy"
+	task "github.com/MGajendra22/GoFr/model/task"
 	user "github.com/MGajendra22/GoFr/model/user"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
@@ -41,6 +46,20 @@ func (m *MockUserStoreInterface) EXPECT() *MockUserStoreInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CreateRefreshTokenUser mocks base method.
+func (m *MockUserStoreInterface) CreateRefreshTokenUser(c *gofr.Context, t auth.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshTokenUser", c, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshTokenUser indicates an expected call of CreateRefreshTokenUser.
+func (mr *MockUserStoreInterfaceMockRecorder) CreateRefreshTokenUser(c, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshTokenUser", reflect.TypeOf((*MockUserStoreInterface)(nil).CreateRefreshTokenUser), c, t)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserStoreInterface) CreateUser(c *gofr.Context, u user.User) (user.User, error) {
 	m.ctrl.T.Helper()
@@ -56,33 +75,76 @@ func (mr *MockUserStoreInterfaceMockRecorder) CreateUser(c, u any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserStoreInterface)(nil).CreateUser), c, u)
 }
 
+// DeleteRefreshTokenUser mocks base method.
+func (m *MockUserStoreInterface) DeleteRefreshTokenUser(c *gofr.Context, userID int, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRefreshTokenUser", c, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRefreshTokenUser indicates an expected call of DeleteRefreshTokenUser.
+func (mr *MockUserStoreInterfaceMockRecorder) DeleteRefreshTokenUser(c, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokenUser", reflect.TypeOf((*MockUserStoreInterface)(nil).DeleteRefreshTokenUser), c, userID, id)
+}
+
+// DeleteRefreshTokensUser mocks base method.
+func (m *MockUserStoreInterface) DeleteRefreshTokensUser(c *gofr.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRefreshTokensUser", c, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRefreshTokensUser indicates an expected call of DeleteRefreshTokensUser.
+func (mr *MockUserStoreInterfaceMockRecorder) DeleteRefreshTokensUser(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokensUser", reflect.TypeOf((*MockUserStoreInterface)(nil).DeleteRefreshTokensUser), c, userID)
+}
+
 // DeleteUser mocks base method.
-func (m *MockUserStoreInterface) DeleteUser(c *gofr.Context, id int) error {
+func (m *MockUserStoreInterface) DeleteUser(c *gofr.Context, id, ver int, p user.DeletePolicy, at time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", c, id)
+	ret := m.ctrl.Call(m, "DeleteUser", c, id, ver, p, at)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUserStoreInterfaceMockRecorder) DeleteUser(c, id any) *gomock.Call {
+func (mr *MockUserStoreInterfaceMockRecorder) DeleteUser(c, id, ver, p, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserStoreInterface)(nil).DeleteUser), c, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserStoreInterface)(nil).DeleteUser), c, id, ver, p, at)
 }
 
 // GetAllUser mocks base method.
-func (m *MockUserStoreInterface) GetAllUser(c *gofr.Context) ([]user.User, error) {
+func (m *MockUserStoreInterface) GetAllUser(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUser", c)
-	ret0, _ := ret[0].([]user.User)
+	ret := m.ctrl.Call(m, "GetAllUser", c, f, q)
+	ret0, _ := ret[0].(page.Page[user.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllUser indicates an expected call of GetAllUser.
-func (mr *MockUserStoreInterfaceMockRecorder) GetAllUser(c any) *gomock.Call {
+func (mr *MockUserStoreInterfaceMockRecorder) GetAllUser(c, f, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUser", reflect.TypeOf((*MockUserStoreInterface)(nil).GetAllUser), c, f, q)
+}
+
+// GetByEmailUser mocks base method.
+func (m *MockUserStoreInterface) GetByEmailUser(c *gofr.Context, email string) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmailUser", c, email)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmailUser indicates an expected call of GetByEmailUser.
+func (mr *MockUserStoreInterfaceMockRecorder) GetByEmailUser(c, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUser", reflect.TypeOf((*MockUserStoreInterface)(nil).GetAllUser), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmailUser", reflect.TypeOf((*MockUserStoreInterface)(nil).GetByEmailUser), c, email)
 }
 
 // GetByIDUser mocks base method.
@@ -99,3 +161,146 @@ func (mr *MockUserStoreInterfaceMockRecorder) GetByIDUser(c, id any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDUser", reflect.TypeOf((*MockUserStoreInterface)(nil).GetByIDUser), c, id)
 }
+
+// UpdateUser mocks base method.
+func (m *MockUserStoreInterface) UpdateUser(c *gofr.Context, u user.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", c, u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserStoreInterfaceMockRecorder) UpdateUser(c, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserStoreInterface)(nil).UpdateUser), c, u)
+}
+
+// MockAuditor is a mock of Auditor interface.
+type MockAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditorMockRecorder is the mock recorder for MockAuditor.
+type MockAuditorMockRecorder struct {
+	mock *MockAuditor
+}
+
+// NewMockAuditor creates a new mock instance.
+func NewMockAuditor(ctrl *gomock.Controller) *MockAuditor {
+	mock := &MockAuditor{ctrl: ctrl}
+	mock.recorder = &MockAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditor) EXPECT() *MockAuditorMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditor) Record(c *gofr.Context, entity string, id int, action string, data any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", c, entity, id, action, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditorMockRecorder) Record(c, entity, id, action, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditor)(nil).Record), c, entity, id, action, data)
+}
+
+// MockTasks is a mock of Tasks interface.
+type MockTasks struct {
+	ctrl     *gomock.Controller
+	recorder *MockTasksMockRecorder
+	isgomock struct{}
+}
+
+// MockTasksMockRecorder is the mock recorder for MockTasks.
+type MockTasksMockRecorder struct {
+	mock *MockTasks
+}
+
+// NewMockTasks creates a new mock instance.
+func NewMockTasks(ctrl *gomock.Controller) *MockTasks {
+	mock := &MockTasks{ctrl: ctrl}
+	mock.recorder = &MockTasksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTasks) EXPECT() *MockTasksMockRecorder {
+	return m.recorder
+}
+
+// CreateEventTask mocks base method.
+func (m *MockTasks) CreateEventTask(c *gofr.Context, e task.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTask", c, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventTask indicates an expected call of CreateEventTask.
+func (mr *MockTasksMockRecorder) CreateEventTask(c, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTask", reflect.TypeOf((*MockTasks)(nil).CreateEventTask), c, e)
+}
+
+// GetTasksByUserIDTask mocks base method.
+func (m *MockTasks) GetTasksByUserIDTask(c *gofr.Context, userid int) ([]task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksByUserIDTask", c, userid)
+	ret0, _ := ret[0].([]task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksByUserIDTask indicates an expected call of GetTasksByUserIDTask.
+func (mr *MockTasksMockRecorder) GetTasksByUserIDTask(c, userid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByUserIDTask", reflect.TypeOf((*MockTasks)(nil).GetTasksByUserIDTask), c, userid)
+}
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", c, perm, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(c, perm, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), c, perm, owner)
+}
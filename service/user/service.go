@@ -1,35 +1,392 @@
 package user
 
 import (
+	"errors"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/middleware"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/patch"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
 	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/crypto/bcrypt"
+	"time"
 )
 
 type UserService struct {
-	store UserStoreInterface
+	store   UserStoreInterface
+	auditor Auditor
+	policy  Policy
+	tasks   Tasks
+	now     func() time.Time
 }
 
-func NewUserService(store UserStoreInterface) *UserService {
-	return &UserService{store: store}
+// Option configures optional collaborators of UserService.
+type Option func(*UserService)
+
+// WithAuditor records every change to a user in the audit log.
+func WithAuditor(a Auditor) Option {
+	return func(s *UserService) {
+		s.auditor = a
+	}
+}
+
+// WithPolicy checks every change to a user against an access policy. Without one, anyone may change any user and
+// give any role.
+func WithPolicy(p Policy) Option {
+	return func(s *UserService) {
+		s.policy = p
+	}
 }
 
+// WithTasks records in the history of the tasks of a user being deleted what the delete policy does to them. Without
+// it, their history does not show it.
+func WithTasks(t Tasks) Option {
+	return func(s *UserService) {
+		s.tasks = t
+	}
+}
+
+// WithClock replaces the clock used to stamp the tasks a delete policy changes.
+func WithClock(now func() time.Time) Option {
+	return func(s *UserService) {
+		s.now = now
+	}
+}
+
+func NewUserService(store UserStoreInterface, opts ...Option) *UserService {
+	svc := &UserService{store: store, now: svcutil.Now}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// ErrInvalidCredentials is returned by Authenticate, whichever of the email and the password is wrong.
+var ErrInvalidCredentials = errs.Unauthorized{Reason: "invalid email or password"}
+
+// Create adds a user to the workspace of the request, who must have a password to log in with. Users are members
+// unless created with another role by someone allowed to give it.
 func (s *UserService) Create(c *gofr.Context, u user.User) (user.User, error) {
 	if err := u.Validate(); err != nil {
 		return u, err
 	}
 
-	return s.store.CreateUser(c, u)
+	if u.Role == "" {
+		u.Role = user.RoleMember
+	}
+
+	if err := s.authorize(c, policy.UserCreate, 0); err != nil {
+		return u, err
+	}
+
+	if u.Role != user.RoleMember {
+		if err := s.authorize(c, policy.UserSetRole, 0); err != nil {
+			return u, err
+		}
+	}
+
+	return s.create(c, u)
+}
+
+// CreateAdmin adds the first admin of a workspace being created, in the workspace c runs in. No policy is asked, as
+// nobody of the workspace can have been granted anything yet: only the creation of the workspace may call it.
+func (s *UserService) CreateAdmin(c *gofr.Context, u user.User) (user.User, error) {
+	u.Role = user.RoleAdmin
+
+	if err := u.Validate(); err != nil {
+		return u, err
+	}
+
+	return s.create(c, u)
+}
+
+// create hashes the password of a checked user and stores them.
+func (s *UserService) create(c *gofr.Context, u user.User) (user.User, error) {
+	if u.Password == "" {
+		return u, errs.Validation{Field: "password", Reason: "must not be empty"}
+	}
+
+	if err := hashPassword(&u); err != nil {
+		return u, err
+	}
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		var err error
+
+		if u, err = s.store.CreateUser(c, u); err != nil {
+			return err
+		}
+
+		return s.record(c, u.ID, audit.ActionCreated, audit.Change{After: u})
+	})
+	if err != nil {
+		return user.User{}, err
+	}
+
+	return u, nil
 }
 
 func (s *UserService) Get(c *gofr.Context, id int) (user.User, error) {
 	return s.store.GetByIDUser(c, id)
 }
 
-func (s *UserService) Delete(c *gofr.Context, id int) error {
-	return s.store.DeleteUser(c, id)
+// Update replaces the name and email of a user at version ver, and its role and password if u has them. A new password
+// revokes the refresh tokens of the user, logging them out everywhere once their access tokens expire.
+func (s *UserService) Update(c *gofr.Context, id, ver int, u user.User) (user.User, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	return s.update(c, cur, u)
+}
+
+// Patch applies a JSON merge patch to a user at version ver.
+func (s *UserService) Patch(c *gofr.Context, id, ver int, p map[string]any) (user.User, error) {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	u := cur
+
+	if err := patch.Merge(&u, p); err != nil {
+		return user.User{}, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return s.update(c, cur, u)
 }
 
-func (s *UserService) All(c *gofr.Context) ([]user.User, error) {
-	return s.store.GetAllUser(c)
+func (s *UserService) update(c *gofr.Context, cur, u user.User) (user.User, error) {
+	if err := s.authorize(c, policy.UserUpdate, cur.ID); err != nil {
+		return user.User{}, err
+	}
+
+	u.ID, u.Version = cur.ID, cur.Version
+
+	if u.Role == "" {
+		u.Role = cur.Role
+	}
+
+	if err := u.Validate(); err != nil {
+		return user.User{}, err
+	}
+
+	if u.Role != cur.Role {
+		if err := s.authorize(c, policy.UserSetRole, cur.ID); err != nil {
+			return user.User{}, err
+		}
+	}
+
+	newPassword := u.Password != ""
+
+	if err := hashPassword(&u); err != nil {
+		return user.User{}, err
+	}
+
+	err := s.audited(c, func(c *gofr.Context) error {
+		if err := s.store.UpdateUser(c, u); err != nil {
+			return err
+		}
+
+		if newPassword {
+			if err := s.store.DeleteRefreshTokensUser(c, u.ID); err != nil {
+				return err
+			}
+		}
+
+		u.Version++
+
+		return s.record(c, u.ID, audit.ActionUpdated, audit.Change{Before: cur, After: u})
+	})
+	if err != nil {
+		return user.User{}, err
+	}
+
+	return u, nil
+}
+
+// authorize asks the policy of the service, if it has one, whether the user making the request may use perm on the
+// account of owner.
+func (s *UserService) authorize(c *gofr.Context, perm policy.Permission, owner int) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	return s.policy.Authorize(c, perm, owner)
+}
+
+// get reads a user and checks it is still at version ver, unless ver is version.Any.
+func (s *UserService) get(c *gofr.Context, id, ver int) (user.User, error) {
+	u, err := s.store.GetByIDUser(c, id)
+	if err == nil && ver != version.Any && u.Version != ver {
+		return u, version.ErrMismatch
+	}
+
+	return u, err
+}
+
+// Delete removes a user at version ver, or at any version if ver is version.Any, applying p to their tasks and
+// recurrences. Cascading moves their tasks to the trash, reassigning needs the new user to be a member of their
+// projects.
+func (s *UserService) Delete(c *gofr.Context, id, ver int, p user.DeletePolicy) error {
+	cur, err := s.get(c, id, ver)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(c, policy.UserDelete, id); err != nil {
+		return err
+	}
+
+	if p.Tasks == user.OnDeleteReassign {
+		if p.ReassignTo == id {
+			return errs.Validation{Field: "reassign_to", Reason: "must be another user"}
+		}
+
+		if _, err := s.store.GetByIDUser(c, p.ReassignTo); err != nil {
+			return svcutil.Missing(err, "user", p.ReassignTo)
+		}
+	}
+
+	now := s.now()
+
+	return s.audited(c, func(c *gofr.Context) error {
+		var owned []task.Task
+
+		if s.tasks != nil && (p.Tasks == user.OnDeleteCascade || p.Tasks == user.OnDeleteReassign) {
+			if owned, err = s.tasks.GetTasksByUserIDTask(c, id); err != nil {
+				return err
+			}
+		}
+
+		if err := s.store.DeleteUser(c, id, ver, p, now); err != nil {
+			return err
+		}
+
+		for _, t := range owned {
+			if err := s.handedOver(c, t, p, now); err != nil {
+				return err
+			}
+		}
+
+		return s.record(c, id, audit.ActionDeleted, audit.Change{Before: cur, Detail: p})
+	})
+}
+
+// handedOver records in the history of a task of a deleted user what the delete policy did to it: a deletion, as if
+// the task were deleted, or a reassignment to the new user.
+func (s *UserService) handedOver(c *gofr.Context, t task.Task, p user.DeletePolicy, at time.Time) error {
+	e := task.Event{TaskID: t.ID, Type: task.EventDeleted, ActorID: middleware.GetActor(c), At: at, Before: &t}
+
+	if p.Tasks == user.OnDeleteReassign {
+		after := t
+		after.Userid, after.Version, after.UpdatedAt = p.ReassignTo, t.Version+1, at
+		e.Type, e.After = task.EventReassigned, &after
+	}
+
+	if err := s.tasks.CreateEventTask(c, e); err != nil {
+		c.Errorf("recording %s event of task %d: %v", e.Type, t.ID, err)
+	}
+
+	if s.auditor == nil {
+		return nil
+	}
+
+	return s.auditor.Record(c, audit.EntityTask, t.ID, string(e.Type), audit.Change{Before: e.Before, After: e.After})
+}
+
+func (s *UserService) All(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error) {
+	return s.store.GetAllUser(c, f, q)
+
+}
+
+// Authenticate returns the user with the given email if password is theirs. Users without a password cannot log in.
+func (s *UserService) Authenticate(c *gofr.Context, email, password string) (user.User, error) {
+	u, err := s.store.GetByEmailUser(c, email)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return user.User{}, err
+	}
+
+	hash := u.PasswordHash
+	if hash == "" {
+		// compare anyway, so that unknown emails take as long to reject as wrong passwords
+		hash = dummyHash
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil || u.PasswordHash == "" {
+		return user.User{}, ErrInvalidCredentials
+	}
+
+	u.PasswordHash = ""
+
+	return u, nil
+}
+
+// AddRefreshToken keeps a refresh token issued to a user, so that it is accepted.
+func (s *UserService) AddRefreshToken(c *gofr.Context, t auth.RefreshToken) error {
+	return s.store.CreateRefreshTokenUser(c, t)
+}
+
+// UseRefreshToken forgets a refresh token of a user as it is exchanged, so that it cannot be exchanged again. It
+// returns errs.NotFound for a token used or revoked already.
+func (s *UserService) UseRefreshToken(c *gofr.Context, userID int, id string) error {
+	return s.store.DeleteRefreshTokenUser(c, userID, id)
+}
+
+// RevokeRefreshTokens forgets every refresh token of a user.
+func (s *UserService) RevokeRefreshTokens(c *gofr.Context, userID int) error {
+	return s.store.DeleteRefreshTokensUser(c, userID)
+}
+
+// dummyHash is the bcrypt hash of a random password nobody knows, at the cost new passwords are hashed at.
+const dummyHash = "$2a$10$g7u1PnG1Yl70sMZiDPAwQOk3EMqMJTv3nYFtQgyLHQShG3G6Nf.qW"
+
+// hashPassword replaces the password of u, if it has one, with its hash.
+func hashPassword(u *user.User) error {
+	if u.Password == "" {
+		return nil
+	}
+
+	if err := user.ValidatePassword(u.Password); err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u.Password, u.PasswordHash = "", string(hash)
+
+	return nil
+}
+
+// record appends a change to a user to the audit log, if the service has one.
+func (s *UserService) record(c *gofr.Context, id int, action string, change audit.Change) error {
+	if s.auditor == nil {
+		return nil
+	}
+
+	return s.auditor.Record(c, audit.EntityUser, id, action, change)
+}
+
+// audited makes a change that records itself. If the service has an auditor, the change runs in a transaction along
+// with its audit entry, so that no change is kept without one.
+func (s *UserService) audited(c *gofr.Context, change func(c *gofr.Context) error) error {
+	if s.auditor == nil {
+		return change(c)
+	}
 
+	return sqlutil.InTx(c, change)
 }
@@ -2,13 +2,20 @@ package user
 
 import (
 	"errors"
-	_ "github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/policy"
+	"github.com/MGajendra22/GoFr/model/task"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
+	"golang.org/x/crypto/bcrypt"
 	"testing"
+	"time"
 )
 
 func Test_CreateUser(t *testing.T) {
@@ -21,7 +28,7 @@ func Test_CreateUser(t *testing.T) {
 	}{
 		{
 			name:       "Valid user",
-			input:      user.User{ID: 1, Name: "Alice", Email: "alice@example.com"},
+			input:      user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Password: "correct horse"},
 			mockOutput: user.User{ID: 1, Name: "Alice", Email: "alice@example.com"},
 			mockError:  nil,
 			expErr:     false,
@@ -31,10 +38,20 @@ func Test_CreateUser(t *testing.T) {
 			input:  user.User{ID: 2, Name: "", Email: ""},
 			expErr: true,
 		},
+		{
+			name:   "Missing password",
+			input:  user.User{ID: 2, Name: "Bob", Email: "bob@example.com"},
+			expErr: true,
+		},
+		{
+			name:   "Short password",
+			input:  user.User{ID: 2, Name: "Bob", Email: "bob@example.com", Password: "short"},
+			expErr: true,
+		},
 
 		{
 			name:      "Store error",
-			input:     user.User{ID: 3, Name: "Bob", Email: "bob@example.com"},
+			input:     user.User{ID: 3, Name: "Bob", Email: "bob@example.com", Password: "correct horse"},
 			mockError: errors.New("db error"),
 			expErr:    true,
 		},
@@ -58,7 +75,12 @@ func Test_CreateUser(t *testing.T) {
 
 			service := NewUserService(mockstore)
 
-			mockstore.EXPECT().CreateUser(ctx, gomock.Any()).Return(tt.mockOutput, tt.mockError).AnyTimes()
+			// the store gets the hash of the password, never the password itself
+			hashed := gomock.Cond(func(u user.User) bool {
+				return u.Password == "" && bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(tt.input.Password)) == nil
+			})
+
+			mockstore.EXPECT().CreateUser(ctx, hashed).Return(tt.mockOutput, tt.mockError).AnyTimes()
 
 			result, err := service.Create(ctx, tt.input)
 
@@ -80,7 +102,7 @@ func Test_GetUser(t *testing.T) {
 		mockErr    error
 		expErr     bool
 	}{
-		{"Valid Id", 1, user.User{1, "John", "mail"}, nil, false},
+		{"Valid Id", 1, user.User{ID: 1, Name: "John", Email: "mail", Version: 1}, nil, false},
 		{"User not found", 2, user.User{}, errors.New("task not found"), true},
 	}
 	for _, tt := range tests {
@@ -113,21 +135,46 @@ func Test_GetUser(t *testing.T) {
 
 func Test_DeleteUser(t *testing.T) {
 	tests := []struct {
-		name    string
-		input   int
-		taskErr error
-		expErr  bool
+		name      string
+		input     int
+		policy    user.DeletePolicy
+		targetErr error
+		ifDelete  bool
+		taskErr   error
+		expErr    error
 	}{
 		{
-			name:   "Valid user Deletion",
-			input:  1,
-			expErr: false,
+			name:     "Valid user Deletion",
+			input:    1,
+			policy:   user.DeletePolicy{Tasks: user.OnDeleteReject},
+			ifDelete: true,
 		},
 		{
-			name:    "User Not Found",
-			input:   1,
-			taskErr: errors.New("user not found"),
-			expErr:  true,
+			name:     "User Not Found",
+			input:    1,
+			policy:   user.DeletePolicy{Tasks: user.OnDeleteCascade},
+			ifDelete: true,
+			taskErr:  errs.NotFound{Entity: "user", ID: 1},
+			expErr:   errs.NotFound{Entity: "user", ID: 1},
+		},
+		{
+			name:     "Reassign To Existing User",
+			input:    1,
+			policy:   user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 2},
+			ifDelete: true,
+		},
+		{
+			name:      "Reassign To Missing User",
+			input:     1,
+			policy:    user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 2},
+			targetErr: errs.NotFound{Entity: "user", ID: 2},
+			expErr:    errs.DependencyMissing{Entity: "user", ID: 2},
+		},
+		{
+			name:   "Reassign To Same User",
+			input:  1,
+			policy: user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 1},
+			expErr: errs.Validation{Field: "reassign_to", Reason: "must be another user"},
 		},
 	}
 	for _, tt := range tests {
@@ -145,27 +192,302 @@ func Test_DeleteUser(t *testing.T) {
 			Container: mockContainer,
 		}
 
-		mockstore.EXPECT().DeleteUser(ctx, tt.input).Return(tt.taskErr).AnyTimes()
+		mockstore.EXPECT().GetByIDUser(ctx, tt.input).Return(user.User{ID: tt.input, Version: 1}, nil)
+
+		if tt.policy.Tasks == user.OnDeleteReassign && tt.policy.ReassignTo != tt.input {
+			mockstore.EXPECT().GetByIDUser(ctx, tt.policy.ReassignTo).Return(user.User{ID: tt.policy.ReassignTo}, tt.targetErr)
+		}
+
+		if tt.ifDelete {
+			mockstore.EXPECT().DeleteUser(ctx, tt.input, version.Any, tt.policy, gomock.Any()).Return(tt.taskErr)
+		}
 
-		err := service.Delete(ctx, tt.input)
+		err := service.Delete(ctx, tt.input, version.Any, tt.policy)
 
-		if tt.expErr {
-			assert.Error(t, err, tt.name)
-		} else {
-			assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_DeleteUserTasks(t *testing.T) {
+	stamp := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	due := stamp.Add(48 * time.Hour)
+	owned := []task.Task{{ID: 7, Desc: "Write report", Userid: 1, Version: 2, DueAt: &due}, {ID: 8, Desc: "Review", Userid: 1, Version: 1}}
+
+	reassigned := func(t task.Task) *task.Task {
+		t.Userid, t.Version, t.UpdatedAt = 2, t.Version+1, stamp
+
+		return &t
+	}
+
+	tests := []struct {
+		name      string
+		policy    user.DeletePolicy
+		deleteErr error
+		expEvents []task.Event
+		expErr    error
+	}{
+		{
+			name:   "Cascade Trashes The Tasks",
+			policy: user.DeletePolicy{Tasks: user.OnDeleteCascade},
+			expEvents: []task.Event{{TaskID: 7, Type: task.EventDeleted, At: stamp, Before: &owned[0]},
+				{TaskID: 8, Type: task.EventDeleted, At: stamp, Before: &owned[1]}},
+		},
+		{
+			name:   "Reassign Moves The Tasks",
+			policy: user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 2},
+			expEvents: []task.Event{{TaskID: 7, Type: task.EventReassigned, At: stamp, Before: &owned[0], After: reassigned(owned[0])},
+				{TaskID: 8, Type: task.EventReassigned, At: stamp, Before: &owned[1], After: reassigned(owned[1])}},
+		},
+		{
+			name:      "Reassign To A User Outside A Project",
+			policy:    user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 2},
+			deleteErr: errs.Validation{Field: "reassign_to", Reason: "must be a member of project 3"},
+			expErr:    errs.Validation{Field: "reassign_to", Reason: "must be a member of project 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockUserStoreInterface(ctrl)
+		mockTasks := NewMockTasks(ctrl)
+
+		service := NewUserService(mockStore, WithTasks(mockTasks), WithClock(func() time.Time { return stamp }))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDUser(ctx, 1).Return(user.User{ID: 1, Version: 1}, nil)
+
+		if tt.policy.Tasks == user.OnDeleteReassign {
+			mockStore.EXPECT().GetByIDUser(ctx, 2).Return(user.User{ID: 2}, nil)
+		}
+
+		mockTasks.EXPECT().GetTasksByUserIDTask(ctx, 1).Return(owned, nil)
+		mockStore.EXPECT().DeleteUser(ctx, 1, version.Any, tt.policy, stamp).Return(tt.deleteErr)
+
+		for _, e := range tt.expEvents {
+			mockTasks.EXPECT().CreateEventTask(ctx, e).Return(nil)
+		}
+
+		err := service.Delete(ctx, 1, version.Any, tt.policy)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+	}
+}
+
+func Test_UpdateUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		ver      int
+		input    user.User
+		getErr   error
+		ifUpdate bool
+		storeErr error
+		expOut   user.User
+		expErr   bool
+	}{
+		{
+			name:     "Valid update",
+			ver:      2,
+			input:    user.User{ID: 9, Name: "Alice", Email: "alice@new.com", Version: 9},
+			ifUpdate: true,
+			expOut:   user.User{ID: 1, Name: "Alice", Email: "alice@new.com", Version: 3},
+		},
+		{
+			name:   "Stale version",
+			ver:    1,
+			input:  user.User{Name: "Alice", Email: "alice@new.com"},
+			expErr: true,
+		},
+		{
+			name:   "User not found",
+			input:  user.User{Name: "Alice", Email: "alice@new.com"},
+			getErr: errs.NotFound{Entity: "user", ID: 1},
+			expErr: true,
+		},
+		{
+			name:   "Validation error",
+			input:  user.User{Name: "Alice"},
+			expErr: true,
+		},
+		{
+			name:     "Store error",
+			input:    user.User{Name: "Alice", Email: "taken@example.com"},
+			ifUpdate: true,
+			storeErr: errors.New("duplicate email"),
+			expErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockContainer, _ := container.NewMockContainer(t)
+
+			ctx := &gofr.Context{
+				Container: mockContainer,
+			}
+
+			mockstore := NewMockUserStoreInterface(ctrl)
+
+			service := NewUserService(mockstore)
+
+			mockstore.EXPECT().GetByIDUser(ctx, 1).Return(user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 2}, tt.getErr)
+
+			if tt.ifUpdate {
+				mockstore.EXPECT().UpdateUser(ctx, user.User{ID: 1, Name: tt.input.Name, Email: tt.input.Email, Version: 2}).
+					Return(tt.storeErr)
+			}
+
+			result, err := service.Update(ctx, 1, tt.ver, tt.input)
+
+			if tt.expErr {
+				assert.Error(t, err, tt.name)
+			} else {
+				assert.NoError(t, err, tt.name)
+				assert.Equal(t, tt.expOut, result, tt.name)
+			}
+
+			if tt.getErr != nil {
+				assert.Equal(t, tt.getErr, err)
+			}
+
+			if tt.ver == 1 {
+				assert.ErrorIs(t, err, version.ErrMismatch)
+			}
+		})
+	}
+}
+
+func Test_UpdatePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	mockstore := NewMockUserStoreInterface(ctrl)
+
+	service := NewUserService(mockstore)
+
+	cur := user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 2}
+
+	mockstore.EXPECT().GetByIDUser(ctx, 1).Return(cur, nil).Times(2)
+	mockstore.EXPECT().UpdateUser(ctx, gomock.Cond(func(u user.User) bool {
+		return u.Password == "" && bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("new password")) == nil
+	})).Return(nil)
+	// a new password logs the user out everywhere
+	mockstore.EXPECT().DeleteRefreshTokensUser(ctx, 1).Return(nil)
+
+	res, err := service.Patch(ctx, 1, 2, map[string]any{"password": "new password"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, res.Password)
+
+	mockstore.EXPECT().GetByIDUser(ctx, 1).Return(cur, nil)
+	mockstore.EXPECT().UpdateUser(ctx, gomock.Any()).Return(nil)
+	mockstore.EXPECT().DeleteRefreshTokensUser(ctx, 1).Return(errors.New("db down"))
+
+	_, err = service.Patch(ctx, 1, 2, map[string]any{"password": "new password"})
+
+	assert.Equal(t, errors.New("db down"), err)
+
+	_, err = service.Patch(ctx, 1, 2, map[string]any{"password": "short"})
+
+	assert.Equal(t, errs.Validation{Field: "password", Reason: "must be 8 to 72 bytes long"}, err)
+}
+
+func Test_Authenticate(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+
+	alice := user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 2}
+
+	stored := alice
+	stored.PasswordHash = string(hash)
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		stored   user.User
+		storeErr error
+		expOut   user.User
+		expErr   error
+	}{
+		{name: "Valid credentials", email: "alice@example.com", password: "correct horse", stored: stored, expOut: alice},
+		{name: "Wrong password", email: "alice@example.com", password: "battery staple", stored: stored,
+			expErr: ErrInvalidCredentials},
+		{name: "Unknown email", email: "bob@example.com", password: "correct horse",
+			storeErr: errs.NotFound{Entity: "user"}, expErr: ErrInvalidCredentials},
+		{name: "No password set", email: "alice@example.com", password: "", stored: alice, expErr: ErrInvalidCredentials},
+		{name: "Store error", email: "alice@example.com", password: "correct horse", storeErr: errors.New("db down"),
+			expErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
 		}
+
+		mockstore := NewMockUserStoreInterface(ctrl)
+
+		service := NewUserService(mockstore)
+
+		mockstore.EXPECT().GetByEmailUser(ctx, tt.email).Return(tt.stored, tt.storeErr)
+
+		res, err := service.Authenticate(ctx, tt.email, tt.password)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+		assert.Equal(t, tt.expOut, res, tt.name)
+	}
+}
+
+func Test_PatchUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
 	}
+
+	mockstore := NewMockUserStoreInterface(ctrl)
+
+	service := NewUserService(mockstore)
+
+	mockstore.EXPECT().GetByIDUser(ctx, 1).Return(user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 1}, nil).Times(2)
+	mockstore.EXPECT().UpdateUser(ctx, user.User{ID: 1, Name: "Alice", Email: "alice@new.com", Version: 1}).Return(nil)
+
+	res, err := service.Patch(ctx, 1, version.Any, map[string]any{"email": "alice@new.com", "id": 7, "version": 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, user.User{ID: 1, Name: "Alice", Email: "alice@new.com", Version: 2}, res)
+
+	_, err = service.Patch(ctx, 1, version.Any, map[string]any{"name": nil})
+
+	assert.Error(t, err, "removing the name must fail validation")
 }
 
 func Test_GetAllUsers(t *testing.T) {
 	tests := []struct {
 		name       string
-		mockOutput []user.User
+		mockOutput page.Page[user.User]
 		mockErr    error
 		expErr     bool
 	}{
-		{"Data fetched", []user.User{{1, "John", "mail"}}, nil, false},
-		{"Unable to fetch", []user.User{}, errors.New("task not found"), true},
+		{"Data fetched", page.Page[user.User]{Items: []user.User{{ID: 1, Name: "John", Email: "mail", Version: 1}}, Total: 1, Limit: 20}, nil, false},
+		{"Unable to fetch", page.Page[user.User]{}, errors.New("task not found"), true},
 	}
 
 	for _, tt := range tests {
@@ -183,9 +505,12 @@ func Test_GetAllUsers(t *testing.T) {
 			Container: mockContainer,
 		}
 
-		mockstore.EXPECT().GetAllUser(ctx).Return(tt.mockOutput, tt.mockErr).AnyTimes()
+		f := user.Filter{Text: "john"}
+		q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
 
-		res, err := service.All(ctx)
+		mockstore.EXPECT().GetAllUser(ctx, f, q).Return(tt.mockOutput, tt.mockErr).AnyTimes()
+
+		res, err := service.All(ctx, f, q)
 
 		if tt.expErr {
 			assert.Error(t, err, tt.name)
@@ -195,3 +520,237 @@ func Test_GetAllUsers(t *testing.T) {
 		}
 	}
 }
+
+func Test_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockUserStoreInterface(ctrl)
+	mockAuditor := NewMockAuditor(ctrl)
+
+	service := NewUserService(mockStore, WithAuditor(mockAuditor))
+
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	alice := user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 1}
+	renamed := user.User{ID: 1, Name: "Alicia", Email: "alice@example.com", Version: 2}
+	reject := user.DeletePolicy{Tasks: user.OnDeleteReject}
+
+	// changes and their audit entries run in one transaction, given its context
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(alice, nil)
+	mockAuditor.EXPECT().Record(gomock.Any(), audit.EntityUser, 1, audit.ActionCreated, audit.Change{After: alice}).Return(nil)
+	mock.SQL.ExpectCommit()
+
+	_, err := service.Create(ctx, user.User{Name: "Alice", Email: "alice@example.com", Password: "correct horse"})
+	assert.NoError(t, err)
+
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().GetByIDUser(ctx, 1).Return(alice, nil)
+	mockStore.EXPECT().UpdateUser(gomock.Any(), user.User{ID: 1, Name: "Alicia", Email: "alice@example.com", Version: 1}).Return(nil)
+	mockAuditor.EXPECT().Record(gomock.Any(), audit.EntityUser, 1, audit.ActionUpdated, audit.Change{Before: alice, After: renamed}).
+		Return(errors.New("db write failed"))
+	mock.SQL.ExpectRollback()
+
+	_, err = service.Update(ctx, 1, 1, user.User{Name: "Alicia", Email: "alice@example.com"})
+	assert.EqualError(t, err, "db write failed", "a change that cannot be audited is rolled back")
+
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().GetByIDUser(ctx, 1).Return(alice, nil)
+	mockStore.EXPECT().DeleteUser(gomock.Any(), 1, 1, reject, gomock.Any()).Return(nil)
+	mockAuditor.EXPECT().Record(gomock.Any(), audit.EntityUser, 1, audit.ActionDeleted, audit.Change{Before: alice, Detail: reject}).Return(nil)
+	mock.SQL.ExpectCommit()
+
+	assert.NoError(t, service.Delete(ctx, 1, 1, reject))
+
+	mock.SQL.ExpectBegin()
+	mockStore.EXPECT().GetByIDUser(ctx, 1).Return(alice, nil)
+	mockStore.EXPECT().DeleteUser(gomock.Any(), 1, 1, reject, gomock.Any()).Return(errs.Conflict{Entity: "user", ID: 1, Reason: "still has 1 tasks"})
+	mock.SQL.ExpectRollback()
+
+	assert.Error(t, service.Delete(ctx, 1, 1, reject), "failed changes are not audited")
+}
+
+func Test_PolicyCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      user.Role
+		createErr error
+		authErr   error
+		expRole   user.Role
+		expErr    error
+	}{
+		{name: "Member By Manager", expRole: user.RoleMember},
+		{name: "Anonymous Sign Up", createErr: errs.Forbidden{Permission: "user:create"},
+			expErr: errs.Forbidden{Permission: "user:create"}},
+		{name: "Admin Of An Empty Workspace", role: user.RoleAdmin, createErr: errs.Forbidden{Permission: "user:create"},
+			expErr: errs.Forbidden{Permission: "user:create"}},
+		{name: "Manager By Admin", role: user.RoleManager, expRole: user.RoleManager},
+		{name: "Manager By Member", role: user.RoleManager, authErr: errs.Forbidden{Permission: "user:set_role"},
+			expErr: errs.Forbidden{Permission: "user:set_role"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockUserStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewUserService(mockStore, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		// users are never created without user:create, however few the workspace has
+		mockPolicy.EXPECT().Authorize(ctx, policy.UserCreate, 0).Return(tt.createErr)
+
+		if tt.createErr == nil && tt.role != "" && tt.role != user.RoleMember {
+			mockPolicy.EXPECT().Authorize(ctx, policy.UserSetRole, 0).Return(tt.authErr)
+		}
+
+		if tt.expErr == nil {
+			mockStore.EXPECT().CreateUser(ctx, gomock.Cond(func(u user.User) bool { return u.Role == tt.expRole })).
+				DoAndReturn(func(_ *gofr.Context, u user.User) (user.User, error) {
+					u.ID = 1
+					return u, nil
+				})
+		}
+
+		res, err := service.Create(ctx, user.User{Name: "Alice", Email: "alice@example.com", Password: "correct horse", Role: tt.role})
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, tt.expRole, res.Role, tt.name)
+		}
+	}
+}
+
+func Test_CreateAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockUserStoreInterface(ctrl)
+	mockPolicy := NewMockPolicy(ctrl)
+
+	service := NewUserService(mockStore, WithPolicy(mockPolicy))
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	// the policy is not asked: the workspace being created has nobody in it yet
+	mockStore.EXPECT().CreateUser(ctx, gomock.Cond(func(u user.User) bool {
+		return u.Role == user.RoleAdmin && u.Password == "" && u.PasswordHash != ""
+	})).DoAndReturn(func(_ *gofr.Context, u user.User) (user.User, error) {
+		u.ID = 1
+		return u, nil
+	})
+
+	res, err := service.CreateAdmin(ctx, user.User{Name: "Alice", Email: "alice@example.com", Password: "correct horse",
+		Role: user.RoleMember})
+
+	assert.NoError(t, err)
+	assert.Equal(t, user.RoleAdmin, res.Role)
+
+	_, err = service.CreateAdmin(ctx, user.User{Name: "Alice", Email: "alice@example.com", Password: "short"})
+
+	assert.Equal(t, errs.Validation{Field: "password", Reason: "must be 8 to 72 bytes long"}, err)
+}
+
+func Test_PolicyChange(t *testing.T) {
+	alice := user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Role: user.RoleMember, Version: 2}
+
+	tests := []struct {
+		name   string
+		call   func(s *UserService, c *gofr.Context) error
+		perms  []policy.Permission
+		denied policy.Permission
+		ifDo   bool
+	}{
+		{
+			name: "Edit Denied",
+			call: func(s *UserService, c *gofr.Context) error {
+				_, err := s.Update(c, 1, 2, user.User{Name: "Alicia", Email: "alice@example.com"})
+				return err
+			},
+			perms: []policy.Permission{policy.UserUpdate}, denied: policy.UserUpdate,
+		},
+		{
+			name: "Edit Keeping Role",
+			call: func(s *UserService, c *gofr.Context) error {
+				_, err := s.Update(c, 1, 2, user.User{Name: "Alicia", Email: "alice@example.com"})
+				return err
+			},
+			perms: []policy.Permission{policy.UserUpdate}, ifDo: true,
+		},
+		{
+			name: "Promotion Denied",
+			call: func(s *UserService, c *gofr.Context) error {
+				_, err := s.Patch(c, 1, 2, map[string]any{"role": "admin"})
+				return err
+			},
+			perms: []policy.Permission{policy.UserUpdate, policy.UserSetRole}, denied: policy.UserSetRole,
+		},
+		{
+			name: "Promotion",
+			call: func(s *UserService, c *gofr.Context) error {
+				_, err := s.Patch(c, 1, 2, map[string]any{"role": "admin"})
+				return err
+			},
+			perms: []policy.Permission{policy.UserUpdate, policy.UserSetRole}, ifDo: true,
+		},
+		{
+			name: "Delete Denied",
+			call: func(s *UserService, c *gofr.Context) error {
+				return s.Delete(c, 1, 2, user.DeletePolicy{Tasks: user.OnDeleteCascade})
+			},
+			perms: []policy.Permission{policy.UserDelete}, denied: policy.UserDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockUserStoreInterface(ctrl)
+		mockPolicy := NewMockPolicy(ctrl)
+
+		service := NewUserService(mockStore, WithPolicy(mockPolicy))
+
+		mockContainer, _ := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		mockStore.EXPECT().GetByIDUser(ctx, 1).Return(alice, nil)
+
+		for _, perm := range tt.perms {
+			var err error
+			if perm == tt.denied {
+				err = errs.Forbidden{Permission: string(perm)}
+			}
+
+			mockPolicy.EXPECT().Authorize(ctx, perm, 1).Return(err)
+		}
+
+		if tt.ifDo {
+			mockStore.EXPECT().UpdateUser(ctx, gomock.Any()).Return(nil)
+		}
+
+		err := tt.call(service, ctx)
+
+		if tt.denied != "" {
+			assert.Equal(t, errs.Forbidden{Permission: string(tt.denied)}, err, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package workspace
+
+import (
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+type WorkspaceStoreInterface interface {
+	CreateWorkspace(c *gofr.Context, w workspace.Workspace) (workspace.Workspace, error)
+	GetByIDWorkspace(c *gofr.Context, id int) (workspace.Workspace, error)
+	GetAllWorkspace(c *gofr.Context) ([]workspace.Workspace, error)
+}
+
+// Accounts creates the first admin of a new workspace.
+type Accounts interface {
+	CreateAdmin(c *gofr.Context, u user.User) (user.User, error)
+}
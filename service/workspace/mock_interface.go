@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=mock_interface.go -package=workspace
+//
+
+// Package workspace is a generated GoMock package.
+package workspace
+
+import (
+	reflect "reflect"
+
+	user "github.com/MGajendra22/GoFr/model/user"
+	workspace "github.com/MGajendra22/GoFr/model/workspace"
+	gomock "go.uber.org/mock/gomock"
+	gofr "gofr.dev/pkg/gofr"
+)
+
+// MockWorkspaceStoreInterface is a mock of WorkspaceStoreInterface interface.
+type MockWorkspaceStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceStoreInterfaceMockRecorder is the mock recorder for MockWorkspaceStoreInterface.
+type MockWorkspaceStoreInterfaceMockRecorder struct {
+	mock *MockWorkspaceStoreInterface
+}
+
+// NewMockWorkspaceStoreInterface creates a new mock instance.
+func NewMockWorkspaceStoreInterface(ctrl *gomock.Controller) *MockWorkspaceStoreInterface {
+	mock := &MockWorkspaceStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceStoreInterface) EXPECT() *MockWorkspaceStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkspace mocks base method.
+func (m *MockWorkspaceStoreInterface) CreateWorkspace(c *gofr.Context, w workspace.Workspace) (workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspace", c, w)
+	ret0, _ := ret[0].(workspace.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockWorkspaceStoreInterfaceMockRecorder) CreateWorkspace(c, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockWorkspaceStoreInterface)(nil).CreateWorkspace), c, w)
+}
+
+// GetAllWorkspace mocks base method.
+func (m *MockWorkspaceStoreInterface) GetAllWorkspace(c *gofr.Context) ([]workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWorkspace", c)
+	ret0, _ := ret[0].([]workspace.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllWorkspace indicates an expected call of GetAllWorkspace.
+func (mr *MockWorkspaceStoreInterfaceMockRecorder) GetAllWorkspace(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkspace", reflect.TypeOf((*MockWorkspaceStoreInterface)(nil).GetAllWorkspace), c)
+}
+
+// GetByIDWorkspace mocks base method.
+func (m *MockWorkspaceStoreInterface) GetByIDWorkspace(c *gofr.Context, id int) (workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDWorkspace", c, id)
+	ret0, _ := ret[0].(workspace.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDWorkspace indicates an expected call of GetByIDWorkspace.
+func (mr *MockWorkspaceStoreInterfaceMockRecorder) GetByIDWorkspace(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWorkspace", reflect.TypeOf((*MockWorkspaceStoreInterface)(nil).GetByIDWorkspace), c, id)
+}
+
+// MockAccounts is a mock of Accounts interface.
+type MockAccounts struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountsMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountsMockRecorder is the mock recorder for MockAccounts.
+type MockAccountsMockRecorder struct {
+	mock *MockAccounts
+}
+
+// NewMockAccounts creates a new mock instance.
+func NewMockAccounts(ctrl *gomock.Controller) *MockAccounts {
+	mock := &MockAccounts{ctrl: ctrl}
+	mock.recorder = &MockAccountsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccounts) EXPECT() *MockAccountsMockRecorder {
+	return m.recorder
+}
+
+// CreateAdmin mocks base method.
+func (m *MockAccounts) CreateAdmin(c *gofr.Context, u user.User) (user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", c, u)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockAccountsMockRecorder) CreateAdmin(c, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockAccounts)(nil).CreateAdmin), c, u)
+}
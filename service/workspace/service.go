@@ -0,0 +1,87 @@
+package workspace
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/internal/svcutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type WorkspaceService struct {
+	str      WorkspaceStoreInterface
+	accounts Accounts
+	now      func() time.Time
+}
+
+// Option configures optional collaborators of WorkspaceService.
+type Option func(*WorkspaceService)
+
+// WithClock replaces the clock used to stamp new workspaces.
+func WithClock(now func() time.Time) Option {
+	return func(s *WorkspaceService) {
+		s.now = now
+	}
+}
+
+func NewService(s WorkspaceStoreInterface, a Accounts, opts ...Option) *WorkspaceService {
+	svc := &WorkspaceService{
+		str:      s,
+		accounts: a,
+		now:      svcutil.Now,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
+}
+
+// Create adds a workspace along with its first admin, all or none of them. The admin manages the users of the
+// workspace from then on.
+func (s *WorkspaceService) Create(c *gofr.Context, sg workspace.Signup) (workspace.Signup, error) {
+	if err := sg.Validate(); err != nil {
+		return workspace.Signup{}, err
+	}
+
+	sg.ID = 0
+	sg.CreatedAt = s.now()
+
+	err := sqlutil.InTx(c, func(c *gofr.Context) error {
+		w, err := s.str.CreateWorkspace(c, sg.Workspace)
+		if err != nil {
+			return err
+		}
+
+		sg.Workspace = w
+
+		sg.Admin, err = s.accounts.CreateAdmin(workspace.In(c, w.ID), sg.Admin)
+
+		var v errs.Validation
+		if errors.As(err, &v) {
+			v.Field = "admin." + v.Field
+
+			return v
+		}
+
+		return err
+	})
+	if err != nil {
+		return workspace.Signup{}, err
+	}
+
+	return sg, nil
+}
+
+// Current returns the workspace the request runs in.
+func (s *WorkspaceService) Current(c *gofr.Context) (workspace.Workspace, error) {
+	return s.str.GetByIDWorkspace(c, workspace.ID(c))
+}
+
+// All returns every workspace, for jobs run in each of them.
+func (s *WorkspaceService) All(c *gofr.Context) ([]workspace.Workspace, error) {
+	return s.str.GetAllWorkspace(c)
+}
@@ -0,0 +1,136 @@
+package workspace
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+// stamp is the time of fixedClock, the clock of the services under test
+var (
+	stamp      = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	fixedClock = WithClock(func() time.Time { return stamp })
+)
+
+func Test_Create(t *testing.T) {
+	alice := user.User{Name: "Alice", Email: "alice@example.com", Password: "correct horse"}
+	admin := user.User{ID: 1, Name: "Alice", Email: "alice@example.com", Role: user.RoleAdmin, Version: 1}
+
+	tests := []struct {
+		name     string
+		input    workspace.Signup
+		ifStore  bool
+		storeErr error
+		ifAdmin  bool
+		adminErr error
+		expErr   error
+	}{
+		{name: "Valid Workspace", input: workspace.Signup{Workspace: workspace.Workspace{ID: 7, Name: "Acme"}, Admin: alice},
+			ifStore: true, ifAdmin: true},
+		{name: "Missing Name", input: workspace.Signup{Workspace: workspace.Workspace{Name: "  "}, Admin: alice},
+			expErr: errs.Validation{Field: "name", Reason: "must not be empty"}},
+		{name: "Store Error", input: workspace.Signup{Workspace: workspace.Workspace{Name: "Acme"}, Admin: alice},
+			ifStore: true, storeErr: errors.New("db down"), expErr: errors.New("db down")},
+		{name: "Invalid Admin", input: workspace.Signup{Workspace: workspace.Workspace{Name: "Acme"}},
+			ifStore: true, ifAdmin: true, adminErr: errs.Validation{Field: "name", Reason: "must not be empty"},
+			expErr: errs.Validation{Field: "admin.name", Reason: "must not be empty"}},
+	}
+
+	for _, tt := range tests {
+		ctrl := gomock.NewController(t)
+
+		mockStore := NewMockWorkspaceStoreInterface(ctrl)
+		mockAccounts := NewMockAccounts(ctrl)
+
+		service := NewService(mockStore, mockAccounts, fixedClock)
+
+		mockContainer, mock := container.NewMockContainer(t)
+
+		ctx := &gofr.Context{
+			Container: mockContainer,
+		}
+
+		if tt.ifStore {
+			mock.SQL.ExpectBegin()
+
+			// the id is assigned by the store, whatever the request says
+			mockStore.EXPECT().CreateWorkspace(gomock.Any(), workspace.Workspace{Name: "Acme", CreatedAt: stamp}).
+				Return(workspace.Workspace{ID: 2, Name: "Acme", CreatedAt: stamp}, tt.storeErr)
+		}
+
+		if tt.ifAdmin {
+			// the admin is created in the new workspace, in the transaction creating it
+			mockAccounts.EXPECT().CreateAdmin(gomock.Cond(func(c *gofr.Context) bool { return workspace.ID(c) == 2 }),
+				tt.input.Admin).Return(admin, tt.adminErr)
+		}
+
+		if tt.ifStore && tt.expErr == nil {
+			mock.SQL.ExpectCommit()
+		} else if tt.ifStore {
+			mock.SQL.ExpectRollback()
+		}
+
+		res, err := service.Create(ctx, tt.input)
+
+		assert.Equal(t, tt.expErr, err, tt.name)
+
+		if tt.expErr == nil {
+			assert.Equal(t, workspace.Signup{Workspace: workspace.Workspace{ID: 2, Name: "Acme", CreatedAt: stamp}, Admin: admin},
+				res, tt.name)
+		}
+	}
+}
+
+func Test_Current(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockWorkspaceStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := workspace.In(&gofr.Context{
+		Container: mockContainer,
+	}, 2)
+
+	mockStore.EXPECT().GetByIDWorkspace(ctx, 2).Return(workspace.Workspace{ID: 2, Name: "Acme"}, nil)
+
+	res, err := service.Current(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", res.Name)
+
+	mockStore.EXPECT().GetByIDWorkspace(ctx, 2).Return(workspace.Workspace{}, errs.NotFound{Entity: "workspace", ID: 2})
+
+	_, err = service.Current(ctx)
+	assert.Equal(t, errs.NotFound{Entity: "workspace", ID: 2}, err)
+}
+
+func Test_All(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStore := NewMockWorkspaceStoreInterface(ctrl)
+
+	service := NewService(mockStore, nil, fixedClock)
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	all := []workspace.Workspace{{ID: 1, Name: "Default"}, {ID: 2, Name: "Acme"}}
+
+	mockStore.EXPECT().GetAllWorkspace(ctx).Return(all, nil)
+
+	res, err := service.All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, all, res)
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"io/fs"
+	"path"
+)
+
+// Local keeps attachment contents in a directory of the file store of the app, the local file system unless
+// another file store is added.
+type Local struct {
+	dir string
+}
+
+// NewLocal returns a storage that keeps contents under dir.
+func NewLocal(dir string) *Local {
+	return &Local{dir: dir}
+}
+
+func (l *Local) Put(c *gofr.Context, key string, content []byte) error {
+	name := path.Join(l.dir, key)
+
+	if err := c.File.MkdirAll(path.Dir(name), 0o750); err != nil {
+		return err
+	}
+
+	f, err := c.File.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
+
+func (l *Local) Get(c *gofr.Context, key string) ([]byte, error) {
+	f, err := c.File.Open(path.Join(l.dir, key))
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+// Delete removes the content stored under key. Content that is already gone is not an error.
+func (l *Local) Delete(c *gofr.Context, key string) error {
+	err := c.File.Remove(path.Join(l.dir, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+	"gofr.dev/pkg/gofr"
+	"io/fs"
+	"sync"
+)
+
+// Memory keeps attachment contents in memory, for tests and local runs.
+type Memory struct {
+	mu       sync.Mutex
+	contents map[string][]byte
+}
+
+// NewMemory returns an empty in-memory storage.
+func NewMemory() *Memory {
+	return &Memory{contents: make(map[string][]byte)}
+}
+
+func (m *Memory) Put(_ *gofr.Context, key string, content []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.contents[key] = append([]byte(nil), content...)
+
+	return nil
+}
+
+func (m *Memory) Get(_ *gofr.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	content, ok := m.contents[key]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", key, fs.ErrNotExist)
+	}
+
+	return append([]byte(nil), content...), nil
+}
+
+func (m *Memory) Delete(_ *gofr.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.contents, key)
+
+	return nil
+}
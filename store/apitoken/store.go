@@ -0,0 +1,169 @@
+package apitoken
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strings"
+	"time"
+)
+
+// Store keeps API tokens in the workspace of the request, except GetByPrefixAPIToken, which finds the token of a
+// request before its workspace is known.
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanAPIToken = errors.New("scan api token failed")
+
+// tokenColumns are the columns read into an apitoken.Token, in the order of scanToken
+const tokenColumns = "id, workspace_id, user_id, name, prefix, token_hash, scopes, expires_at, last_used_at, created_at " +
+	"FROM api_tokens"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanToken reads a row of tokenColumns, splitting the scopes kept comma separated
+func scanToken(row scanner) (apitoken.Token, error) {
+	var (
+		t      apitoken.Token
+		scopes string
+	)
+
+	err := row.Scan(&t.ID, &t.WorkspaceID, &t.UserID, &t.Name, &t.Prefix, &t.Hash, &scopes, &t.ExpiresAt, &t.LastUsedAt, &t.CreatedAt)
+	if err != nil {
+		return t, err
+	}
+
+	t.Scopes = []apitoken.Scope{}
+
+	for _, s := range strings.Split(scopes, ",") {
+		if s != "" {
+			t.Scopes = append(t.Scopes, apitoken.Scope(s))
+		}
+	}
+
+	return t, nil
+}
+
+func joinScopes(scopes []apitoken.Scope) string {
+	s := make([]string, len(scopes))
+
+	for i := range scopes {
+		s[i] = string(scopes[i])
+	}
+
+	return strings.Join(s, ",")
+}
+
+// CreateAPIToken inserts a new token into the database
+func (*Store) CreateAPIToken(c *gofr.Context, t apitoken.Token) (apitoken.Token, error) {
+	DB := sqlutil.DB(c)
+
+	t.WorkspaceID = workspace.ID(c)
+
+	res, err := DB.Exec("INSERT INTO api_tokens (workspace_id, user_id, name, prefix, token_hash, scopes, expires_at, created_at) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?)", t.WorkspaceID, t.UserID, t.Name, t.Prefix, t.Hash, joinScopes(t.Scopes), t.ExpiresAt,
+		t.CreatedAt)
+	if err != nil {
+		return t, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return t, err
+	}
+
+	t.ID = int(id)
+
+	return t, nil
+}
+
+// GetByIDAPIToken fetches a token by its ID
+func (*Store) GetByIDAPIToken(c *gofr.Context, id int) (apitoken.Token, error) {
+	DB := sqlutil.DB(c)
+
+	t, err := scanToken(DB.QueryRow("SELECT "+tokenColumns+" WHERE id = ? AND workspace_id = ?", id, workspace.ID(c)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, errs.NotFound{Entity: "api token", ID: id}
+	}
+
+	return t, err
+}
+
+// GetByPrefixAPIToken fetches the token starting with prefix, in whichever workspace it is
+func (*Store) GetByPrefixAPIToken(c *gofr.Context, prefix string) (apitoken.Token, error) {
+	DB := sqlutil.DB(c)
+
+	t, err := scanToken(DB.QueryRow("SELECT "+tokenColumns+" WHERE prefix = ?", prefix))
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, errs.NotFound{Entity: "api token"}
+	}
+
+	return t, err
+}
+
+// GetByUserIDAPIToken returns the tokens of a user, newest first
+func (*Store) GetByUserIDAPIToken(c *gofr.Context, userID int) ([]apitoken.Token, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+tokenColumns+" WHERE user_id = ? AND workspace_id = ? ORDER BY created_at DESC, id DESC",
+		userID, workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tokens := []apitoken.Token{}
+
+	for rows.Next() {
+		t, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanAPIToken, err)
+		}
+
+		tokens = append(tokens, t)
+	}
+
+	return tokens, rows.Err()
+}
+
+// TouchAPIToken records that a token was used at the given time
+func (*Store) TouchAPIToken(c *gofr.Context, id int, at time.Time) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("UPDATE api_tokens SET last_used_at = ? WHERE id = ?", at, id)
+
+	return err
+}
+
+// DeleteAPIToken removes a token, revoking it
+func (*Store) DeleteAPIToken(c *gofr.Context, id int) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("DELETE FROM api_tokens WHERE id = ? AND workspace_id = ?", id, workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "api token", ID: id}
+	}
+
+	return nil
+}
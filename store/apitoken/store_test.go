@@ -0,0 +1,255 @@
+package apitoken
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/apitoken"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+var cols = []string{"id", "workspace_id", "user_id", "name", "prefix", "token_hash", "scopes", "expires_at", "last_used_at", "created_at"}
+
+const selectToken = "SELECT id, workspace_id, user_id, name, prefix, token_hash, scopes, expires_at, last_used_at, created_at FROM api_tokens"
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, errors.New("RowsAffected failed")
+}
+
+func Test_CreateAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	tk := apitoken.Token{UserID: 3, Name: "CI", Prefix: "gft_0123456789ab", Hash: "digest",
+		Scopes: []apitoken.Scope{apitoken.ScopeTasksRead, apitoken.ScopeTasksWrite}, CreatedAt: stamp}
+	insert := "INSERT INTO api_tokens (workspace_id, user_id, name, prefix, token_hash, scopes, expires_at, created_at) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read,tasks:write", tk.ExpiresAt, stamp).
+		WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateAPIToken(ctx, tk); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read,tasks:write", tk.ExpiresAt, stamp).
+		WillReturnResult(badResult{})
+
+	if _, err := str.CreateAPIToken(ctx, tk); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read,tasks:write", tk.ExpiresAt, stamp).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	res, err := str.CreateAPIToken(ctx, tk)
+	if err != nil || res.ID != 4 || res.WorkspaceID != 1 {
+		t.Errorf("expected token 4, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := selectToken + " WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(9, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDAPIToken(ctx, 9); err != (errs.NotFound{Entity: "api token", ID: 9}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	// a token of another workspace is not found
+	mock.SQL.ExpectQuery(query).WithArgs(4, 2).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDAPIToken(workspace.In(ctx, 2), 4); err != (errs.NotFound{Entity: "api token", ID: 4}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(4, 1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read", stamp, nil, stamp))
+
+	tk, err := str.GetByIDAPIToken(ctx, 4)
+	if err != nil || tk.UserID != 3 || tk.ExpiresAt == nil || tk.LastUsedAt != nil ||
+		!reflect.DeepEqual(tk.Scopes, []apitoken.Scope{apitoken.ScopeTasksRead}) {
+		t.Errorf("unexpected token: %+v, %v", tk, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByPrefixAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := selectToken + " WHERE prefix = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs("gft_000000000000").WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByPrefixAPIToken(ctx, "gft_000000000000"); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs("gft_0123456789ab").WillReturnError(errors.New("db down"))
+
+	if _, err := str.GetByPrefixAPIToken(ctx, "gft_0123456789ab"); err == nil || errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected the store error, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs("gft_0123456789ab").
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(4, 2, 3, "CI", "gft_0123456789ab", "digest", "users:read,users:admin", nil, stamp,
+			stamp))
+
+	tk, err := str.GetByPrefixAPIToken(ctx, "gft_0123456789ab")
+	if err != nil || tk.ID != 4 || tk.WorkspaceID != 2 || tk.Hash != "digest" || tk.ExpiresAt != nil ||
+		!reflect.DeepEqual(tk.Scopes, []apitoken.Scope{apitoken.ScopeUsersRead, apitoken.ScopeUsersAdmin}) {
+		t.Errorf("unexpected token: %+v, %v", tk, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByUserIDAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := selectToken + " WHERE user_id = ? AND workspace_id = ? ORDER BY created_at DESC, id DESC"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetByUserIDAPIToken(ctx, 3); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", 1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read", nil, nil, stamp))
+
+	if _, err := str.GetByUserIDAPIToken(ctx, 3); !errors.Is(err, ErrScanAPIToken) {
+		t.Errorf("expected ErrScanAPIToken, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(5, 1, 3, "Deploy", "gft_ba9876543210", "other", "tasks:write", nil, nil, stamp).
+			AddRow(4, 1, 3, "CI", "gft_0123456789ab", "digest", "tasks:read", nil, nil, stamp))
+
+	tokens, err := str.GetByUserIDAPIToken(ctx, 3)
+	if err != nil || len(tokens) != 2 || tokens[0].ID != 5 || tokens[1].Name != "CI" {
+		t.Errorf("unexpected tokens: %+v, %v", tokens, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_TouchAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "UPDATE api_tokens SET last_used_at = ? WHERE id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs(stamp, 4).WillReturnError(errors.New("Update failed"))
+
+	if err := str.TouchAPIToken(ctx, 4, stamp); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(stamp, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.TouchAPIToken(ctx, 4, stamp); err != nil {
+		t.Errorf("touch api token fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteAPIToken(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "DELETE FROM api_tokens WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnError(errors.New("Delete failed"))
+
+	if err := str.DeleteAPIToken(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnResult(badResult{})
+
+	if err := str.DeleteAPIToken(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(9, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteAPIToken(ctx, 9); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteAPIToken(ctx, 4); err != nil {
+		t.Errorf("delete api token fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
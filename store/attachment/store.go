@@ -0,0 +1,154 @@
+package attachment
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"time"
+)
+
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanAttachment = errors.New("scan attachment failed")
+
+// attachmentColumns are the columns read into an attachment.Attachment, in the order of attachmentFields
+const attachmentColumns = "id, task_id, filename, size, content_type, checksum, storage_key, created_at"
+
+// attachmentFields are the scan destinations of attachmentColumns
+func attachmentFields(a *attachment.Attachment) []any {
+	return []any{&a.ID, &a.TaskID, &a.Filename, &a.Size, &a.ContentType, &a.Checksum, &a.StorageKey, &a.CreatedAt}
+}
+
+// CreateAttachment inserts the metadata of a new attachment into the database
+func (*Store) CreateAttachment(c *gofr.Context, a attachment.Attachment) (attachment.Attachment, error) {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("INSERT INTO attachments (task_id, filename, size, content_type, checksum, storage_key, created_at) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?)", a.TaskID, a.Filename, a.Size, a.ContentType, a.Checksum, a.StorageKey, a.CreatedAt)
+	if err != nil {
+		return a, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return a, err
+	}
+
+	a.ID = int(id)
+
+	return a, nil
+}
+
+// GetByIDAttachment fetches the metadata of an attachment by its ID
+func (*Store) GetByIDAttachment(c *gofr.Context, id int) (attachment.Attachment, error) {
+	DB := sqlutil.DB(c)
+
+	var a attachment.Attachment
+
+	err := DB.QueryRow("SELECT "+attachmentColumns+" FROM attachments WHERE id = ?", id).Scan(attachmentFields(&a)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return a, errs.NotFound{Entity: "attachment", ID: id}
+	}
+
+	return a, err
+}
+
+// GetByTaskIDAttachment returns the metadata of the attachments of a task, oldest first
+func (*Store) GetByTaskIDAttachment(c *gofr.Context, taskID int) ([]attachment.Attachment, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+attachmentColumns+" FROM attachments WHERE task_id = ? ORDER BY id", taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAttachments(rows)
+}
+
+// DeleteAttachment removes the metadata of an attachment
+func (*Store) DeleteAttachment(c *gofr.Context, id int) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("DELETE FROM attachments WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "attachment", ID: id}
+	}
+
+	return nil
+}
+
+// PurgeAttachment removes the metadata of the attachments of the tasks of the workspace trashed before the given time
+// and returns it, for their content to be removed too
+func (*Store) PurgeAttachment(c *gofr.Context, before time.Time) ([]attachment.Attachment, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := trashedAttachments(tx, workspace.ID(c), before)
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return nil, err
+	}
+
+	for _, a := range purged {
+		if _, err := tx.Exec("DELETE FROM attachments WHERE id = ?", a.ID); err != nil {
+			sqlutil.Rollback(c, tx)
+
+			return nil, err
+		}
+	}
+
+	return purged, tx.Commit()
+}
+
+// trashedAttachments locks and returns the attachments of the tasks of a workspace trashed before the given time
+func trashedAttachments(tx sqlutil.Runner, ws int, before time.Time) ([]attachment.Attachment, error) {
+	rows, err := tx.Query("SELECT "+attachmentColumns+" FROM attachments WHERE task_id IN "+
+		"(SELECT id FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ?) ORDER BY id FOR UPDATE",
+		ws, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAttachments(rows)
+}
+
+// scanAttachments reads and closes rows of attachmentColumns
+func scanAttachments(rows *sql.Rows) ([]attachment.Attachment, error) {
+	defer rows.Close()
+
+	attachments := []attachment.Attachment{}
+
+	for rows.Next() {
+		var a attachment.Attachment
+
+		if err := rows.Scan(attachmentFields(&a)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanAttachment, err)
+		}
+
+		attachments = append(attachments, a)
+	}
+
+	return attachments, rows.Err()
+}
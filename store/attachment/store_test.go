@@ -0,0 +1,229 @@
+package attachment
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/attachment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+var cols = []string{"id", "task_id", "filename", "size", "content_type", "checksum", "storage_key", "created_at"}
+
+const checksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, errors.New("RowsAffected failed")
+}
+
+func Test_CreateAttachment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	a := attachment.Attachment{TaskID: 1, Filename: "app.log", Size: 5, ContentType: "text/plain", Checksum: checksum,
+		StorageKey: "tasks/1/ab12", CreatedAt: stamp}
+	insert := "INSERT INTO attachments (task_id, filename, size, content_type, checksum, storage_key, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp).
+		WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateAttachment(ctx, a); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp).WillReturnResult(badResult{})
+
+	if _, err := str.CreateAttachment(ctx, a); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	res, err := str.CreateAttachment(ctx, a)
+	if err != nil || res.ID != 4 {
+		t.Errorf("expected attachment 4, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDAttachment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, task_id, filename, size, content_type, checksum, storage_key, created_at FROM attachments WHERE id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(9).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDAttachment(ctx, 9); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(4, 1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp))
+
+	a, err := str.GetByIDAttachment(ctx, 4)
+	if err != nil || a.StorageKey != "tasks/1/ab12" || a.Size != 5 {
+		t.Errorf("unexpected attachment: %+v, %v", a, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByTaskIDAttachment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, task_id, filename, size, content_type, checksum, storage_key, created_at FROM attachments WHERE task_id = ? ORDER BY id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetByTaskIDAttachment(ctx, 1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", 1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp))
+
+	if _, err := str.GetByTaskIDAttachment(ctx, 1); !errors.Is(err, ErrScanAttachment) {
+		t.Errorf("expected ErrScanAttachment, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(4, 1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp).
+			AddRow(5, 1, "shot.png", 2048, "image/png", checksum, "tasks/1/cd34", stamp))
+
+	attachments, err := str.GetByTaskIDAttachment(ctx, 1)
+	if err != nil || len(attachments) != 2 || attachments[1].Filename != "shot.png" {
+		t.Errorf("unexpected attachments: %+v, %v", attachments, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteAttachment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(4).WillReturnError(errors.New("Delete failed"))
+
+	if err := str.DeleteAttachment(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(4).WillReturnResult(badResult{})
+
+	if err := str.DeleteAttachment(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(9).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteAttachment(ctx, 9); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteAttachment(ctx, 4); err != nil {
+		t.Errorf("delete attachment fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_PurgeAttachment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, task_id, filename, size, content_type, checksum, storage_key, created_at FROM attachments WHERE task_id IN " +
+		"(SELECT id FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ?) ORDER BY id FOR UPDATE"
+	trashed := mock.SQL.NewRows(cols).AddRow(4, 1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp).
+		AddRow(5, 2, "shot.png", 2048, "image/png", checksum, "tasks/2/cd34", stamp)
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.PurgeAttachment(ctx, stamp); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp).WillReturnError(errors.New("Select failed"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.PurgeAttachment(ctx, stamp); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp).WillReturnRows(trashed)
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(5).WillReturnError(errors.New("Delete failed"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.PurgeAttachment(ctx, stamp); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(4, 1, "app.log", 5, "text/plain", checksum, "tasks/1/ab12", stamp))
+	mock.SQL.ExpectExec("DELETE FROM attachments WHERE id = ?").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	purged, err := str.PurgeAttachment(ctx, stamp)
+	if err != nil || len(purged) != 1 || purged[0].StorageKey != "tasks/1/ab12" {
+		t.Errorf("unexpected attachments: %+v, %v", purged, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
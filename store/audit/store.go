@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strconv"
+)
+
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanEntry = errors.New("scan audit entry failed")
+
+// entryColumns are the columns read into an audit.Entry, in the order scanned by scanEntry
+const entryColumns = "id, entity, entity_id, action, actor_id, at, data, prev_hash, hash"
+
+// AppendAudit links an entry to the last one of the log and appends it to the workspace of the request, returning it
+// with its id and hashes. The head of the log is locked until the entry is written, so concurrent appends are chained
+// one after the other, whatever their workspace
+func (*Store) AppendAudit(c *gofr.Context, e audit.Entry) (audit.Entry, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return e, err
+	}
+
+	e, err = appendEntry(tx, workspace.ID(c), e)
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return e, err
+	}
+
+	return e, tx.Commit()
+}
+
+func appendEntry(tx sqlutil.Runner, ws int, e audit.Entry) (audit.Entry, error) {
+	var seq int
+
+	if err := tx.QueryRow("SELECT seq, hash FROM audit_head WHERE id = 1 FOR UPDATE").Scan(&seq, &e.PrevHash); err != nil {
+		return e, err
+	}
+
+	e.ID = seq + 1
+	e.Hash = e.ComputeHash()
+
+	_, err := tx.Exec("INSERT INTO audit_log (workspace_id, "+entryColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		ws, e.ID, e.Entity, e.EntityID, e.Action, e.ActorID, e.At, string(e.Data), e.PrevHash, e.Hash)
+	if err != nil {
+		return e, err
+	}
+
+	_, err = tx.Exec("UPDATE audit_head SET seq = ?, hash = ? WHERE id = 1", e.ID, e.Hash)
+
+	return e, err
+}
+
+// GetAllAudit returns one page of the entries of the workspace of the request matching the filter, oldest first unless
+// q is descending, along with the total number of matches
+func (*Store) GetAllAudit(c *gofr.Context, f audit.Filter, q page.Query) (page.Page[audit.Entry], error) {
+	DB := sqlutil.DB(c)
+
+	res := page.Page[audit.Entry]{Items: []audit.Entry{}, Limit: q.Limit, Offset: q.Offset}
+
+	conds, args := entryFilter(workspace.ID(c), f)
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM audit_log"+sqlutil.Where(conds), args...).Scan(&res.Total)
+	if err != nil {
+		return res, err
+	}
+
+	order, conds, args := sqlutil.Keyset(q, "id", conds, args)
+
+	// one row more than the page size tells whether there is a next page
+	args = append(args, q.Limit+1, q.Offset)
+
+	entries, err := queryEntries(c, "SELECT "+entryColumns+" FROM audit_log"+sqlutil.Where(conds)+" ORDER BY "+order+" LIMIT ? OFFSET ?",
+		args...)
+	if err != nil {
+		return res, err
+	}
+
+	res.Items = entries
+
+	res.Items, res.NextCursor = sqlutil.NextPage(q, res.Items, func(e audit.Entry) (string, int) {
+		return strconv.Itoa(e.ID), e.ID
+	})
+
+	return res, nil
+}
+
+// GetRangeAudit returns up to limit entries following the entry with id after, in order
+func (*Store) GetRangeAudit(c *gofr.Context, after, limit int) ([]audit.Entry, error) {
+	return queryEntries(c, "SELECT "+entryColumns+" FROM audit_log WHERE id > ? ORDER BY id LIMIT ?", after, limit)
+}
+
+// GetHeadAudit returns the number and hash of the last entry appended, as recorded in the head of the log
+func (*Store) GetHeadAudit(c *gofr.Context) (seq int, hash string, err error) {
+	DB := sqlutil.DB(c)
+
+	err = DB.QueryRow("SELECT seq, hash FROM audit_head WHERE id = 1").Scan(&seq, &hash)
+
+	return seq, hash, err
+}
+
+func queryEntries(c *gofr.Context, query string, args ...any) ([]audit.Entry, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := []audit.Entry{}
+
+	for rows.Next() {
+		var (
+			e    audit.Entry
+			data string
+		)
+
+		if err := rows.Scan(&e.ID, &e.Entity, &e.EntityID, &e.Action, &e.ActorID, &e.At, &data, &e.PrevHash, &e.Hash); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanEntry, err)
+		}
+
+		e.Data = json.RawMessage(data)
+
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+func entryFilter(ws int, f audit.Filter) ([]string, []any) {
+	conds, args := []string{"workspace_id = ?"}, []any{ws}
+
+	if f.Entity != "" {
+		conds = append(conds, "entity = ?")
+		args = append(args, f.Entity)
+	}
+
+	if f.EntityID != 0 {
+		conds = append(conds, "entity_id = ?")
+		args = append(args, f.EntityID)
+	}
+
+	if f.ActorID != 0 {
+		conds = append(conds, "actor_id = ?")
+		args = append(args, f.ActorID)
+	}
+
+	if f.Action != "" {
+		conds = append(conds, "action = ?")
+		args = append(args, f.Action)
+	}
+
+	return conds, args
+}
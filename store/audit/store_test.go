@@ -0,0 +1,192 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/audit"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+var (
+	stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	cols  = []string{"id", "entity", "entity_id", "action", "actor_id", "at", "data", "prev_hash", "hash"}
+)
+
+const (
+	headQuery = "SELECT seq, hash FROM audit_head WHERE id = 1 FOR UPDATE"
+	insert    = "INSERT INTO audit_log (workspace_id, id, entity, entity_id, action, actor_id, at, data, prev_hash, hash) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+)
+
+func Test_AppendAudit(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	prev := audit.Entry{ID: 4, Entity: audit.EntityUser, EntityID: 2, Action: "created", At: stamp, Data: json.RawMessage(`{}`),
+		PrevHash: audit.Genesis}
+	prev.Hash = prev.ComputeHash()
+
+	e := audit.Entry{Entity: audit.EntityTask, EntityID: 1, Action: "deleted", At: stamp, Data: json.RawMessage(`{"before":null}`)}
+
+	linked := e
+	linked.ID, linked.PrevHash = 5, prev.Hash
+	linked.Hash = linked.ComputeHash()
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.AppendAudit(ctx, e); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(headQuery).WillReturnRows(mock.SQL.NewRows([]string{"seq", "hash"}).AddRow(4, prev.Hash))
+	mock.SQL.ExpectExec(insert).WithArgs(1, 5, "task", 1, "deleted", nil, stamp, `{"before":null}`, prev.Hash, linked.Hash).
+		WillReturnError(errors.New("Duplicate entry"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.AppendAudit(ctx, e); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(headQuery).WillReturnRows(mock.SQL.NewRows([]string{"seq", "hash"}).AddRow(4, prev.Hash))
+	mock.SQL.ExpectExec(insert).WithArgs(1, 5, "task", 1, "deleted", nil, stamp, `{"before":null}`, prev.Hash, linked.Hash).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.SQL.ExpectExec("UPDATE audit_head SET seq = ?, hash = ? WHERE id = 1").WithArgs(5, linked.Hash).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.AppendAudit(ctx, e)
+	if err != nil || res.ID != 5 || res.PrevHash != prev.Hash || res.Hash != linked.Hash {
+		t.Errorf("unexpected entry: %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllAudit(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	f := audit.Filter{Entity: audit.EntityTask, EntityID: 1, ActorID: 7, Action: "updated"}
+	q := page.Query{Limit: 1, Sort: "id", Order: page.OrderDesc}
+	conds := " WHERE workspace_id = ? AND entity = ? AND entity_id = ? AND actor_id = ? AND action = ?"
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM audit_log"+conds).WithArgs(1, "task", 1, 7, "updated").
+		WillReturnError(errors.New("Count failed"))
+
+	if _, err := str.GetAllAudit(ctx, f, q); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM audit_log"+conds).WithArgs(1, "task", 1, 7, "updated").
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery("SELECT id, entity, entity_id, action, actor_id, at, data, prev_hash, hash FROM audit_log"+conds+
+		" ORDER BY id DESC LIMIT ? OFFSET ?").WithArgs(1, "task", 1, 7, "updated", 2, 0).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(9, "task", 1, "updated", 7, stamp, `{"after":{}}`, "a", "b").
+			AddRow(3, "task", 1, "updated", 7, stamp, `{"after":{}}`, "c", "d"))
+
+	first, err := str.GetAllAudit(ctx, f, q)
+	if err != nil || len(first.Items) != 1 || first.Items[0].ID != 9 || first.Total != 2 || first.NextCursor == "" ||
+		string(first.Items[0].Data) != `{"after":{}}` {
+		t.Fatalf("unexpected first page: %+v, %v", first, err)
+	}
+
+	next, err := page.NewQuery("1", "", first.NextCursor, "", "desc", "id")
+	if err != nil {
+		t.Fatalf("next cursor rejected: %v", err)
+	}
+
+	// only the entries of the workspace of the request are read
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM audit_log WHERE workspace_id = ?").WithArgs(2).
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery("SELECT id, entity, entity_id, action, actor_id, at, data, prev_hash, hash FROM audit_log "+
+		"WHERE workspace_id = ? AND id < ? ORDER BY id DESC LIMIT ? OFFSET ?").WithArgs(2, 9, 2, 0).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(3, "task", 1, "updated", 7, stamp, `{"after":{}}`, "c", "d"))
+
+	second, err := str.GetAllAudit(workspace.In(ctx, 2), audit.Filter{}, next)
+	if err != nil || len(second.Items) != 1 || second.Items[0].ID != 3 || second.NextCursor != "" {
+		t.Errorf("unexpected second page: %+v, %v", second, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetRangeAudit(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, entity, entity_id, action, actor_id, at, data, prev_hash, hash FROM audit_log WHERE id > ? ORDER BY id LIMIT ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(0, 100).WillReturnError(errors.New("Query failed"))
+
+	if _, err := str.GetRangeAudit(ctx, 0, 100); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(0, 100).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", "task", 1, "updated", nil, stamp, `{}`, "a", "b"))
+
+	if _, err := str.GetRangeAudit(ctx, 0, 100); !errors.Is(err, ErrScanEntry) {
+		t.Errorf("expected ErrScanEntry, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 100).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(2, "user", 3, "created", nil, stamp, `{}`, "a", "b"))
+
+	entries, err := str.GetRangeAudit(ctx, 1, 100)
+	if err != nil || len(entries) != 1 || entries[0].ActorID != nil || entries[0].Entity != "user" {
+		t.Errorf("unexpected entries: %+v, %v", entries, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetHeadAudit(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	mock.SQL.ExpectQuery("SELECT seq, hash FROM audit_head WHERE id = 1").
+		WillReturnRows(mock.SQL.NewRows([]string{"seq", "hash"}).AddRow(5, "b"))
+
+	seq, hash, err := str.GetHeadAudit(ctx)
+	if err != nil || seq != 5 || hash != "b" {
+		t.Errorf("unexpected head: %d, %s, %v", seq, hash, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
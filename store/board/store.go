@@ -0,0 +1,271 @@
+package board
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// Store keeps the boards of the workspace of the request, along with their columns.
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanBoard = errors.New("scan board failed")
+
+// boardColumns are the columns read into a board.Board, in the order of boardFields
+const boardColumns = "id, name, project_id, version, created_at, updated_at"
+
+// boardFields are the scan destinations of boardColumns
+func boardFields(b *board.Board) []any {
+	return []any{&b.ID, &b.Name, &b.ProjectID, &b.Version, &b.CreatedAt, &b.UpdatedAt}
+}
+
+// CreateBoard inserts a new board along with its columns, all in one transaction
+func (*Store) CreateBoard(c *gofr.Context, b board.Board) (board.Board, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return b, err
+	}
+
+	res, err := tx.Exec("INSERT INTO boards (workspace_id, name, project_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		workspace.ID(c), b.Name, b.ProjectID, b.CreatedAt, b.UpdatedAt)
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return b, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return b, err
+	}
+
+	b.ID = int(id)
+	b.Version = 1
+
+	if b.Columns, err = insertColumns(tx, b.ID, b.Columns); err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return b, err
+	}
+
+	return b, tx.Commit()
+}
+
+// GetByIDBoard fetches a board by its ID, along with its columns
+func (*Store) GetByIDBoard(c *gofr.Context, id int) (board.Board, error) {
+	DB := sqlutil.DB(c)
+
+	var b board.Board
+
+	err := DB.QueryRow("SELECT "+boardColumns+" FROM boards WHERE id = ? AND workspace_id = ?", id, workspace.ID(c)).
+		Scan(boardFields(&b)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return b, errs.NotFound{Entity: "board", ID: id}
+	}
+
+	if err != nil {
+		return b, err
+	}
+
+	columns, err := getColumns(c, id)
+	if err != nil {
+		return b, err
+	}
+
+	b.Columns = columns[id]
+
+	return b, nil
+}
+
+// GetByColumnIDBoard fetches the board a column belongs to
+func (s *Store) GetByColumnIDBoard(c *gofr.Context, columnID int) (board.Board, error) {
+	DB := sqlutil.DB(c)
+
+	var id int
+
+	err := DB.QueryRow("SELECT b.id FROM board_columns bc JOIN boards b ON b.id = bc.board_id WHERE bc.id = ? AND b.workspace_id = ?",
+		columnID, workspace.ID(c)).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return board.Board{}, errs.NotFound{Entity: "column", ID: columnID}
+	}
+
+	if err != nil {
+		return board.Board{}, err
+	}
+
+	return s.GetByIDBoard(c, id)
+}
+
+// GetAllBoard returns every board, in name order, along with their columns
+func (*Store) GetAllBoard(c *gofr.Context) ([]board.Board, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+boardColumns+" FROM boards WHERE workspace_id = ? ORDER BY name, id", workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	boards := []board.Board{}
+
+	for rows.Next() {
+		var b board.Board
+
+		if err := rows.Scan(boardFields(&b)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanBoard, err)
+		}
+
+		boards = append(boards, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	columns, err := getColumns(c, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range boards {
+		boards[i].Columns = columns[boards[i].ID]
+	}
+
+	return boards, nil
+}
+
+// UpdateBoard replaces the name, project and columns of a board if it is still at b.Version, and bumps its version.
+// The columns get new ids, which are returned along with the board
+func (*Store) UpdateBoard(c *gofr.Context, b board.Board) (board.Board, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return b, err
+	}
+
+	if b, err = updateBoard(tx, workspace.ID(c), b); err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return b, err
+	}
+
+	return b, tx.Commit()
+}
+
+func updateBoard(tx sqlutil.Runner, ws int, b board.Board) (board.Board, error) {
+	res, err := tx.Exec("UPDATE boards SET name = ?, project_id = ?, updated_at = ?, version = version + 1 "+
+		"WHERE id = ? AND version = ? AND workspace_id = ?", b.Name, b.ProjectID, b.UpdatedAt, b.ID, b.Version, ws)
+	if err := sqlutil.VersionChecked(res, err); err != nil {
+		return b, err
+	}
+
+	if _, err := tx.Exec("DELETE FROM board_columns WHERE board_id = ?", b.ID); err != nil {
+		return b, err
+	}
+
+	b.Columns, err = insertColumns(tx, b.ID, b.Columns)
+
+	return b, err
+}
+
+// DeleteBoard removes a board along with its columns, leaving the tasks it shows as they are. Unless ver is
+// version.Any the board is only removed if it is still at that version
+func (*Store) DeleteBoard(c *gofr.Context, id, ver int) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("DELETE FROM boards WHERE id = ? AND version = ? AND workspace_id = ?", id, ver, workspace.ID(c))
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := DB.Exec("DELETE FROM boards WHERE id = ? AND workspace_id = ?", id, workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "board", ID: id}
+	}
+
+	return nil
+}
+
+// insertColumns inserts the columns of a board in their order and returns them with their ids
+func insertColumns(tx sqlutil.Runner, boardID int, columns []board.Column) ([]board.Column, error) {
+	out := make([]board.Column, len(columns))
+
+	for i, col := range columns {
+		res, err := tx.Exec("INSERT INTO board_columns (board_id, position, name, status) VALUES (?, ?, ?, ?)",
+			boardID, i, col.Name, col.Status)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+
+		col.ID = int(id)
+		out[i] = col
+	}
+
+	return out, nil
+}
+
+// getColumns returns the columns of a board, or of every board of the workspace if boardID is 0, in their order, by
+// board id
+func getColumns(c *gofr.Context, boardID int) (map[int][]board.Column, error) {
+	DB := sqlutil.DB(c)
+
+	query, args := "SELECT bc.board_id, bc.id, bc.name, bc.status FROM board_columns bc JOIN boards b ON b.id = bc.board_id "+
+		"WHERE b.workspace_id = ?", []any{workspace.ID(c)}
+
+	if boardID != 0 {
+		query += " AND bc.board_id = ?"
+		args = append(args, boardID)
+	}
+
+	rows, err := DB.Query(query+" ORDER BY bc.board_id, bc.position", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	columns := make(map[int][]board.Column)
+
+	for rows.Next() {
+		var (
+			boardID int
+			col     board.Column
+		)
+
+		if err := rows.Scan(&boardID, &col.ID, &col.Name, &col.Status); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanBoard, err)
+		}
+
+		columns[boardID] = append(columns[boardID], col)
+	}
+
+	return columns, rows.Err()
+}
@@ -0,0 +1,269 @@
+package board
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/board"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+var (
+	boardCols  = []string{"id", "name", "project_id", "version", "created_at", "updated_at"}
+	columnCols = []string{"board_id", "id", "name", "status"}
+)
+
+const (
+	insertColumn  = "INSERT INTO board_columns (board_id, position, name, status) VALUES (?, ?, ?, ?)"
+	selectColumns = "SELECT bc.board_id, bc.id, bc.name, bc.status FROM board_columns bc JOIN boards b ON b.id = bc.board_id " +
+		"WHERE b.workspace_id = ?"
+)
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, fmt.Errorf("RowsAffected failed")
+}
+
+func Test_CreateBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	b := board.Board{Name: "Sprint", CreatedAt: stamp, UpdatedAt: stamp,
+		Columns: []board.Column{{Name: "To do", Status: task.StatusTodo}, {Name: "Done", Status: task.StatusDone}}}
+	insert := "INSERT INTO boards (workspace_id, name, project_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.CreateBoard(ctx, b); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Sprint", nil, stamp, stamp).WillReturnResult(badResult{})
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.CreateBoard(ctx, b); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Sprint", nil, stamp, stamp).WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.SQL.ExpectExec(insertColumn).WithArgs(4, 0, "To do", task.StatusTodo).WillReturnError(errors.New("Insert failed"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.CreateBoard(ctx, b); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Sprint", nil, stamp, stamp).WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.SQL.ExpectExec(insertColumn).WithArgs(4, 0, "To do", task.StatusTodo).WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.SQL.ExpectExec(insertColumn).WithArgs(4, 1, "Done", task.StatusDone).WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.CreateBoard(workspace.In(ctx, 2), b)
+	if err != nil || res.ID != 4 || res.Version != 1 || res.Columns[0].ID != 10 || res.Columns[1].ID != 11 {
+		t.Errorf("expected board 4 with columns 10 and 11, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, project_id, version, created_at, updated_at FROM boards WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDBoard(ctx, 4); err != (errs.NotFound{Entity: "board", ID: 4}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).WillReturnRows(sqlmock.NewRows(boardCols).AddRow(4, "Sprint", 7, 2, stamp, stamp))
+	mock.SQL.ExpectQuery(selectColumns+" AND bc.board_id = ? ORDER BY bc.board_id, bc.position").WithArgs(1, 4).
+		WillReturnRows(sqlmock.NewRows(columnCols).AddRow(4, 10, "To do", "todo").AddRow(4, 11, "Done", "done"))
+
+	res, err := str.GetByIDBoard(ctx, 4)
+
+	want := board.Board{ID: 4, Name: "Sprint", ProjectID: &[]int{7}[0], Version: 2, CreatedAt: stamp, UpdatedAt: stamp,
+		Columns: []board.Column{{ID: 10, Name: "To do", Status: task.StatusTodo}, {ID: 11, Name: "Done", Status: task.StatusDone}}}
+
+	if err != nil || !reflect.DeepEqual(res, want) {
+		t.Errorf("expected %+v, got %+v, %v", want, res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByColumnIDBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT b.id FROM board_columns bc JOIN boards b ON b.id = bc.board_id WHERE bc.id = ? AND b.workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(10, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByColumnIDBoard(ctx, 10); err != (errs.NotFound{Entity: "column", ID: 10}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(10, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.SQL.ExpectQuery("SELECT id, name, project_id, version, created_at, updated_at FROM boards WHERE id = ? AND workspace_id = ?").
+		WithArgs(4, 1).WillReturnRows(sqlmock.NewRows(boardCols).AddRow(4, "Sprint", nil, 1, stamp, stamp))
+	mock.SQL.ExpectQuery(selectColumns+" AND bc.board_id = ? ORDER BY bc.board_id, bc.position").WithArgs(1, 4).
+		WillReturnRows(sqlmock.NewRows(columnCols).AddRow(4, 10, "To do", "todo"))
+
+	res, err := str.GetByColumnIDBoard(ctx, 10)
+	if err != nil || res.ID != 4 || len(res.Columns) != 1 {
+		t.Errorf("expected board 4, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, project_id, version, created_at, updated_at FROM boards WHERE workspace_id = ? ORDER BY name, id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(boardCols).AddRow("x", "Sprint", nil, 1, stamp, stamp))
+
+	if _, err := str.GetAllBoard(ctx); !errors.Is(err, ErrScanBoard) {
+		t.Errorf("expected ErrScanBoard, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(boardCols).AddRow(5, "Backlog", nil, 1, stamp, stamp).AddRow(4, "Sprint", nil, 1, stamp, stamp))
+	mock.SQL.ExpectQuery(selectColumns + " ORDER BY bc.board_id, bc.position").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(columnCols).AddRow(4, 10, "To do", "todo").AddRow(4, 11, "Done", "done").
+			AddRow(5, 12, "Ideas", "todo"))
+
+	boards, err := str.GetAllBoard(ctx)
+	if err != nil || len(boards) != 2 || len(boards[0].Columns) != 1 || len(boards[1].Columns) != 2 {
+		t.Errorf("unexpected boards: %+v, %v", boards, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_UpdateBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	b := board.Board{ID: 4, Name: "Sprint", Version: 2, UpdatedAt: stamp, Columns: []board.Column{{ID: 10, Name: "Doing", Status: "in_progress"}}}
+	update := "UPDATE boards SET name = ?, project_id = ?, updated_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(update).WithArgs("Sprint", nil, stamp, 4, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.UpdateBoard(ctx, b); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(update).WithArgs("Sprint", nil, stamp, 4, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("DELETE FROM board_columns WHERE board_id = ?").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.SQL.ExpectExec(insertColumn).WithArgs(4, 0, "Doing", "in_progress").WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.UpdateBoard(ctx, b)
+	if err != nil || len(res.Columns) != 1 || res.Columns[0].ID != 12 {
+		t.Errorf("expected a new column 12, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteBoard(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	mock.SQL.ExpectExec("DELETE FROM boards WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(4, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteBoard(ctx, 4, 2); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM boards WHERE id = ? AND workspace_id = ?").WithArgs(4, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteBoard(ctx, 4, version.Any); err != (errs.NotFound{Entity: "board", ID: 4}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM boards WHERE id = ? AND workspace_id = ?").WithArgs(4, 1).WillReturnResult(badResult{})
+
+	if err := str.DeleteBoard(ctx, 4, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM boards WHERE id = ? AND workspace_id = ?").WithArgs(4, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteBoard(ctx, 4, version.Any); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package comment
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// Store reads and changes the comments on the tasks of the workspace of the request alone, which it joins tasks for:
+// comments have no workspace of their own.
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanComment = errors.New("scan comment failed")
+
+// commentColumns are the columns read into a comment.Comment, in the order of commentFields, from the comments on
+// the tasks of the workspace given as first argument. The author name comes from users, and is empty once the author
+// is deleted
+const commentColumns = "c.id, c.task_id, c.parent_id, c.author_id, COALESCE(u.name, ''), c.body, c.version, c.created_at, c.updated_at " +
+	"FROM comments c JOIN tasks t ON t.id = c.task_id AND t.workspace_id = ? LEFT JOIN users u ON u.id = c.author_id"
+
+// inWorkspace joins the comments c being changed to their task in the workspace given as first argument
+const inWorkspace = "comments c JOIN tasks t ON t.id = c.task_id AND t.workspace_id = ?"
+
+// commentFields are the scan destinations of commentColumns
+func commentFields(cm *comment.Comment) []any {
+	return []any{&cm.ID, &cm.TaskID, &cm.ParentID, &cm.AuthorID, &cm.Author, &cm.Body, &cm.Version, &cm.CreatedAt, &cm.UpdatedAt}
+}
+
+// CreateComment inserts a new comment into the database
+func (*Store) CreateComment(c *gofr.Context, cm comment.Comment) (comment.Comment, error) {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("INSERT INTO comments (task_id, parent_id, author_id, body, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		cm.TaskID, cm.ParentID, cm.AuthorID, cm.Body, cm.CreatedAt, cm.UpdatedAt)
+	if err != nil {
+		return cm, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return cm, err
+	}
+
+	cm.ID = int(id)
+	cm.Version = 1
+
+	return cm, nil
+}
+
+// GetByIDComment fetches a comment by its ID
+func (*Store) GetByIDComment(c *gofr.Context, id int) (comment.Comment, error) {
+	DB := sqlutil.DB(c)
+
+	var cm comment.Comment
+
+	err := DB.QueryRow("SELECT "+commentColumns+" WHERE c.id = ?", workspace.ID(c), id).Scan(commentFields(&cm)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cm, errs.NotFound{Entity: "comment", ID: id}
+	}
+
+	return cm, err
+}
+
+// GetByTaskIDComment returns all the comments on a task, replies included, oldest first
+func (*Store) GetByTaskIDComment(c *gofr.Context, taskID int) ([]comment.Comment, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+commentColumns+" WHERE c.task_id = ? ORDER BY c.created_at, c.id", workspace.ID(c), taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	comments := []comment.Comment{}
+
+	for rows.Next() {
+		var cm comment.Comment
+
+		if err := rows.Scan(commentFields(&cm)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanComment, err)
+		}
+
+		comments = append(comments, cm)
+	}
+
+	return comments, rows.Err()
+}
+
+// UpdateComment replaces the body of a comment if it is still at cm.Version, and bumps its version
+func (*Store) UpdateComment(c *gofr.Context, cm comment.Comment) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE "+inWorkspace+" SET c.body = ?, c.updated_at = ?, c.version = c.version + 1 "+
+		"WHERE c.id = ? AND c.version = ?", workspace.ID(c), cm.Body, cm.UpdatedAt, cm.ID, cm.Version)
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// DeleteComment removes a comment along with its replies. Unless ver is version.Any the comment is only removed
+// if it is still at that version
+func (*Store) DeleteComment(c *gofr.Context, id, ver int) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("DELETE c FROM "+inWorkspace+" WHERE c.id = ? AND c.version = ?", workspace.ID(c), id, ver)
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := DB.Exec("DELETE c FROM "+inWorkspace+" WHERE c.id = ?", workspace.ID(c), id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "comment", ID: id}
+	}
+
+	return nil
+}
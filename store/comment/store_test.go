@@ -0,0 +1,211 @@
+package comment
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/comment"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/version"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+var cols = []string{"id", "task_id", "parent_id", "author_id", "author", "body", "version", "created_at", "updated_at"}
+
+const selectComment = "SELECT c.id, c.task_id, c.parent_id, c.author_id, COALESCE(u.name, ''), c.body, c.version, c.created_at, c.updated_at " +
+	"FROM comments c JOIN tasks t ON t.id = c.task_id AND t.workspace_id = ? LEFT JOIN users u ON u.id = c.author_id"
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, errors.New("RowsAffected failed")
+}
+
+func Test_CreateComment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	author := 3
+	cm := comment.Comment{TaskID: 1, AuthorID: &author, Body: "Looks good", CreatedAt: stamp, UpdatedAt: stamp}
+	insert := "INSERT INTO comments (task_id, parent_id, author_id, body, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, cm.ParentID, cm.AuthorID, "Looks good", stamp, stamp).WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateComment(ctx, cm); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, cm.ParentID, cm.AuthorID, "Looks good", stamp, stamp).WillReturnResult(badResult{})
+
+	if _, err := str.CreateComment(ctx, cm); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, cm.ParentID, cm.AuthorID, "Looks good", stamp, stamp).WillReturnResult(sqlmock.NewResult(7, 1))
+
+	res, err := str.CreateComment(ctx, cm)
+	if err != nil || res.ID != 7 || res.Version != 1 {
+		t.Errorf("expected comment 7 at version 1, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDComment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	mock.SQL.ExpectQuery(selectComment+" WHERE c.id = ?").WithArgs(1, 9).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDComment(ctx, 9); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(selectComment+" WHERE c.id = ?").WithArgs(1, 7).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(7, 1, nil, 3, "Alice", "Looks good", 1, stamp, stamp))
+
+	cm, err := str.GetByIDComment(ctx, 7)
+	if err != nil || cm.Author != "Alice" || cm.AuthorID == nil || *cm.AuthorID != 3 {
+		t.Errorf("unexpected comment: %+v, %v", cm, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByTaskIDComment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := selectComment + " WHERE c.task_id = ? ORDER BY c.created_at, c.id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetByTaskIDComment(ctx, 1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", 1, nil, 3, "Alice", "Looks good", 1, stamp, stamp))
+
+	if _, err := str.GetByTaskIDComment(ctx, 1); !errors.Is(err, ErrScanComment) {
+		t.Errorf("expected ErrScanComment, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(7, 1, nil, 3, "Alice", "Looks good", 1, stamp, stamp).
+			AddRow(8, 1, 7, nil, "", "Thanks", 1, stamp, stamp))
+
+	comments, err := str.GetByTaskIDComment(ctx, 1)
+	if err != nil || len(comments) != 2 || *comments[1].ParentID != 7 || comments[1].AuthorID != nil {
+		t.Errorf("unexpected comments: %+v, %v", comments, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_UpdateComment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	cm := comment.Comment{ID: 7, Body: "Looks great", Version: 2, UpdatedAt: stamp}
+	query := "UPDATE comments c JOIN tasks t ON t.id = c.task_id AND t.workspace_id = ? SET c.body = ?, c.updated_at = ?, " +
+		"c.version = c.version + 1 WHERE c.id = ? AND c.version = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs(1, "Looks great", stamp, 7, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.UpdateComment(ctx, cm); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(1, "Looks great", stamp, 7, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.UpdateComment(ctx, cm); err != nil {
+		t.Errorf("update comment fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteComment(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	del := "DELETE c FROM comments c JOIN tasks t ON t.id = c.task_id AND t.workspace_id = ? WHERE c.id = ?"
+
+	mock.SQL.ExpectExec(del).WithArgs(1, 7).WillReturnError(errors.New("Delete failed"))
+
+	if err := str.DeleteComment(ctx, 7, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(del).WithArgs(1, 7).WillReturnResult(badResult{})
+
+	if err := str.DeleteComment(ctx, 7, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(del).WithArgs(1, 9).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteComment(ctx, 9, version.Any); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(del).WithArgs(1, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteComment(ctx, 7, version.Any); err != nil {
+		t.Errorf("delete comment fail: %v", err)
+	}
+
+	mock.SQL.ExpectExec(del+" AND c.version = ?").WithArgs(1, 7, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteComment(ctx, 7, 1); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
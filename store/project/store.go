@@ -0,0 +1,223 @@
+package project
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strings"
+)
+
+// Store keeps the projects of the workspace of the request, along with their members.
+type Store struct {
+	closed []task.Status
+}
+
+// NewStore returns a store counting as finished the tasks in one of the closed statuses of the task workflow, the
+// first being the one tasks are completed in.
+func NewStore(closed []task.Status) *Store {
+	return &Store{closed: closed}
+}
+
+var ErrScanProject = errors.New("scan project failed")
+
+// projectColumns are the columns read into a project.Project, in the order of projectFields
+const projectColumns = "id, name, description, version, created_at, updated_at"
+
+// projectFields are the scan destinations of projectColumns
+func projectFields(p *project.Project) []any {
+	return []any{&p.ID, &p.Name, &p.Description, &p.Version, &p.CreatedAt, &p.UpdatedAt}
+}
+
+// CreateProject inserts a new project into the database
+func (*Store) CreateProject(c *gofr.Context, p project.Project) (project.Project, error) {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("INSERT INTO projects (workspace_id, name, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		workspace.ID(c), p.Name, p.Description, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return p, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return p, err
+	}
+
+	p.ID = int(id)
+	p.Version = 1
+
+	return p, nil
+}
+
+// GetByIDProject fetches a project by its ID
+func (*Store) GetByIDProject(c *gofr.Context, id int) (project.Project, error) {
+	DB := sqlutil.DB(c)
+
+	var p project.Project
+
+	err := DB.QueryRow("SELECT "+projectColumns+" FROM projects WHERE id = ? AND workspace_id = ?", id, workspace.ID(c)).
+		Scan(projectFields(&p)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return p, errs.NotFound{Entity: "project", ID: id}
+	}
+
+	return p, err
+}
+
+// GetAllProject returns every project, in name order
+func (*Store) GetAllProject(c *gofr.Context) ([]project.Project, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+projectColumns+" FROM projects WHERE workspace_id = ? ORDER BY name, id", workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	projects := []project.Project{}
+
+	for rows.Next() {
+		var p project.Project
+
+		if err := rows.Scan(projectFields(&p)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanProject, err)
+		}
+
+		projects = append(projects, p)
+	}
+
+	return projects, rows.Err()
+}
+
+// UpdateProject replaces the name and description of a project if it is still at p.Version, and bumps its version
+func (*Store) UpdateProject(c *gofr.Context, p project.Project) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE projects SET name = ?, description = ?, updated_at = ?, version = version + 1 "+
+		"WHERE id = ? AND version = ? AND workspace_id = ?", p.Name, p.Description, p.UpdatedAt, p.ID, p.Version, workspace.ID(c))
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// DeleteProject removes a project along with its memberships, leaving its tasks in no project. Unless ver is
+// version.Any the project is only removed if it is still at that version
+func (*Store) DeleteProject(c *gofr.Context, id, ver int) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("DELETE FROM projects WHERE id = ? AND version = ? AND workspace_id = ?", id, ver, workspace.ID(c))
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := DB.Exec("DELETE FROM projects WHERE id = ? AND workspace_id = ?", id, workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "project", ID: id}
+	}
+
+	return nil
+}
+
+// AddMemberProject makes a user a member of a project. Adding a member twice is a no-op, and so is adding a user
+// of another workspace
+func (*Store) AddMemberProject(c *gofr.Context, id, userID int) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("INSERT IGNORE INTO project_members (project_id, user_id) SELECT p.id, u.id FROM projects p "+
+		"JOIN users u ON u.id = ? AND u.workspace_id = p.workspace_id WHERE p.id = ? AND p.workspace_id = ?",
+		userID, id, workspace.ID(c))
+
+	return err
+}
+
+// RemoveMemberProject removes a user from the members of a project, if they are one
+func (*Store) RemoveMemberProject(c *gofr.Context, id, userID int) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("DELETE pm FROM project_members pm JOIN projects p ON p.id = pm.project_id "+
+		"WHERE pm.project_id = ? AND pm.user_id = ? AND p.workspace_id = ?", id, userID, workspace.ID(c))
+
+	return err
+}
+
+// GetMembersProject returns the members of a project, in name order
+func (*Store) GetMembersProject(c *gofr.Context, id int) ([]user.User, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT u.id, u.name, u.email, u.role, u.version FROM users u "+
+		"JOIN project_members pm ON pm.user_id = u.id JOIN projects p ON p.id = pm.project_id "+
+		"WHERE pm.project_id = ? AND p.workspace_id = ? ORDER BY u.name, u.id", id, workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	members := []user.User{}
+
+	for rows.Next() {
+		var u user.User
+
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Role, &u.Version); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanProject, err)
+		}
+
+		members = append(members, u)
+	}
+
+	return members, rows.Err()
+}
+
+// IsMemberProject reports whether a user is a member of a project, or returns errs.NotFound if there is no such
+// project
+func (*Store) IsMemberProject(c *gofr.Context, id, userID int) (bool, error) {
+	DB := sqlutil.DB(c)
+
+	var member bool
+
+	err := DB.QueryRow("SELECT EXISTS (SELECT 1 FROM project_members WHERE project_id = p.id AND user_id = ?) "+
+		"FROM projects p WHERE p.id = ? AND p.workspace_id = ?", userID, id, workspace.ID(c)).Scan(&member)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, errs.NotFound{Entity: "project", ID: id}
+	}
+
+	return member, err
+}
+
+// StatsProject counts the live tasks of a project: all of them, the open ones and the completed ones
+func (s *Store) StatsProject(c *gofr.Context, id int) (project.Stats, error) {
+	DB := sqlutil.DB(c)
+
+	args := []any{}
+	for _, status := range s.closed {
+		args = append(args, status)
+	}
+
+	args = append(args, s.closed[0], id, workspace.ID(c))
+
+	var stats project.Stats
+
+	err := DB.QueryRow("SELECT COUNT(*), COUNT(CASE WHEN status NOT IN (?"+strings.Repeat(", ?", len(s.closed)-1)+
+		") THEN 1 END), COUNT(CASE WHEN status = ? THEN 1 END) FROM tasks "+
+		"WHERE project_id = ? AND workspace_id = ? AND deleted_at IS NULL", args...).Scan(&stats.Total, &stats.Open, &stats.Done)
+
+	return stats, err
+}
@@ -0,0 +1,332 @@
+package project
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/project"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+// closed are the closed statuses of the default workflow, done first
+var closed = []task.Status{task.StatusDone, task.StatusCancelled}
+
+var projectCols = []string{"id", "name", "description", "version", "created_at", "updated_at"}
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, fmt.Errorf("RowsAffected failed")
+}
+
+func Test_CreateProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	p := project.Project{Name: "Website", Description: "Relaunch", CreatedAt: stamp, UpdatedAt: stamp}
+	insert := "INSERT INTO projects (workspace_id, name, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Website", "Relaunch", stamp, stamp).WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateProject(ctx, p); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Website", "Relaunch", stamp, stamp).WillReturnResult(badResult{})
+
+	if _, err := str.CreateProject(ctx, p); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Website", "Relaunch", stamp, stamp).WillReturnResult(sqlmock.NewResult(4, 1))
+
+	res, err := str.CreateProject(workspace.In(ctx, 2), p)
+	if err != nil || res.ID != 4 || res.Version != 1 || res.Name != "Website" {
+		t.Errorf("expected project 4 at version 1, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT id, name, description, version, created_at, updated_at FROM projects WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(9, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDProject(ctx, 9); err != (errs.NotFound{Entity: "project", ID: 9}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).
+		WillReturnRows(mock.SQL.NewRows(projectCols).AddRow(4, "Website", "Relaunch", 2, stamp, stamp))
+
+	p, err := str.GetByIDProject(ctx, 4)
+	if err != nil || p.ID != 4 || p.Name != "Website" || p.Version != 2 || !p.CreatedAt.Equal(stamp) {
+		t.Errorf("unexpected project: %+v, %v", p, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT id, name, description, version, created_at, updated_at FROM projects WHERE workspace_id = ? ORDER BY name, id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnError(errors.New("db down"))
+
+	if _, err := str.GetAllProject(ctx); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(mock.SQL.NewRows(projectCols).AddRow("x", "Website", "", 1, stamp, stamp))
+
+	if _, err := str.GetAllProject(ctx); !errors.Is(err, ErrScanProject) {
+		t.Errorf("expected ErrScanProject, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(mock.SQL.NewRows(projectCols).
+		AddRow(5, "Mobile", "", 1, stamp, stamp).AddRow(4, "Website", "Relaunch", 1, stamp, stamp))
+
+	all, err := str.GetAllProject(ctx)
+	if err != nil || len(all) != 2 || all[1].ID != 4 {
+		t.Errorf("unexpected projects: %+v, %v", all, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_UpdateProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	p := project.Project{ID: 4, Name: "Website", Description: "Relaunch", Version: 2, UpdatedAt: stamp}
+	query := "UPDATE projects SET name = ?, description = ?, updated_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs("Website", "Relaunch", stamp, 4, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.UpdateProject(ctx, p); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs("Website", "Relaunch", stamp, 4, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.UpdateProject(ctx, p); err != nil {
+		t.Errorf("update project fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "DELETE FROM projects WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnError(errors.New("Delete failed"))
+
+	if err := str.DeleteProject(ctx, 4, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnResult(badResult{})
+
+	if err := str.DeleteProject(ctx, 4, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(9, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteProject(ctx, 9, version.Any); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(4, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteProject(ctx, 4, version.Any); err != nil {
+		t.Errorf("delete project fail: %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM projects WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(4, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteProject(ctx, 4, 1); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_Members(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("INSERT IGNORE INTO project_members (project_id, user_id) SELECT p.id, u.id FROM projects p "+
+		"JOIN users u ON u.id = ? AND u.workspace_id = p.workspace_id WHERE p.id = ? AND p.workspace_id = ?").
+		WithArgs(3, 4, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.AddMemberProject(ctx, 4, 3); err != nil {
+		t.Errorf("add member fail: %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE pm FROM project_members pm JOIN projects p ON p.id = pm.project_id "+
+		"WHERE pm.project_id = ? AND pm.user_id = ? AND p.workspace_id = ?").
+		WithArgs(4, 3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.RemoveMemberProject(ctx, 4, 3); err != nil {
+		t.Errorf("remove member fail: %v", err)
+	}
+
+	query := "SELECT u.id, u.name, u.email, u.role, u.version FROM users u JOIN project_members pm ON pm.user_id = u.id " +
+		"JOIN projects p ON p.id = pm.project_id WHERE pm.project_id = ? AND p.workspace_id = ? ORDER BY u.name, u.id"
+	cols := []string{"id", "name", "email", "role", "version"}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).WillReturnError(errors.New("db down"))
+
+	if _, err := str.GetMembersProject(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", "Ann", "ann@x.io", "member", 1))
+
+	if _, err := str.GetMembersProject(ctx, 4); !errors.Is(err, ErrScanProject) {
+		t.Errorf("expected ErrScanProject, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(4, 1).WillReturnRows(mock.SQL.NewRows(cols).
+		AddRow(3, "Ann", "ann@x.io", "member", 1).AddRow(2, "Bob", "bob@x.io", "manager", 1))
+
+	members, err := str.GetMembersProject(ctx, 4)
+	if err != nil || len(members) != 2 || members[0].ID != 3 || members[1].Role != "manager" {
+		t.Errorf("unexpected members: %+v, %v", members, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_IsMemberProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT EXISTS (SELECT 1 FROM project_members WHERE project_id = p.id AND user_id = ?) FROM projects p " +
+		"WHERE p.id = ? AND p.workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 9, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.IsMemberProject(ctx, 9, 3); err != (errs.NotFound{Entity: "project", ID: 9}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 4, 1).WillReturnRows(mock.SQL.NewRows([]string{"member"}).AddRow(false))
+
+	if ok, err := str.IsMemberProject(ctx, 4, 3); err != nil || ok {
+		t.Errorf("expected no member, got %v, %v", ok, err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(2, 4, 1).WillReturnRows(mock.SQL.NewRows([]string{"member"}).AddRow(true))
+
+	if ok, err := str.IsMemberProject(ctx, 4, 2); err != nil || !ok {
+		t.Errorf("expected a member, got %v, %v", ok, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_StatsProject(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT COUNT(*), COUNT(CASE WHEN status NOT IN (?, ?) THEN 1 END), COUNT(CASE WHEN status = ? THEN 1 END) " +
+		"FROM tasks WHERE project_id = ? AND workspace_id = ? AND deleted_at IS NULL"
+
+	mock.SQL.ExpectQuery(query).WithArgs("done", "cancelled", "done", 4, 1).WillReturnError(errors.New("db down"))
+
+	if _, err := str.StatsProject(ctx, 4); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs("done", "cancelled", "done", 4, 1).
+		WillReturnRows(mock.SQL.NewRows([]string{"total", "open", "done"}).AddRow(6, 3, 2))
+
+	s, err := str.StatsProject(ctx, 4)
+	if err != nil || s != (project.Stats{Total: 6, Open: 3, Done: 2}) {
+		t.Errorf("unexpected stats: %+v, %v", s, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// ApplyAllTask applies the changes of a bulk request in their order, all in one transaction. The first change that
+// fails rolls back those before it and is returned as a task.BatchError. Changed tasks are only written if they are
+// still at the version read
+func (*Store) ApplyAllTask(c *gofr.Context, changes []task.Change) ([]task.Task, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]task.Task, len(changes))
+
+	for i := range changes {
+		if out[i], err = applyChange(tx, workspace.ID(c), changes[i]); err != nil {
+			sqlutil.Rollback(c, tx)
+
+			return nil, task.BatchError{Index: i, Err: err}
+		}
+	}
+
+	return out, tx.Commit()
+}
+
+// applyChange writes a change and returns the task as stored
+func applyChange(db sqlutil.Runner, ws int, ch task.Change) (task.Task, error) {
+	t := ch.Task
+
+	switch ch.Action {
+	case task.BulkCreate:
+		return insertTask(db, ws, t)
+	case task.BulkComplete:
+		res, err := db.Exec("UPDATE tasks SET status = ?, updated_at = ?, version = version + 1 "+
+			"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL", t.Status, t.UpdatedAt, t.ID, t.Version, ws)
+		if err := sqlutil.VersionChecked(res, err); err != nil {
+			return t, err
+		}
+	case task.BulkDelete:
+		res, err := db.Exec("UPDATE tasks SET deleted_at = ?, version = version + 1 "+
+			"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL", t.DeletedAt, t.ID, t.Version, ws)
+		if err := sqlutil.VersionChecked(res, err); err != nil {
+			return t, err
+		}
+	case task.BulkReassign:
+		res, err := db.Exec("UPDATE tasks SET userid = ?, updated_at = ?, version = version + 1 "+
+			"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL", t.Userid, t.UpdatedAt, t.ID, t.Version, ws)
+		if err := sqlutil.VersionChecked(res, err); err != nil {
+			return t, err
+		}
+	default:
+		return t, fmt.Errorf("unknown bulk action %q", ch.Action)
+	}
+
+	t.Version++
+
+	return t, nil
+}
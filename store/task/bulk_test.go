@@ -0,0 +1,70 @@
+package task
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+const (
+	completeChange = "UPDATE tasks SET status = ?, updated_at = ?, version = version + 1 " +
+		"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL"
+	deleteChange = "UPDATE tasks SET deleted_at = ?, version = version + 1 " +
+		"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL"
+	reassignChange = "UPDATE tasks SET userid = ?, updated_at = ?, version = version + 1 " +
+		"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL"
+)
+
+var bulkChanges = []task.Change{
+	{Action: task.BulkComplete, Task: task.Task{ID: 4, Status: task.StatusDone, Version: 2, UpdatedAt: stamp}},
+	{Action: task.BulkReassign, Task: task.Task{ID: 5, Userid: 3, Version: 1, UpdatedAt: stamp}},
+	{Action: task.BulkDelete, Task: task.Task{ID: 6, Version: 7, DeletedAt: &stamp}},
+}
+
+func Test_ApplyAllTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.ApplyAllTask(ctx, bulkChanges); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(completeChange).WithArgs(task.StatusDone, stamp, 4, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec(reassignChange).WithArgs(3, stamp, 5, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectRollback()
+
+	_, err := str.ApplyAllTask(ctx, bulkChanges)
+
+	var be task.BatchError
+	if !errors.As(err, &be) || be.Index != 1 || !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected a version mismatch of change 1, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(completeChange).WithArgs(task.StatusDone, stamp, 4, 2, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec(reassignChange).WithArgs(3, stamp, 5, 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec(deleteChange).WithArgs(&stamp, 6, 7, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.ApplyAllTask(workspace.In(ctx, 2), bulkChanges)
+	if err != nil || len(res) != 3 || res[0].Version != 3 || res[1].Userid != 3 || res[2].Version != 8 {
+		t.Errorf("unexpected tasks: %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// inWorkspace is the condition keeping the rows of a table linked to tasks whose task_id is a task of the workspace
+// bound to its placeholder
+const inWorkspace = "task_id IN (SELECT id FROM tasks WHERE workspace_id = ?)"
+
+// blockedBy selects the ids of the tasks blocking the task bound to its placeholder
+const blockedBy = "SELECT blocker_id FROM task_dependencies WHERE task_id = ?"
+
+// AddBlockerTask records that a task is blocked by another one. Adding a link twice is a no-op, and so is linking tasks
+// outside of the workspace
+func (*Store) AddBlockerTask(c *gofr.Context, id, blocker int) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("INSERT IGNORE INTO task_dependencies (task_id, blocker_id) SELECT t.id, b.id FROM tasks t "+
+		"JOIN tasks b ON b.id = ? AND b.workspace_id = t.workspace_id WHERE t.id = ? AND t.workspace_id = ?",
+		blocker, id, workspace.ID(c))
+
+	return err
+}
+
+// RemoveBlockerTask removes the link between a task and one of its blockers, if any
+func (*Store) RemoveBlockerTask(c *gofr.Context, id, blocker int) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("DELETE FROM task_dependencies WHERE task_id = ? AND blocker_id = ? AND "+inWorkspace,
+		id, blocker, workspace.ID(c))
+
+	return err
+}
+
+// GetBlockersTask returns the live tasks blocking a task, in id order
+func (*Store) GetBlockersTask(c *gofr.Context, id int) ([]task.Task, error) {
+	return queryTasks(c, "SELECT "+taskColumns+" FROM tasks WHERE id IN ("+blockedBy+") AND workspace_id = ? AND deleted_at IS NULL "+
+		"ORDER BY id", id, workspace.ID(c))
+}
+
+// CountOpenBlockersTask returns how many live tasks blocking a task are not closed
+func (s *Store) CountOpenBlockersTask(c *gofr.Context, id int) (int, error) {
+	DB := sqlutil.DB(c)
+
+	cond, args := s.notClosed()
+
+	var n int
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM tasks WHERE id IN ("+blockedBy+") AND workspace_id = ? AND deleted_at IS NULL AND "+cond,
+		append([]any{id, workspace.ID(c)}, args...)...).Scan(&n)
+
+	return n, err
+}
+
+// blockerGraph selects the links reachable from a task by following blockers, trashed tasks included. UNION
+// drops the links already found, so the recursion ends even if the links form a cycle. Links never cross workspaces,
+// so only the first task is checked to be in the workspace bound to the second placeholder
+const blockerGraph = "WITH RECURSIVE graph (task_id, blocker_id) AS (" +
+	"SELECT task_id, blocker_id FROM task_dependencies WHERE task_id = ? AND " + inWorkspace + " " +
+	"UNION SELECT d.task_id, d.blocker_id FROM task_dependencies d JOIN graph g ON d.task_id = g.blocker_id) "
+
+// GetBlockerGraphTask returns the links between a task, its blockers, their blockers and so on
+func (*Store) GetBlockerGraphTask(c *gofr.Context, id int) ([]task.Dependency, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query(blockerGraph+"SELECT task_id, blocker_id FROM graph ORDER BY task_id, blocker_id", id, workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	deps := []task.Dependency{}
+
+	for rows.Next() {
+		var d task.Dependency
+
+		if err := rows.Scan(&d.TaskID, &d.BlockerID); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		deps = append(deps, d)
+	}
+
+	return deps, rows.Err()
+}
+
+// GetTransitiveBlockersTask returns the live tasks blocking a task directly or through other tasks, in id order
+func (*Store) GetTransitiveBlockersTask(c *gofr.Context, id int) ([]task.Task, error) {
+	return queryTasks(c, blockerGraph+"SELECT "+taskColumns+" FROM tasks WHERE id IN (SELECT blocker_id FROM graph) "+
+		"AND deleted_at IS NULL ORDER BY id", id, workspace.ID(c))
+}
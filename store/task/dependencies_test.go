@@ -0,0 +1,177 @@
+package task
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+)
+
+func Test_AddAndRemoveBlockerTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	insert := "INSERT IGNORE INTO task_dependencies (task_id, blocker_id) SELECT t.id, b.id FROM tasks t " +
+		"JOIN tasks b ON b.id = ? AND b.workspace_id = t.workspace_id WHERE t.id = ? AND t.workspace_id = ?"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 2, 1).WillReturnError(errors.New("Insert failed"))
+
+	if err := str.AddBlockerTask(ctx, 2, 1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.AddBlockerTask(ctx, 2, 1); err != nil {
+		t.Errorf("add blocker fail: %v", err)
+	}
+
+	// linking tasks of another workspace inserts nothing
+	mock.SQL.ExpectExec(insert).WithArgs(1, 2, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.AddBlockerTask(workspace.In(ctx, 2), 2, 1); err != nil {
+		t.Errorf("add blocker fail: %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM task_dependencies WHERE task_id = ? AND blocker_id = ? AND "+
+		"task_id IN (SELECT id FROM tasks WHERE workspace_id = ?)").WithArgs(2, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.RemoveBlockerTask(ctx, 2, 1); err != nil {
+		t.Errorf("remove blocker fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetBlockersTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT " + taskColumns + " FROM tasks WHERE id IN (SELECT blocker_id FROM task_dependencies WHERE task_id = ?) " +
+		"AND workspace_id = ? AND deleted_at IS NULL ORDER BY id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(2, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetBlockersTask(ctx, 2); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(2, 1).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, 3, nil, 0))
+
+	tasks, err := str.GetBlockersTask(ctx, 2)
+	if err != nil || len(tasks) != 1 || tasks[0].Estimate == nil || *tasks[0].Estimate != 3 {
+		t.Errorf("unexpected blockers: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_CountOpenBlockersTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE id IN (SELECT blocker_id FROM task_dependencies WHERE task_id = ?) "+
+		"AND workspace_id = ? AND deleted_at IS NULL AND status NOT IN (?, ?)").
+		WithArgs(2, 1, task.StatusDone, task.StatusCancelled).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(1))
+
+	n, err := str.CountOpenBlockersTask(ctx, 2)
+	if err != nil || n != 1 {
+		t.Errorf("expected 1 open blocker, got %d, %v", n, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetBlockerGraphTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "WITH RECURSIVE graph (task_id, blocker_id) AS (" +
+		"SELECT task_id, blocker_id FROM task_dependencies WHERE task_id = ? AND task_id IN (SELECT id FROM tasks WHERE workspace_id = ?) " +
+		"UNION SELECT d.task_id, d.blocker_id FROM task_dependencies d JOIN graph g ON d.task_id = g.blocker_id) " +
+		"SELECT task_id, blocker_id FROM graph ORDER BY task_id, blocker_id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetBlockerGraphTask(ctx, 3); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnRows(mock.SQL.NewRows([]string{"task_id", "blocker_id"}).AddRow("x", 1))
+
+	if _, err := str.GetBlockerGraphTask(ctx, 3); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected ErrScanTask, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).
+		WillReturnRows(mock.SQL.NewRows([]string{"task_id", "blocker_id"}).AddRow(2, 1).AddRow(3, 2))
+
+	deps, err := str.GetBlockerGraphTask(ctx, 3)
+	if err != nil || !reflect.DeepEqual(deps, []task.Dependency{{TaskID: 2, BlockerID: 1}, {TaskID: 3, BlockerID: 2}}) {
+		t.Errorf("unexpected graph: %v, %v", deps, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetTransitiveBlockersTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "WITH RECURSIVE graph (task_id, blocker_id) AS (" +
+		"SELECT task_id, blocker_id FROM task_dependencies WHERE task_id = ? AND task_id IN (SELECT id FROM tasks WHERE workspace_id = ?) " +
+		"UNION SELECT d.task_id, d.blocker_id FROM task_dependencies d JOIN graph g ON d.task_id = g.blocker_id) " +
+		"SELECT " + taskColumns + " FROM tasks WHERE id IN (SELECT blocker_id FROM graph) AND deleted_at IS NULL ORDER BY id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, 3, nil, 0).
+			AddRow(2, "def", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0))
+
+	tasks, err := str.GetTransitiveBlockersTask(ctx, 3)
+	if err != nil || len(tasks) != 2 || tasks[1].Estimate != nil {
+		t.Errorf("unexpected blockers: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strconv"
+)
+
+var ErrScanEvent = errors.New("scan task event failed")
+
+// CreateEventTask appends an event to the history of a task. Events keep the workspace of their task, since they
+// outlive it
+func (*Store) CreateEventTask(c *gofr.Context, e task.Event) error {
+	DB := sqlutil.DB(c)
+
+	before, err := snapshot(e.Before)
+	if err != nil {
+		return err
+	}
+
+	after, err := snapshot(e.After)
+	if err != nil {
+		return err
+	}
+
+	_, err = DB.Exec("INSERT INTO task_events (workspace_id, task_id, type, actor_id, at, before_snapshot, after_snapshot) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?)", workspace.ID(c), e.TaskID, e.Type, e.ActorID, e.At, before, after)
+
+	return err
+}
+
+// GetEventsTask returns one page of the history of a task, in the order the events were recorded unless q is
+// descending, along with the total number of events
+func (*Store) GetEventsTask(c *gofr.Context, id int, q page.Query) (page.Page[task.Event], error) {
+	DB := sqlutil.DB(c)
+
+	res := page.Page[task.Event]{Items: []task.Event{}, Limit: q.Limit, Offset: q.Offset}
+
+	conds, args := []string{"workspace_id = ?", "task_id = ?"}, []any{workspace.ID(c), id}
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM task_events"+sqlutil.Where(conds), args...).Scan(&res.Total)
+	if err != nil {
+		return res, err
+	}
+
+	order, conds, args := sqlutil.Keyset(q, "id", conds, args)
+
+	// one row more than the page size tells whether there is a next page
+	args = append(args, q.Limit+1, q.Offset)
+
+	rows, err := DB.Query("SELECT id, task_id, type, actor_id, at, before_snapshot, after_snapshot FROM task_events"+
+		sqlutil.Where(conds)+" ORDER BY "+order+" LIMIT ? OFFSET ?", args...)
+	if err != nil {
+		return res, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			e             task.Event
+			before, after []byte
+		)
+
+		if err := rows.Scan(&e.ID, &e.TaskID, &e.Type, &e.ActorID, &e.At, &before, &after); err != nil {
+			return res, fmt.Errorf("%w: %v", ErrScanEvent, err)
+		}
+
+		if e.Before, err = fromSnapshot(before); err != nil {
+			return res, fmt.Errorf("%w: %v", ErrScanEvent, err)
+		}
+
+		if e.After, err = fromSnapshot(after); err != nil {
+			return res, fmt.Errorf("%w: %v", ErrScanEvent, err)
+		}
+
+		res.Items = append(res.Items, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return res, err
+	}
+
+	res.Items, res.NextCursor = sqlutil.NextPage(q, res.Items, func(e task.Event) (string, int) {
+		return strconv.Itoa(e.ID), e.ID
+	})
+
+	return res, nil
+}
+
+// snapshot encodes a task as stored in an event, nil being stored as NULL
+func snapshot(t *task.Task) (any, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// fromSnapshot decodes a task stored in an event, NULL being read as nil
+func fromSnapshot(b []byte) (*task.Task, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	var t task.Task
+
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,113 @@
+package task
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+var eventCols = []string{"id", "task_id", "type", "actor_id", "at", "before_snapshot", "after_snapshot"}
+
+func Test_CreateEventTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	actor := 7
+	before := task.Task{ID: 1, Desc: "Work", Status: task.StatusTodo, Userid: 1, Priority: task.PriorityP2, Version: 2, CreatedAt: stamp,
+		UpdatedAt: stamp}
+	snapshot := `{"id":1,"desc":"Work","status":"todo","userid":1,"priority":"P2","comments":0,"version":2,` +
+		`"created_at":"2026-10-18T09:30:00Z","updated_at":"2026-10-18T09:30:00Z"}`
+	insert := "INSERT INTO task_events (workspace_id, task_id, type, actor_id, at, before_snapshot, after_snapshot) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, 1, task.EventDeleted, &actor, stamp, snapshot, nil).WillReturnError(errors.New("Insert failed"))
+
+	if err := str.CreateEventTask(ctx, task.Event{TaskID: 1, Type: task.EventDeleted, ActorID: &actor, At: stamp, Before: &before}); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(2, 1, task.EventCreated, nil, stamp, nil, snapshot).WillReturnResult(sqlmock.NewResult(3, 1))
+
+	if err := str.CreateEventTask(workspace.In(ctx, 2), task.Event{TaskID: 1, Type: task.EventCreated, At: stamp, After: &before}); err != nil {
+		t.Errorf("create event fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetEventsTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	count := "SELECT COUNT(*) FROM task_events WHERE workspace_id = ? AND task_id = ?"
+	query := "SELECT id, task_id, type, actor_id, at, before_snapshot, after_snapshot FROM task_events WHERE workspace_id = ? AND task_id = ? " +
+		"ORDER BY id ASC LIMIT ? OFFSET ?"
+	q := page.Query{Limit: 1, Sort: "id", Order: page.OrderAsc}
+
+	mock.SQL.ExpectQuery(count).WithArgs(1, 1).WillReturnError(errors.New("Count failed"))
+
+	if _, err := str.GetEventsTask(ctx, 1, q); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(count).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1, 2, 0).
+		WillReturnRows(mock.SQL.NewRows(eventCols).AddRow(1, 1, "created", 7, stamp, nil, "{not json"))
+
+	if _, err := str.GetEventsTask(ctx, 1, q); !errors.Is(err, ErrScanEvent) {
+		t.Errorf("expected ErrScanEvent, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(count).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1, 2, 0).
+		WillReturnRows(mock.SQL.NewRows(eventCols).AddRow(1, 1, "created", 7, stamp, nil, `{"id":1,"desc":"Work","status":"todo"}`).
+			AddRow(2, 1, "deleted", nil, stamp, `{"id":1,"desc":"Work","status":"todo"}`, nil))
+
+	first, err := str.GetEventsTask(ctx, 1, q)
+	if err != nil {
+		t.Fatalf("get events fail: %v", err)
+	}
+
+	e := first.Items[0]
+	if len(first.Items) != 1 || first.Total != 2 || first.NextCursor == "" || e.Type != task.EventCreated ||
+		*e.ActorID != 7 || e.Before != nil || e.After.Desc != "Work" {
+		t.Errorf("unexpected first page: %+v", first)
+	}
+
+	next, err := page.NewQuery("1", "", first.NextCursor, "", "", "id")
+	if err != nil {
+		t.Fatalf("next cursor rejected: %v", err)
+	}
+
+	mock.SQL.ExpectQuery(count).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery("SELECT id, task_id, type, actor_id, at, before_snapshot, after_snapshot FROM task_events "+
+		"WHERE workspace_id = ? AND task_id = ? AND id > ? ORDER BY id ASC LIMIT ? OFFSET ?").WithArgs(1, 1, 1, 2, 0).
+		WillReturnRows(mock.SQL.NewRows(eventCols).AddRow(2, 1, "deleted", nil, stamp, `{"id":1,"desc":"Work","status":"todo"}`, nil))
+
+	second, err := str.GetEventsTask(ctx, 1, next)
+	if err != nil || len(second.Items) != 1 || second.NextCursor != "" || second.Items[0].ActorID != nil ||
+		second.Items[0].After != nil {
+		t.Errorf("unexpected second page: %+v, %v", second, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
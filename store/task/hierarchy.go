@@ -0,0 +1,94 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// GetChildrenTask returns the live direct subtasks of a task, in id order
+func (*Store) GetChildrenTask(c *gofr.Context, id int) ([]task.Task, error) {
+	return queryTasks(c, "SELECT "+taskColumns+" FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL ORDER BY id",
+		id, workspace.ID(c))
+}
+
+// GetSubtreeTask returns the live descendants of a task, at any depth, in id order. A trashed subtask hides
+// its own subtasks. Subtasks are in the workspace of their parent, so only the direct ones are checked to be in the
+// workspace
+func (*Store) GetSubtreeTask(c *gofr.Context, id int) ([]task.Task, error) {
+	return queryTasks(c, "WITH RECURSIVE subtree (id) AS ("+
+		"SELECT id FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL "+
+		"UNION ALL SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL) "+
+		"SELECT "+taskColumns+" FROM tasks WHERE id IN (SELECT id FROM subtree) ORDER BY id", id, workspace.ID(c))
+}
+
+// GetAncestorsTask returns the ids of the parent, grandparent and so on of a task, trashed ones included
+func (*Store) GetAncestorsTask(c *gofr.Context, id int) ([]int, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("WITH RECURSIVE ancestors (id, depth) AS ("+
+		"SELECT parent_id, 1 FROM tasks WHERE id = ? AND workspace_id = ? AND parent_id IS NOT NULL "+
+		"UNION ALL SELECT t.parent_id, a.depth + 1 FROM tasks t JOIN ancestors a ON t.id = a.id WHERE t.parent_id IS NOT NULL) "+
+		"SELECT id FROM ancestors ORDER BY depth", id, workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var ancestor int
+
+		if err := rows.Scan(&ancestor); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		ids = append(ids, ancestor)
+	}
+
+	return ids, rows.Err()
+}
+
+// CountOpenChildrenTask returns how many live direct subtasks of a task are not closed
+func (s *Store) CountOpenChildrenTask(c *gofr.Context, id int) (int, error) {
+	DB := sqlutil.DB(c)
+
+	cond, args := s.notClosed()
+
+	var n int
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL AND "+cond,
+		append([]any{id, workspace.ID(c)}, args...)...).Scan(&n)
+
+	return n, err
+}
+
+// queryTasks runs a query selecting taskColumns
+func queryTasks(c *gofr.Context, query string, args ...any) ([]task.Task, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tasks := []task.Task{}
+
+	for rows.Next() {
+		var t task.Task
+
+		if err := rows.Scan(taskFields(&t)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		tasks = append(tasks, t)
+	}
+
+	return tasks, rows.Err()
+}
@@ -0,0 +1,138 @@
+package task
+
+import (
+	"errors"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+)
+
+var taskCols = []string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}
+
+func Test_GetChildrenTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT " + taskColumns + " FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL ORDER BY id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetChildrenTask(ctx, 1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow("x", "abc", "todo", 1, 1, stamp, stamp, nil, "P2", 1, nil, nil, 0))
+
+	if _, err := str.GetChildrenTask(ctx, 1); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected ErrScanTask, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow(2, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", 1, nil, nil, 0).
+			AddRow(3, "def", "done", 1, 1, stamp, stamp, nil, "P2", 1, nil, nil, 0))
+
+	tasks, err := str.GetChildrenTask(ctx, 1)
+	if err != nil || len(tasks) != 2 || tasks[0].ParentID == nil || *tasks[0].ParentID != 1 {
+		t.Errorf("unexpected children: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetSubtreeTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "WITH RECURSIVE subtree (id) AS (" +
+		"SELECT id FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL " +
+		"UNION ALL SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL) " +
+		"SELECT " + taskColumns + " FROM tasks WHERE id IN (SELECT id FROM subtree) ORDER BY id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow(2, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", 1, nil, nil, 0).
+			AddRow(3, "def", "todo", 1, 1, stamp, stamp, nil, "P2", 2, nil, nil, 0))
+
+	tasks, err := str.GetSubtreeTask(ctx, 1)
+	if err != nil || len(tasks) != 2 || *tasks[1].ParentID != 2 {
+		t.Errorf("unexpected subtree: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAncestorsTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "WITH RECURSIVE ancestors (id, depth) AS (" +
+		"SELECT parent_id, 1 FROM tasks WHERE id = ? AND workspace_id = ? AND parent_id IS NOT NULL " +
+		"UNION ALL SELECT t.parent_id, a.depth + 1 FROM tasks t JOIN ancestors a ON t.id = a.id WHERE t.parent_id IS NOT NULL) " +
+		"SELECT id FROM ancestors ORDER BY depth"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetAncestorsTask(ctx, 3); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnRows(mock.SQL.NewRows([]string{"id"}).AddRow("x"))
+
+	if _, err := str.GetAncestorsTask(ctx, 3); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected ErrScanTask, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnRows(mock.SQL.NewRows([]string{"id"}).AddRow(2).AddRow(1))
+
+	ids, err := str.GetAncestorsTask(ctx, 3)
+	if err != nil || !reflect.DeepEqual(ids, []int{2, 1}) {
+		t.Errorf("unexpected ancestors: %v, %v", ids, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_CountOpenChildrenTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE parent_id = ? AND workspace_id = ? AND deleted_at IS NULL AND status NOT IN (?, ?)").
+		WithArgs(1, 1, task.StatusDone, task.StatusCancelled).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+
+	n, err := str.CountOpenChildrenTask(ctx, 1)
+	if err != nil || n != 2 {
+		t.Errorf("expected 2 open children, got %d, %v", n, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
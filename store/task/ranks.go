@@ -0,0 +1,147 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/rank"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// GetRankedTask returns the first live tasks matching the filter in rank order, the order of the columns of a
+// board, at most limit of them
+func (s *Store) GetRankedTask(c *gofr.Context, f task.Filter, limit int) ([]task.Task, error) {
+	conds, args := s.taskFilter(workspace.ID(c), f)
+
+	return queryTasks(c, "SELECT "+taskColumns+" FROM tasks"+sqlutil.Where(conds)+" ORDER BY board_rank, id LIMIT ?",
+		append(args, limit)...)
+}
+
+// GetRankTask returns the rank of a live task
+func (*Store) GetRankTask(c *gofr.Context, id int) (string, error) {
+	DB := sqlutil.DB(c)
+
+	var r string
+
+	err := DB.QueryRow("SELECT board_rank FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL", id,
+		workspace.ID(c)).Scan(&r)
+	if errors.Is(err, sql.ErrNoRows) {
+		return r, errs.NotFound{Entity: "task", ID: id}
+	}
+
+	return r, err
+}
+
+// SetRankTask moves a task within the columns of the boards showing it. Ranks are not part of the task, its version
+// is left as is
+func (*Store) SetRankTask(c *gofr.Context, id int, r string) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("UPDATE tasks SET board_rank = ? WHERE id = ? AND workspace_id = ?", r, id, workspace.ID(c))
+
+	return err
+}
+
+// CountRankedTask counts the live tasks matching the filter, the task exclude aside, ranked strictly between lo and
+// hi. An empty hi is no upper bound
+func (s *Store) CountRankedTask(c *gofr.Context, f task.Filter, lo, hi string, exclude int) (int, error) {
+	DB := sqlutil.DB(c)
+
+	conds, args := s.taskFilter(workspace.ID(c), f)
+
+	conds = append(conds, "id <> ?", "board_rank > ?")
+	args = append(args, exclude, lo)
+
+	if hi != "" {
+		conds = append(conds, "board_rank < ?")
+		args = append(args, hi)
+	}
+
+	var n int
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM tasks"+sqlutil.Where(conds), args...).Scan(&n)
+
+	return n, err
+}
+
+// nextRank returns the rank putting a new task with status at the bottom of the columns showing it. Trashed tasks
+// count, so that they are not ranked alike new ones once restored. The last rank is locked until the transaction db
+// runs in ends, so that tasks created at the same time wait for each other rather than take the same rank
+func nextRank(db sqlutil.Runner, ws int, status task.Status) (string, error) {
+	var last string
+
+	err := db.QueryRow("SELECT board_rank FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank DESC LIMIT 1 FOR UPDATE",
+		ws, status).Scan(&last)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	return rank.After(last), nil
+}
+
+// LockColumnTask locks the ranks of the tasks with a status, trashed ones included, until the transaction c runs in
+// ends, so that the tasks moved to or created in the columns showing it meanwhile wait rather than take ranks alike
+func (*Store) LockColumnTask(c *gofr.Context, status task.Status) error {
+	_, err := columnIDs(sqlutil.DB(c), workspace.ID(c), status)
+
+	return err
+}
+
+// RebalanceRankTask spreads out the ranks of the tasks with a status, trashed ones included, keeping their order and
+// breaking ties by id, so that there is room again between tasks that were ranked alike
+func (*Store) RebalanceRankTask(c *gofr.Context, status task.Status) error {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return err
+	}
+
+	ids, err := columnIDs(tx, workspace.ID(c), status)
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return err
+	}
+
+	r := ""
+
+	for _, id := range ids {
+		r = rank.After(r)
+
+		if _, err := tx.Exec("UPDATE tasks SET board_rank = ? WHERE id = ? AND workspace_id = ?", r, id, workspace.ID(c)); err != nil {
+			sqlutil.Rollback(c, tx)
+
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// columnIDs locks and returns the ids of the tasks of a workspace with a status in rank order, ties broken by id
+func columnIDs(db sqlutil.Runner, ws int, status task.Status) ([]int, error) {
+	rows, err := db.Query("SELECT id FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank, id FOR UPDATE",
+		ws, status)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
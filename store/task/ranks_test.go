@@ -0,0 +1,197 @@
+package task
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+)
+
+func Test_GetRankedTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT " + taskColumns + " FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND project_id = ? " +
+		"ORDER BY board_rank, id LIMIT ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, task.StatusTodo, 4, 101).WillReturnError(errors.New("db down"))
+
+	if _, err := str.GetRankedTask(ctx, task.Filter{Status: task.StatusTodo, ProjectID: 4}, 101); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, task.StatusTodo, 4, 101).
+		WillReturnRows(mock.SQL.NewRows(taskCols).AddRow(3, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, 4, 0).
+			AddRow(2, "def", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, 4, 0))
+
+	tasks, err := str.GetRankedTask(ctx, task.Filter{Status: task.StatusTodo, ProjectID: 4}, 101)
+	if err != nil || len(tasks) != 2 || tasks[0].ID != 3 {
+		t.Errorf("unexpected tasks: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetRankTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT board_rank FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetRankTask(ctx, 3); err != (errs.NotFound{Entity: "task", ID: 3}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 2).WillReturnRows(sqlmock.NewRows([]string{"board_rank"}).AddRow("0000i"))
+
+	if r, err := str.GetRankTask(workspace.In(ctx, 2), 3); err != nil || r != "0000i" {
+		t.Errorf("unexpected rank %q, %v", r, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_SetRankTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("UPDATE tasks SET board_rank = ? WHERE id = ? AND workspace_id = ?").WithArgs("0000i", 3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.SetRankTask(ctx, 3, "0000i"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_CountRankedTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	tests := []struct {
+		name   string
+		lo, hi string
+		query  string
+		args   []driver.Value
+	}{
+		{"Between Two Tasks", "0000a", "0000b",
+			"SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND id <> ? AND board_rank > ? AND board_rank < ?",
+			[]driver.Value{1, task.StatusDone, 7, "0000a", "0000b"}},
+		{"Below The Last Task", "0000a", "",
+			"SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND id <> ? AND board_rank > ?",
+			[]driver.Value{1, task.StatusDone, 7, "0000a"}},
+	}
+
+	for _, tt := range tests {
+		mock.SQL.ExpectQuery(tt.query).WithArgs(tt.args...).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		n, err := str.CountRankedTask(ctx, task.Filter{Status: task.StatusDone}, tt.lo, tt.hi, 7)
+		if err != nil || n != 2 {
+			t.Errorf("%s: unexpected count %d, %v", tt.name, n, err)
+		}
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_LockColumnTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	column := "SELECT id FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank, id FOR UPDATE"
+
+	mock.SQL.ExpectQuery(column).WithArgs(1, task.StatusTodo).WillReturnError(errors.New("db down"))
+
+	if err := str.LockColumnTask(ctx, task.StatusTodo); err == nil || err.Error() != "db down" {
+		t.Errorf("expected db down, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(column).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+
+	if err := str.LockColumnTask(ctx, task.StatusTodo); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_RebalanceRankTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	column := "SELECT id FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank, id FOR UPDATE"
+	update := "UPDATE tasks SET board_rank = ? WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(column).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+	mock.SQL.ExpectExec(update).WithArgs("000001", 3, 1).WillReturnError(errors.New("db down"))
+	mock.SQL.ExpectRollback()
+
+	if err := str.RebalanceRankTask(ctx, task.StatusTodo); err == nil || err.Error() != "db down" {
+		t.Errorf("expected db down, got %v", err)
+	}
+
+	// tasks ranked alike are spread out in the order of their ids
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(column).WithArgs(2, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+	mock.SQL.ExpectExec(update).WithArgs("000001", 3, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec(update).WithArgs("000002", 4, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.RebalanceRankTask(workspace.In(ctx, 2), task.StatusTodo); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strings"
+	"time"
+)
+
+// recurrenceColumns are the columns of task_recurrences r read into a task.Recurrence, in the order of scanRecurrence
+const recurrenceColumns = "r.id, r.description, r.userid, r.priority, r.project_id, r.estimate, r.freq, r.repeat_interval, " +
+	"r.by_weekday, r.until_at, r.max_count, r.start_at, r.next_at, r.last_at, r.last_task_id, r.occurrences, r.version, " +
+	"r.created_at, r.updated_at"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanRecurrence reads a row of recurrenceColumns, splitting the weekdays kept comma separated
+func scanRecurrence(row scanner) (task.Recurrence, error) {
+	var (
+		r    task.Recurrence
+		days string
+	)
+
+	err := row.Scan(&r.ID, &r.Desc, &r.Userid, &r.Priority, &r.ProjectID, &r.Estimate, &r.Rule.Freq, &r.Rule.Interval, &days,
+		&r.Rule.Until, &r.Rule.Count, &r.Start, &r.NextAt, &r.LastAt, &r.LastTaskID, &r.Occurrences, &r.Version,
+		&r.CreatedAt, &r.UpdatedAt)
+	if err != nil {
+		return r, err
+	}
+
+	for _, d := range strings.Split(days, ",") {
+		if d != "" {
+			r.Rule.ByWeekday = append(r.Rule.ByWeekday, task.Weekday(d))
+		}
+	}
+
+	return r, nil
+}
+
+func joinWeekdays(days []task.Weekday) string {
+	s := make([]string, len(days))
+
+	for i := range days {
+		s[i] = string(days[i])
+	}
+
+	return strings.Join(s, ",")
+}
+
+// CreateRecurrenceTask inserts a new recurrence, which has no occurrence yet
+func (*Store) CreateRecurrenceTask(c *gofr.Context, r task.Recurrence) (task.Recurrence, error) {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("INSERT INTO task_recurrences (workspace_id, description, userid, priority, project_id, estimate, freq, "+
+		"repeat_interval, by_weekday, until_at, max_count, start_at, next_at, created_at, updated_at) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", workspace.ID(c), r.Desc, r.Userid, r.Priority, r.ProjectID,
+		r.Estimate, r.Rule.Freq, r.Rule.Interval, joinWeekdays(r.Rule.ByWeekday), r.Rule.Until, r.Rule.Count, r.Start,
+		r.NextAt, r.CreatedAt, r.UpdatedAt)
+	if err != nil {
+		return r, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return r, err
+	}
+
+	r.ID = int(id)
+	r.Version = 1
+
+	return r, nil
+}
+
+// GetByIDRecurrenceTask fetches a recurrence by its ID
+func (*Store) GetByIDRecurrenceTask(c *gofr.Context, id int) (task.Recurrence, error) {
+	DB := sqlutil.DB(c)
+
+	r, err := scanRecurrence(DB.QueryRow("SELECT "+recurrenceColumns+" FROM task_recurrences r WHERE r.id = ? AND r.workspace_id = ?",
+		id, workspace.ID(c)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return r, errs.NotFound{Entity: "recurrence", ID: id}
+	}
+
+	return r, err
+}
+
+// GetAllRecurrenceTask returns every recurrence, oldest first
+func (*Store) GetAllRecurrenceTask(c *gofr.Context) ([]task.Recurrence, error) {
+	return queryRecurrences(c, "SELECT "+recurrenceColumns+" FROM task_recurrences r WHERE r.workspace_id = ? ORDER BY r.id",
+		workspace.ID(c))
+}
+
+// GetPendingRecurrenceTask returns the recurrences whose next occurrence is to be created as of now, the ones it is due
+// for
+func (*Store) GetPendingRecurrenceTask(c *gofr.Context, now time.Time) ([]task.Recurrence, error) {
+	return queryRecurrences(c, "SELECT "+recurrenceColumns+" FROM task_recurrences r WHERE r.workspace_id = ? AND r.next_at <= ? "+
+		"ORDER BY r.id", workspace.ID(c), now)
+}
+
+func queryRecurrences(c *gofr.Context, query string, args ...any) ([]task.Recurrence, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	recurrences := []task.Recurrence{}
+
+	for rows.Next() {
+		r, err := scanRecurrence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		recurrences = append(recurrences, r)
+	}
+
+	return recurrences, rows.Err()
+}
+
+// UpdateRecurrenceTask replaces the template, rule, start and next occurrence of a recurrence if it is still at
+// r.Version, and bumps its version
+func (*Store) UpdateRecurrenceTask(c *gofr.Context, r task.Recurrence) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE task_recurrences SET description = ?, userid = ?, priority = ?, project_id = ?, estimate = ?, "+
+		"freq = ?, repeat_interval = ?, by_weekday = ?, until_at = ?, max_count = ?, start_at = ?, next_at = ?, updated_at = ?, "+
+		"version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?", r.Desc, r.Userid, r.Priority, r.ProjectID,
+		r.Estimate, r.Rule.Freq, r.Rule.Interval, joinWeekdays(r.Rule.ByWeekday), r.Rule.Until, r.Rule.Count, r.Start, r.NextAt,
+		r.UpdatedAt, r.ID, r.Version, workspace.ID(c))
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// AdvanceRecurrenceTask claims the occurrence due at r.LastAt, the r.Occurrences-th, whose task r.LastTaskID the
+// caller created in the transaction c runs in: it moves the recurrence on to r.NextAt unless it has changed since read
+// at r.Version, or another run claimed the occurrence first. Creating occurrences is not a change of the recurrence,
+// its version is left as is
+func (*Store) AdvanceRecurrenceTask(c *gofr.Context, r task.Recurrence) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE task_recurrences SET next_at = ?, last_at = ?, last_task_id = ?, occurrences = ? "+
+		"WHERE id = ? AND version = ? AND occurrences = ? AND workspace_id = ?", r.NextAt, r.LastAt, r.LastTaskID,
+		r.Occurrences, r.ID, r.Version, r.Occurrences-1, workspace.ID(c))
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// DeleteRecurrenceTask removes a recurrence, leaving the tasks it created as they are. Unless ver is version.Any the
+// recurrence is only removed if it is still at that version
+func (*Store) DeleteRecurrenceTask(c *gofr.Context, id, ver int) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("DELETE FROM task_recurrences WHERE id = ? AND version = ? AND workspace_id = ?", id, ver,
+			workspace.ID(c))
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := DB.Exec("DELETE FROM task_recurrences WHERE id = ? AND workspace_id = ?", id, workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "recurrence", ID: id}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"strings"
+	"testing"
+)
+
+var recurrenceCols = strings.Split(strings.ReplaceAll(recurrenceColumns, "r.", ""), ", ")
+
+func Test_CreateRecurrenceTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	rule := task.Rule{Freq: task.FrequencyWeekly, Interval: 2, ByWeekday: []task.Weekday{"MO", "TH"}, Count: 4}
+	r := task.Recurrence{Desc: "Rotate on-call", Userid: 3, Priority: task.PriorityP2, Rule: rule, Start: stamp, NextAt: &stamp,
+		CreatedAt: stamp, UpdatedAt: stamp}
+
+	insert := "INSERT INTO task_recurrences (workspace_id, description, userid, priority, project_id, estimate, freq, " +
+		"repeat_interval, by_weekday, until_at, max_count, start_at, next_at, created_at, updated_at) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Rotate on-call", 3, task.PriorityP2, nil, nil, task.FrequencyWeekly, 2, "MO,TH",
+		nil, 4, stamp, &stamp, stamp, stamp).WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateRecurrenceTask(workspace.In(ctx, 2), r); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Rotate on-call", 3, task.PriorityP2, nil, nil, task.FrequencyWeekly, 2, "MO,TH",
+		nil, 4, stamp, &stamp, stamp, stamp).WillReturnResult(sqlmock.NewResult(5, 1))
+
+	created, err := str.CreateRecurrenceTask(ctx, r)
+	if err != nil || created.ID != 5 || created.Version != 1 {
+		t.Errorf("unexpected recurrence: %+v, %v", created, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDRecurrenceTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT " + recurrenceColumns + " FROM task_recurrences r WHERE r.id = ? AND r.workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(5, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDRecurrenceTask(ctx, 5); err != (errs.NotFound{Entity: "recurrence", ID: 5}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(5, 1).WillReturnRows(mock.SQL.NewRows(recurrenceCols).
+		AddRow(5, "Rotate on-call", 3, "P2", nil, nil, "weekly", 2, "MO,TH", nil, 4, stamp, stamp, nil, nil, 0, 1, stamp, stamp))
+
+	r, err := str.GetByIDRecurrenceTask(ctx, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(r.Rule.ByWeekday) != 2 || r.Rule.ByWeekday[1] != "TH" || r.Rule.Interval != 2 || r.NextAt == nil {
+		t.Errorf("unexpected recurrence: %+v", r)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetPendingRecurrenceTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT " + recurrenceColumns + " FROM task_recurrences r WHERE r.workspace_id = ? AND r.next_at <= ? ORDER BY r.id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp).WillReturnRows(mock.SQL.NewRows(recurrenceCols).
+		AddRow(5, "Water plants", 3, "P3", nil, nil, "daily", 0, "", nil, 0, stamp, stamp, stamp, 9, 4, 1, stamp, stamp))
+
+	rs, err := str.GetPendingRecurrenceTask(ctx, stamp)
+	if err != nil || len(rs) != 1 || rs[0].Rule.ByWeekday != nil || *rs[0].LastTaskID != 9 {
+		t.Errorf("unexpected recurrences: %+v, %v", rs, err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp).WillReturnRows(mock.SQL.NewRows([]string{"id"}).
+		AddRow(5))
+
+	if _, err := str.GetPendingRecurrenceTask(ctx, stamp); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected a scan error, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_AdvanceRecurrenceTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	lastTask := 12
+	r := task.Recurrence{ID: 5, NextAt: nil, LastAt: &stamp, LastTaskID: &lastTask, Occurrences: 4, Version: 2}
+
+	update := "UPDATE task_recurrences SET next_at = ?, last_at = ?, last_task_id = ?, occurrences = ? " +
+		"WHERE id = ? AND version = ? AND occurrences = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(update).WithArgs(nil, &stamp, &lastTask, 4, 5, 2, 3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.AdvanceRecurrenceTask(ctx, r); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected a version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(update).WithArgs(nil, &stamp, &lastTask, 4, 5, 2, 3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.AdvanceRecurrenceTask(ctx, r); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteRecurrenceTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("DELETE FROM task_recurrences WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(5, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteRecurrenceTask(ctx, 5, 2); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected a version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM task_recurrences WHERE id = ? AND workspace_id = ?").WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteRecurrenceTask(ctx, 5, version.Any); err != (errs.NotFound{Entity: "recurrence", ID: 5}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
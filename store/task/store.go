@@ -4,24 +4,90 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
 	"gofr.dev/pkg/gofr"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Store keeps tasks in the workspace of the request: every query only sees the tasks of workspace.ID, and the
+// links, tags and history of those tasks. Tasks in one of its closed statuses are finished: they are neither open
+// subtasks or blockers, nor due, overdue or next to work on.
 type Store struct {
+	closed []task.Status
 }
 
-func NewStore() *Store {
-	return &Store{}
+// NewStore returns a store whose finished tasks are those in one of the closed statuses of the task workflow
+func NewStore(closed []task.Status) *Store {
+	return &Store{closed: closed}
 }
 
-var ErrScanUser = errors.New("scan user failed")
+var ErrScanTask = errors.New("scan task failed")
 
-// CreateTask inserts a new task into the database
+// taskColumns are the columns read into a task.Task, in the order of taskFields. Trashed tasks whose user was deleted
+// have none, and are read with user 0
+const taskColumns = "id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, " +
+	"project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id)"
+
+// taskFields are the scan destinations of taskColumns
+func taskFields(t *task.Task) []any {
+	return []any{&t.ID, &t.Desc, &t.Status, &t.Userid, &t.Version, &t.CreatedAt, &t.UpdatedAt, &t.DueAt, &t.Priority, &t.ParentID, &t.Estimate,
+		&t.ProjectID, &t.Comments}
+}
+
+// CreateTask inserts a new task into the database, at the bottom of the board columns showing it. Its rank is given in
+// a transaction, see nextRank
 func (*Store) CreateTask(c *gofr.Context, t task.Task) (task.Task, error) {
-	DB := c.SQL
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return t, err
+	}
+
+	if t, err = insertTask(tx, workspace.ID(c), t); err != nil {
+		sqlutil.Rollback(c, tx)
 
-	res, err := DB.Exec("INSERT INTO tasks (description, status,userid) VALUES (?, ?,?)", t.Desc, t.Status, t.Userid)
+		return t, err
+	}
+
+	return t, tx.Commit()
+}
+
+// CreateManyTask inserts new tasks in their order, all in one transaction, each at the bottom of the board columns
+// showing it
+func (*Store) CreateManyTask(c *gofr.Context, ts []task.Task) ([]task.Task, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]task.Task, len(ts))
+
+	for i := range ts {
+		if out[i], err = insertTask(tx, workspace.ID(c), ts[i]); err != nil {
+			sqlutil.Rollback(c, tx)
+
+			return nil, err
+		}
+	}
+
+	return out, tx.Commit()
+}
+
+func insertTask(db sqlutil.Runner, ws int, t task.Task) (task.Task, error) {
+	r, err := nextRank(db, ws, t.Status)
+	if err != nil {
+		return t, err
+	}
+
+	res, err := db.Exec("INSERT INTO tasks (workspace_id, description, status, userid, priority, parent_id, estimate, project_id, "+
+		"board_rank, created_at, updated_at, due_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", ws, t.Desc, t.Status,
+		t.Userid, t.Priority, t.ParentID, t.Estimate, t.ProjectID, r, t.CreatedAt, t.UpdatedAt, t.DueAt)
 	if err != nil {
 		return t, err
 	}
@@ -32,51 +98,96 @@ func (*Store) CreateTask(c *gofr.Context, t task.Task) (task.Task, error) {
 	}
 
 	t.ID = int(id)
+	t.Version = 1
+	t.Comments = 0
 
 	return t, nil
 }
 
-// GetByIDTask fetches a task by its ID
+// GetByIDTask fetches a task by its ID. Trashed tasks are not found
 func (*Store) GetByIDTask(c *gofr.Context, id int) (task.Task, error) {
-	DB := c.SQL
+	DB := sqlutil.DB(c)
 
 	var t task.Task
 
-	err := DB.QueryRow("SELECT * FROM tasks WHERE id = ?", id).
-		Scan(&t.ID, &t.Desc, &t.Status, &t.Userid)
-	if err != nil {
-		return t, fmt.Errorf("%w: %v", ErrScanUser, err)
+	err := DB.QueryRow("SELECT "+taskColumns+" FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL", id, workspace.ID(c)).
+		Scan(taskFields(&t)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, errs.NotFound{Entity: "task", ID: id}
 	}
 
 	return t, err
 }
 
-// CompleteTask marks a task as completed
-func (*Store) CompleteTask(c *gofr.Context, id int) error {
-	DB := c.SQL
+// UpdateTask replaces the description, assignee, priority, parent, estimate, project and due date of a task if it is still at
+// t.Version, and bumps its version. Moving the due date re-arms the reminder
+func (*Store) UpdateTask(c *gofr.Context, t task.Task) error {
+	DB := sqlutil.DB(c)
 
-	res, err := DB.Exec("UPDATE tasks SET status = true WHERE id = ?", id)
-	if err != nil {
-		return err
-	}
+	// SET assigns left to right, so reminded_at is compared with the due date before it changes
+	res, err := DB.Exec("UPDATE tasks SET description = ?, userid = ?, priority = ?, parent_id = ?, estimate = ?, project_id = ?, "+
+		"reminded_at = CASE WHEN due_at <=> ? THEN reminded_at END, due_at = ?, updated_at = ?, version = version + 1 "+
+		"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL",
+		t.Desc, t.Userid, t.Priority, t.ParentID, t.Estimate, t.ProjectID, t.DueAt, t.DueAt, t.UpdatedAt, t.ID, t.Version,
+		workspace.ID(c))
 
-	affected, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
+	return sqlutil.VersionChecked(res, err)
+}
 
-	if affected == 0 {
-		return sql.ErrNoRows
+// UpdateStatusTask moves a task to another status at the given time if it is still at version ver, and bumps its version
+func (*Store) UpdateStatusTask(c *gofr.Context, id, ver int, to task.Status, at time.Time) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE tasks SET status = ?, updated_at = ?, version = version + 1 "+
+		"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL", to, at, id, ver, workspace.ID(c))
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// DeleteTask moves a task to the trash at the given time. Unless ver is version.Any the task is only trashed if it is
+// still at that version
+func (*Store) DeleteTask(c *gofr.Context, id, ver int, at time.Time) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("UPDATE tasks SET deleted_at = ?, version = version + 1 "+
+			"WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL", at, id, ver, workspace.ID(c))
+
+		return sqlutil.VersionChecked(res, err)
 	}
 
-	return nil
+	res, err := DB.Exec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL",
+		at, id, workspace.ID(c))
+
+	return found(res, err, id)
+}
+
+// RestoreTask takes a task out of the trash. Tasks whose user was deleted stay there until purged
+func (*Store) RestoreTask(c *gofr.Context, id int) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE tasks SET deleted_at = NULL, version = version + 1 "+
+		"WHERE id = ? AND workspace_id = ? AND deleted_at IS NOT NULL AND userid IS NOT NULL", id, workspace.ID(c))
+
+	return found(res, err, id)
 }
 
-// DeleteTask removes a task by ID
-func (*Store) DeleteTask(c *gofr.Context, id int) error {
-	DB := c.SQL
+// PurgeTask permanently removes the tasks trashed before the given time and returns how many were removed. Tasks with
+// attachments are kept until their attachments are purged, as deleting their rows would leave their content stored
+func (*Store) PurgeTask(c *gofr.Context, before time.Time) (int64, error) {
+	DB := sqlutil.DB(c)
 
-	res, err := DB.Exec("DELETE FROM tasks WHERE id = ?", id)
+	res, err := DB.Exec("DELETE FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ? "+
+		"AND NOT EXISTS (SELECT 1 FROM attachments WHERE attachments.task_id = tasks.id)", workspace.ID(c), before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// found turns a write that matched no row into errs.NotFound
+func found(res sql.Result, err error, id int) error {
 	if err != nil {
 		return err
 	}
@@ -87,45 +198,152 @@ func (*Store) DeleteTask(c *gofr.Context, id int) error {
 	}
 
 	if affected == 0 {
-		return sql.ErrNoRows
+		return errs.NotFound{Entity: "task", ID: id}
 	}
 
 	return nil
 }
 
-// GetAllTask returns all tasks from the database
-func (*Store) GetAllTask(c *gofr.Context) ([]task.Task, error) {
-	DB := c.SQL
+// sortColumns maps the sort keys accepted by GetAllTask to their columns
+var sortColumns = map[string]string{"id": "id", "desc": "description", "status": "status", "userid": "userid",
+	"priority": "priority"}
+
+// GetAllTask returns one page of the tasks matching the filter, along with the total number of matches
+func (s *Store) GetAllTask(c *gofr.Context, f task.Filter, q page.Query) (page.Page[task.Task], error) {
+	DB := sqlutil.DB(c)
+
+	res := page.Page[task.Task]{Items: []task.Task{}, Limit: q.Limit, Offset: q.Offset}
+
+	conds, args := s.taskFilter(workspace.ID(c), f)
 
-	rows, err := DB.Query("SELECT id, description, status , userid FROM tasks")
+	err := DB.QueryRow("SELECT COUNT(*) FROM tasks"+sqlutil.Where(conds), args...).Scan(&res.Total)
 	if err != nil {
-		return nil, err
+		return res, err
 	}
 
-	var tasks []task.Task
+	order, conds, args := sqlutil.Keyset(q, sortColumns[q.Sort], conds, args)
+
+	// one row more than the page size tells whether there is a next page
+	args = append(args, q.Limit+1, q.Offset)
+
+	rows, err := DB.Query("SELECT "+taskColumns+", deleted_at FROM tasks"+sqlutil.Where(conds)+
+		" ORDER BY "+order+" LIMIT ? OFFSET ?", args...)
+	if err != nil {
+		return res, err
+	}
+
+	defer rows.Close()
 
 	for rows.Next() {
 		var t task.Task
 
-		if err := rows.Scan(&t.ID, &t.Desc, &t.Status, &t.Userid); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrScanUser, err)
+		if err := rows.Scan(append(taskFields(&t), &t.DeletedAt)...); err != nil {
+			return res, fmt.Errorf("%w: %v", ErrScanTask, err)
 		}
 
-		tasks = append(tasks, t)
+		res.Items = append(res.Items, t)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return res, err
 	}
 
-	return tasks, nil
+	res.Items, res.NextCursor = sqlutil.NextPage(q, res.Items, func(t task.Task) (string, int) {
+		return sortValue(t, q.Sort), t.ID
+	})
+
+	return res, nil
+}
+
+func (s *Store) taskFilter(ws int, f task.Filter) ([]string, []any) {
+	var (
+		conds = []string{"workspace_id = ?", "deleted_at IS NULL"}
+		args  = []any{ws}
+	)
+
+	if f.Trashed {
+		conds[1] = "deleted_at IS NOT NULL"
+	}
+
+	if f.Status != "" {
+		conds = append(conds, "status = ?")
+		args = append(args, f.Status)
+	}
+
+	if f.Userid != 0 {
+		conds = append(conds, "userid = ?")
+		args = append(args, f.Userid)
+	}
+
+	if f.ProjectID != 0 {
+		conds = append(conds, "project_id = ?")
+		args = append(args, f.ProjectID)
+	}
+
+	if f.Text != "" {
+		conds = append(conds, "description LIKE ?")
+		args = append(args, sqlutil.Contains(f.Text))
+	}
+
+	if f.Overdue {
+		conds = append(conds, "due_at < ?")
+		args = append(args, f.Now)
+	}
+
+	if f.DueWithin > 0 {
+		conds = append(conds, "due_at >= ? AND due_at < ?")
+		args = append(args, f.Now, f.Now.Add(f.DueWithin))
+	}
+
+	if len(f.Tags) > 0 {
+		cond, tags := tagFilter(f.Tags, f.AllTags)
+		conds = append(conds, cond)
+		args = append(args, tags...)
+	}
+
+	if f.Overdue || f.DueWithin > 0 {
+		cond, closed := s.notClosed()
+		conds = append(conds, cond)
+		args = append(args, closed...)
+	}
+
+	return conds, args
 }
 
-// GetTasksByUserID it will send the tasks , which are assigned to user
+// notClosed is the condition keeping the tasks whose status is not one of the closed statuses of the store
+func (s *Store) notClosed() (string, []any) {
+	args := make([]any, len(s.closed))
+	for i, status := range s.closed {
+		args[i] = status
+	}
+
+	return "status NOT IN (?" + strings.Repeat(", ?", len(args)-1) + ")", args
+}
+
+func sortValue(t task.Task, sort string) string {
+	switch sort {
+	case "desc":
+		return t.Desc
+	case "status":
+		return string(t.Status)
+	case "userid":
+		return strconv.Itoa(t.Userid)
+	case "priority":
+		return string(t.Priority)
+	default:
+		return strconv.Itoa(t.ID)
+	}
+}
+
+// rankOrder sorts tasks by priority, then by due date with undated tasks last, then oldest first
+const rankOrder = " ORDER BY priority, due_at IS NULL, due_at, created_at, id"
+
+// GetTasksByUserID it will send the tasks , which are assigned to user, most urgent first
 func (*Store) GetTasksByUserIDTask(c *gofr.Context, userid int) ([]task.Task, error) {
-	DB := c.SQL
+	DB := sqlutil.DB(c)
 
-	rows, err := DB.Query("SELECT id, description, status , userid FROM tasks where userid =?", userid)
+	rows, err := DB.Query("SELECT "+taskColumns+" FROM tasks where userid =? AND workspace_id = ? AND deleted_at IS NULL"+rankOrder,
+		userid, workspace.ID(c))
 	if err != nil {
 		return nil, err
 	}
@@ -142,8 +360,8 @@ func (*Store) GetTasksByUserIDTask(c *gofr.Context, userid int) ([]task.Task, er
 	for rows.Next() {
 		var t task.Task
 
-		if err := rows.Scan(&t.ID, &t.Desc, &t.Status, &t.Userid); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrScanUser, err)
+		if err := rows.Scan(taskFields(&t)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
 		}
 
 		tasks = append(tasks, t)
@@ -155,3 +373,58 @@ func (*Store) GetTasksByUserIDTask(c *gofr.Context, userid int) ([]task.Task, er
 
 	return tasks, nil
 }
+
+// GetNextTask returns the open task of a user that ranks first in rankOrder
+func (s *Store) GetNextTask(c *gofr.Context, userid int) (task.Task, error) {
+	DB := sqlutil.DB(c)
+
+	var t task.Task
+
+	cond, args := s.notClosed()
+
+	err := DB.QueryRow("SELECT "+taskColumns+" FROM tasks WHERE userid = ? AND workspace_id = ? AND deleted_at IS NULL AND "+
+		cond+rankOrder+" LIMIT 1", append([]any{userid, workspace.ID(c)}, args...)...).Scan(taskFields(&t)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, errs.NotFound{Entity: "open task for user", ID: userid}
+	}
+
+	return t, err
+}
+
+// GetDueTask returns the open tasks that were due at or before now and have not been reminded of yet
+func (s *Store) GetDueTask(c *gofr.Context, now time.Time) ([]task.Task, error) {
+	DB := sqlutil.DB(c)
+
+	cond, args := s.notClosed()
+
+	rows, err := DB.Query("SELECT "+taskColumns+" FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND reminded_at IS NULL "+
+		"AND due_at <= ? AND "+cond+" ORDER BY due_at, id", append([]any{workspace.ID(c), now}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tasks []task.Task
+
+	for rows.Next() {
+		var t task.Task
+
+		if err := rows.Scan(taskFields(&t)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		tasks = append(tasks, t)
+	}
+
+	return tasks, rows.Err()
+}
+
+// MarkRemindedTask records that the reminder of a task was sent, so it is not sent again
+func (*Store) MarkRemindedTask(c *gofr.Context, id int, at time.Time) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("UPDATE tasks SET reminded_at = ? WHERE id = ? AND workspace_id = ?", at, id, workspace.ID(c))
+
+	return err
+}
@@ -1,14 +1,27 @@
 package task
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
 	"strings"
 	"testing"
+	"time"
+)
+
+var (
+	stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	due   = time.Date(2026, 10, 20, 17, 0, 0, 0, time.UTC)
+	// closed are the closed statuses of the default workflow
+	closed = []task.Status{task.StatusDone, task.StatusCancelled}
 )
 
 type badResultForLastInsertId struct{}
@@ -37,29 +50,60 @@ func Test_CreateTask(t *testing.T) {
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
+
+	t1 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2, Priority: task.PriorityP1, Version: 1, CreatedAt: stamp,
+		UpdatedAt: stamp, DueAt: &due}
+	t2 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
+	t3 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
 
-	t1 := task.Task{1, "abc", false, 2}
-	t2 := task.Task{1, "abc", false, 2}
-	t3 := task.Task{1, "abc", false, 2}
+	insert := "INSERT INTO tasks (workspace_id, description, status, userid, priority, parent_id, estimate, project_id, board_rank, created_at, updated_at, due_at) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	lastRank := "SELECT board_rank FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank DESC LIMIT 1 FOR UPDATE"
 
-	mock.SQL.ExpectExec("INSERT INTO tasks (description, status,userid) VALUES (?, ?,?)").WithArgs(t2.Desc, t2.Status, t2.Userid).WillReturnError(errors.New("Insert failed"))
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.CreateTask(ctx, t2); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(1, task.StatusTodo).WillReturnError(errors.New("db down"))
+	mock.SQL.ExpectRollback()
 
 	_, err := str.CreateTask(ctx, t2)
+	if err == nil || err.Error() != "db down" {
+		t.Errorf("Expected rank error, got: %v", err)
+	}
+
+	// the first task of a column finds no rank to follow
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}))
+	mock.SQL.ExpectExec(insert).WithArgs(1, t2.Desc, t2.Status, t2.Userid, t2.Priority, t2.ParentID, t2.Estimate, t2.ProjectID, "000001", t2.CreatedAt, t2.UpdatedAt, t2.DueAt).WillReturnError(errors.New("Insert failed"))
+	mock.SQL.ExpectRollback()
+
+	_, err = str.CreateTask(ctx, t2)
 	if err == nil || !strings.Contains(err.Error(), "Insert failed") {
 		t.Error("expected an error, got nil")
 	}
 
-	mock.SQL.ExpectExec("INSERT INTO tasks (description, status,userid) VALUES (?, ?,?)").WithArgs(t3.Desc, t3.Status, t3.Userid).WillReturnResult(badResultForLastInsertId{})
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("000001"))
+	mock.SQL.ExpectExec(insert).WithArgs(1, t3.Desc, t3.Status, t3.Userid, t3.Priority, t3.ParentID, t3.Estimate, t3.ProjectID, "000002", t3.CreatedAt, t3.UpdatedAt, t3.DueAt).WillReturnResult(badResultForLastInsertId{})
+	mock.SQL.ExpectRollback()
 
 	_, err3 := str.CreateTask(ctx, t3)
 	if err3 == nil || err3.Error() != "LastInsertId failed" {
 		t.Errorf("Expected LastInsertId error, got: %v", err3)
 	}
 
-	mock.SQL.ExpectExec("INSERT INTO tasks (description, status,userid) VALUES (?, ?,?)").WithArgs(t1.Desc, t1.Status, t1.Userid).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ranks stay short while tasks are appended, and never end with a zero
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(2, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("00000z"))
+	mock.SQL.ExpectExec(insert).WithArgs(2, t1.Desc, t1.Status, t1.Userid, t1.Priority, t1.ParentID, t1.Estimate, t1.ProjectID, "00001", t1.CreatedAt, t1.UpdatedAt, t1.DueAt).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.SQL.ExpectCommit()
 
-	res, err := str.CreateTask(ctx, t1)
+	res, err := str.CreateTask(workspace.In(ctx, 2), t1)
 	if err != nil {
 		t.Error("create task fail")
 	}
@@ -67,6 +111,65 @@ func Test_CreateTask(t *testing.T) {
 	if res != t1 {
 		t.Error("create task fail")
 	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_CreateManyTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	ts := []task.Task{{Desc: "Set up laptop", Status: task.StatusTodo, Userid: 2, Priority: task.PriorityP2, CreatedAt: stamp,
+		UpdatedAt: stamp}, {Desc: "Read handbook", Status: task.StatusTodo, Userid: 2, Priority: task.PriorityP3, CreatedAt: stamp,
+		UpdatedAt: stamp}}
+
+	insert := "INSERT INTO tasks (workspace_id, description, status, userid, priority, parent_id, estimate, project_id, board_rank, created_at, updated_at, due_at) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	lastRank := "SELECT board_rank FROM tasks WHERE workspace_id = ? AND status = ? ORDER BY board_rank DESC LIMIT 1 FOR UPDATE"
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.CreateManyTask(ctx, ts); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("000001"))
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Set up laptop", task.StatusTodo, 2, task.PriorityP2, nil, nil, nil, "000002", stamp,
+		stamp, nil).WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.SQL.ExpectQuery(lastRank).WithArgs(1, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("000002"))
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Read handbook", task.StatusTodo, 2, task.PriorityP3, nil, nil, nil, "000003", stamp,
+		stamp, nil).WillReturnError(errors.New("Insert failed"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.CreateManyTask(ctx, ts); err == nil || err.Error() != "Insert failed" {
+		t.Errorf("expected insert error, got: %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(lastRank).WithArgs(2, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}))
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Set up laptop", task.StatusTodo, 2, task.PriorityP2, nil, nil, nil, "000001", stamp,
+		stamp, nil).WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.SQL.ExpectQuery(lastRank).WithArgs(2, task.StatusTodo).WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("000001"))
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Read handbook", task.StatusTodo, 2, task.PriorityP3, nil, nil, nil, "000002", stamp,
+		stamp, nil).WillReturnResult(sqlmock.NewResult(8, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.CreateManyTask(workspace.In(ctx, 2), ts)
+	if err != nil || len(res) != 2 || res[0].ID != 7 || res[1].ID != 8 || res[1].Version != 1 {
+		t.Errorf("expected tasks 7 and 8, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
 }
 
 func Test_GetByIDTask(t *testing.T) {
@@ -76,34 +179,49 @@ func Test_GetByIDTask(t *testing.T) {
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
 
-	mock.SQL.ExpectQuery("SELECT * FROM tasks WHERE id = ?").WithArgs(2).WillReturnError(errors.New("Invalid Id"))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(2, 1).WillReturnError(errors.New("Invalid Id"))
 
 	_, err := str.GetByIDTask(ctx, 2)
 	if err == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	rowWithScanErr := mock.SQL.NewRows([]string{"id", "desc", "status", "userid"}).AddRow("as", "abc", false, "a")
+	rowWithScanErr := mock.SQL.NewRows([]string{"id", "desc", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).AddRow("as", "abc", "todo", "a", 1, stamp, stamp, nil, "P2", nil, nil, nil, 0)
 
-	mock.SQL.ExpectQuery("SELECT * FROM tasks WHERE id = ?").WithArgs(1).WillReturnRows(rowWithScanErr)
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(1, 1).WillReturnRows(rowWithScanErr)
 
 	_, err1 := str.GetByIDTask(ctx, 1)
-	if err1 == nil || !errors.Is(err, ErrScanUser) {
-		t.Error("Got scan error")
+	if err1 == nil {
+		t.Error("expected a scan error, got nil")
 	}
 
-	row := mock.SQL.NewRows([]string{"id", "desc", "status", "userid"}).AddRow(1, "abc", false, 1)
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(3, 1).WillReturnError(sql.ErrNoRows)
 
-	mock.SQL.ExpectQuery("SELECT * FROM tasks WHERE id = ?").WithArgs(1).WillReturnRows(row)
+	_, err = str.GetByIDTask(ctx, 3)
+	if err != (errs.NotFound{Entity: "task", ID: 3}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	// a task of another workspace is not found
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(1, 2).WillReturnError(sql.ErrNoRows)
+
+	_, err = str.GetByIDTask(workspace.In(ctx, 2), 1)
+	if err != (errs.NotFound{Entity: "task", ID: 1}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	row := mock.SQL.NewRows([]string{"id", "desc", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0)
+
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(1, 1).WillReturnRows(row)
 
 	res, err := str.GetByIDTask(ctx, 1)
 	if err != nil {
 		t.Error("get task fail")
 	}
 
-	if res.Desc != "abc" || res.Status || res.Userid != 1 || res.ID != 1 {
+	if res.Desc != "abc" || res.Status != task.StatusTodo || res.Userid != 1 || res.ID != 1 || res.Version != 1 {
 		t.Error("get task fail")
 	}
 
@@ -112,37 +230,80 @@ func Test_GetByIDTask(t *testing.T) {
 	}
 }
 
-func Test_CompleteTask(t *testing.T) {
+func Test_UpdateTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	t1 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2, Priority: task.PriorityP0, Version: 3, UpdatedAt: stamp, DueAt: &due}
+
+	query := "UPDATE tasks SET description = ?, userid = ?, priority = ?, parent_id = ?, estimate = ?, project_id = ?, reminded_at = CASE WHEN due_at <=> ? THEN reminded_at END, due_at = ?, " +
+		"updated_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL"
+
+	mock.SQL.ExpectExec(query).WithArgs(t1.Desc, t1.Userid, t1.Priority, t1.ParentID, t1.Estimate, t1.ProjectID, t1.DueAt, t1.DueAt, t1.UpdatedAt, t1.ID, t1.Version, 1).WillReturnError(errors.New("Update failed"))
+
+	if err := str.UpdateTask(ctx, t1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(t1.Desc, t1.Userid, t1.Priority, t1.ParentID, t1.Estimate, t1.ProjectID, t1.DueAt, t1.DueAt, t1.UpdatedAt, t1.ID, t1.Version, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.UpdateTask(ctx, t1); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch for a stale version, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(t1.Desc, t1.Userid, t1.Priority, t1.ParentID, t1.Estimate, t1.ProjectID, t1.DueAt, t1.DueAt, t1.UpdatedAt, t1.ID, t1.Version, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.UpdateTask(ctx, t1); err != nil {
+		t.Errorf("update task fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_UpdateStatusTask(t *testing.T) {
 	mockContainer, mock := container.NewMockContainer(t)
 
 	ctx := &gofr.Context{
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
 
-	t1 := task.Task{1, "abc", false, 2}
-	t2 := task.Task{1, "abc", false, 2}
+	query := "UPDATE tasks SET status = ?, updated_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL"
 
-	mock.SQL.ExpectExec("UPDATE tasks SET status = true WHERE id = ?").WithArgs(t2.ID).WillReturnError(errors.New("Not found"))
+	mock.SQL.ExpectExec(query).WithArgs(task.StatusDone, stamp, 1, 2, 1).WillReturnError(errors.New("Not found"))
 
-	err := str.CompleteTask(ctx, t2.ID)
+	err := str.UpdateStatusTask(ctx, 1, 2, task.StatusDone, stamp)
 	if err == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	mock.SQL.ExpectExec("UPDATE tasks SET status = true WHERE id = ?").WithArgs(t1.ID).WillReturnResult(badResultForRowsAffected{})
+	mock.SQL.ExpectExec(query).WithArgs(task.StatusDone, stamp, 1, 2, 1).WillReturnResult(badResultForRowsAffected{})
 
-	err = str.CompleteTask(ctx, t1.ID)
+	err = str.UpdateStatusTask(ctx, 1, 2, task.StatusDone, stamp)
 	if err == nil || err.Error() != "RowsAffected failed" {
 		t.Error("Rows affected fail")
 	}
 
-	mock.SQL.ExpectExec("UPDATE tasks SET status = true WHERE id = ?").WithArgs(t1.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.SQL.ExpectExec(query).WithArgs(task.StatusDone, stamp, 1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = str.UpdateStatusTask(ctx, 1, 2, task.StatusDone, stamp)
+	if !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch when the task changed, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(task.StatusDone, stamp, 1, 2, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err = str.CompleteTask(ctx, t1.ID)
+	err = str.UpdateStatusTask(ctx, 1, 2, task.StatusDone, stamp)
 	if err != nil {
-		t.Error("complete task fail")
+		t.Error("update task status fail")
 	}
 
 	if err := mock.SQL.ExpectationsWereMet(); err != nil {
@@ -157,32 +318,155 @@ func Test_DeleteTask(t *testing.T) {
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
 
-	t1 := task.Task{1, "abc", false, 2}
-	t2 := task.Task{1, "abc", false, 2}
+	t1 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
+	t2 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
 
-	mock.SQL.ExpectExec("DELETE FROM tasks WHERE id = ?").WithArgs(t2.ID).WillReturnError(errors.New("Invalid Id"))
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t2.ID, 1).WillReturnError(errors.New("Invalid Id"))
 
-	err := str.DeleteTask(ctx, t2.ID)
+	err := str.DeleteTask(ctx, t2.ID, version.Any, stamp)
 	if err == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	mock.SQL.ExpectExec("DELETE FROM tasks WHERE id = ?").WithArgs(t1.ID).WillReturnResult(badResultForRowsAffected{})
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t1.ID, 1).WillReturnResult(badResultForRowsAffected{})
 
-	err = str.DeleteTask(ctx, t1.ID)
+	err = str.DeleteTask(ctx, t1.ID, version.Any, stamp)
 	if err == nil || err.Error() != "RowsAffected failed" {
 		t.Error("Rows affected fail")
 	}
 
-	mock.SQL.ExpectExec("DELETE FROM tasks WHERE id = ?").WithArgs(t1.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t1.ID, 1).WillReturnResult(sqlmock.NewResult(0, 0))
 
-	err = str.DeleteTask(ctx, t1.ID)
+	err = str.DeleteTask(ctx, t1.ID, version.Any, stamp)
+	if err != (errs.NotFound{Entity: "task", ID: t1.ID}) {
+		t.Errorf("expected errs.NotFound for a missing task, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t1.ID, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = str.DeleteTask(ctx, t1.ID, version.Any, stamp)
 	if err != nil {
 		t.Error("delete task fail")
 	}
 
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t1.ID, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = str.DeleteTask(ctx, t1.ID, 2, stamp)
+	if !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch for a stale version, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ? AND deleted_at IS NULL").WithArgs(stamp, t1.ID, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = str.DeleteTask(ctx, t1.ID, 2, stamp)
+	if err != nil {
+		t.Error("delete task fail")
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_RestoreTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "UPDATE tasks SET deleted_at = NULL, version = version + 1 WHERE id = ? AND workspace_id = ? AND deleted_at IS NOT NULL AND userid IS NOT NULL"
+
+	mock.SQL.ExpectExec(query).WithArgs(1, 1).WillReturnError(errors.New("Restore failed"))
+
+	err := str.RestoreTask(ctx, 1)
+	if err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = str.RestoreTask(ctx, 1)
+	if err != (errs.NotFound{Entity: "task", ID: 1}) {
+		t.Errorf("expected errs.NotFound for a task not in the trash, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = str.RestoreTask(ctx, 1)
+	if err != nil {
+		t.Error("restore task fail")
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_PurgeTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "DELETE FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ? " +
+		"AND NOT EXISTS (SELECT 1 FROM attachments WHERE attachments.task_id = tasks.id)"
+	before := time.Date(2026, 9, 18, 3, 0, 0, 0, time.UTC)
+
+	mock.SQL.ExpectExec(query).WithArgs(1, before).WillReturnError(errors.New("Purge failed"))
+
+	_, err := str.PurgeTask(ctx, before)
+	if err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(1, before).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	n, err := str.PurgeTask(ctx, before)
+	if err != nil || n != 3 {
+		t.Errorf("expected 3 purged tasks, got %d, %v", n, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetTrashedTasks(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND userid = ?").
+		WithArgs(1, 3).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(1))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE workspace_id = ? AND deleted_at IS NOT NULL AND userid = ?"+
+		" ORDER BY id ASC LIMIT ? OFFSET ?").
+		WithArgs(1, 3, 21, 0).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}).
+			AddRow(5, "abc", "todo", 3, 2, stamp, stamp, nil, "P2", nil, nil, nil, 0, deletedAt))
+
+	res, err := str.GetAllTask(ctx, task.Filter{Userid: 3, Trashed: true}, page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc})
+	if err != nil {
+		t.Fatalf("get trashed tasks fail: %v", err)
+	}
+
+	if len(res.Items) != 1 || res.Items[0].DeletedAt == nil || !res.Items[0].DeletedAt.Equal(deletedAt) {
+		t.Errorf("unexpected trash page: %+v", res)
+	}
+
 	if err := mock.SQL.ExpectationsWereMet(); err != nil {
 		t.Errorf("unmet SQL expectations: %v", err)
 	}
@@ -195,34 +479,49 @@ func Test_GetAllTasks(t *testing.T) {
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
+
+	q := page.Query{Limit: 2, Sort: "id", Order: page.OrderAsc}
+
+	countQuery := "SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL"
+	listQuery := "SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL ORDER BY id ASC LIMIT ? OFFSET ?"
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks").WillReturnError(errors.New("Unable to fetch all tasks"))
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1).WillReturnError(errors.New("Unable to count tasks"))
 
-	_, err := str.GetAllTask(ctx)
+	_, err := str.GetAllTask(ctx, task.Filter{}, q)
 	if err == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	rowWithScanErr := mock.SQL.NewRows([]string{"id", "description", "status", "userid"}).AddRow("av", "abc", false, "as").AddRow("asd", "def", true, "as")
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, 3, 0).WillReturnError(errors.New("Unable to fetch all tasks"))
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks").WillReturnRows(rowWithScanErr)
+	_, err = str.GetAllTask(ctx, task.Filter{}, q)
+	if err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	rowWithScanErr := mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}).AddRow("av", "abc", "todo", "as", 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil).AddRow("asd", "def", "done", "as", 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil)
 
-	_, err = str.GetAllTask(ctx)
-	if err == nil || !errors.Is(err, ErrScanUser) {
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, 3, 0).WillReturnRows(rowWithScanErr)
+
+	_, err = str.GetAllTask(ctx, task.Filter{}, q)
+	if err == nil || !errors.Is(err, ErrScanTask) {
 		t.Error("Got Scan error")
 	}
 
-	rows := mock.SQL.NewRows([]string{"id", "description", "status", "userid"}).AddRow(1, "abc", false, 1).AddRow(2, "def", true, 2)
+	rows := mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil).AddRow(2, "def", "done", 2, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil)
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks").WillReturnRows(rows)
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, 3, 0).WillReturnRows(rows)
 
-	tasks, err := str.GetAllTask(ctx)
+	tasks, err := str.GetAllTask(ctx, task.Filter{}, q)
 	if err != nil {
 		t.Error("get all tasks fail")
 	}
 
-	if len(tasks) != 2 {
+	if len(tasks.Items) != 2 || tasks.Total != 2 || tasks.NextCursor != "" {
 		t.Error("get all tasks fail")
 	}
 
@@ -232,6 +531,61 @@ func Test_GetAllTasks(t *testing.T) {
 
 }
 
+func Test_GetAllTasksFilteredPage(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	f := task.Filter{Status: task.StatusTodo, Userid: 3, ProjectID: 5, Text: "50%_off"}
+	q := page.Query{Limit: 1, Sort: "desc", Order: page.OrderDesc}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND userid = ? AND project_id = ? AND description LIKE ?").
+		WithArgs(1, task.StatusTodo, 3, 5, `%50\%\_off%`).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(3))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND userid = ? AND project_id = ? AND description LIKE ?"+
+		" ORDER BY description DESC, id DESC LIMIT ? OFFSET ?").
+		WithArgs(1, task.StatusTodo, 3, 5, `%50\%\_off%`, 2, 0).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}).
+			AddRow(7, "b 50%_off", "todo", 3, 1, stamp, stamp, nil, "P2", nil, nil, 5, 0, nil).AddRow(4, "a 50%_off", "todo", 3, 1, stamp, stamp, nil, "P2", nil, nil, 5, 0, nil))
+
+	first, err := str.GetAllTask(ctx, f, q)
+	if err != nil {
+		t.Fatalf("get first page fail: %v", err)
+	}
+
+	if len(first.Items) != 1 || first.Items[0].ID != 7 || *first.Items[0].ProjectID != 5 || first.Total != 3 || first.NextCursor == "" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+
+	next, err := page.NewQuery("1", "", first.NextCursor, "desc", "desc", task.SortKeys...)
+	if err != nil {
+		t.Fatalf("next cursor rejected: %v", err)
+	}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND userid = ? AND project_id = ? AND description LIKE ?").
+		WithArgs(1, task.StatusTodo, 3, 5, `%50\%\_off%`).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(3))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND status = ? AND userid = ? AND project_id = ? AND description LIKE ?"+
+		" AND (description < ? OR (description = ? AND id < ?)) ORDER BY description DESC, id DESC LIMIT ? OFFSET ?").
+		WithArgs(1, task.StatusTodo, 3, 5, `%50\%\_off%`, "b 50%_off", "b 50%_off", 7, 2, 0).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}).AddRow(4, "a 50%_off", "todo", 3, 1, stamp, stamp, nil, "P2", nil, nil, 5, 0, nil))
+
+	second, err := str.GetAllTask(ctx, f, next)
+	if err != nil {
+		t.Fatalf("get second page fail: %v", err)
+	}
+
+	if len(second.Items) != 1 || second.Items[0].ID != 4 || second.NextCursor != "" {
+		t.Errorf("unexpected second page: %+v", second)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
 func Test_GetTasksByUserIDTask(t *testing.T) {
 	mockContainer, mock := container.NewMockContainer(t)
 
@@ -239,31 +593,31 @@ func Test_GetTasksByUserIDTask(t *testing.T) {
 		Container: mockContainer,
 	}
 
-	str := NewStore()
+	str := NewStore(closed)
 
-	t1 := task.Task{1, "abc", false, 2}
-	t2 := task.Task{1, "abc", false, 2}
+	t1 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
+	t2 := task.Task{ID: 1, Desc: "abc", Status: task.StatusTodo, Userid: 2}
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks where userid =?").WithArgs(t1.Userid).WillReturnError(errors.New("Not found"))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks where userid =? AND workspace_id = ? AND deleted_at IS NULL ORDER BY priority, due_at IS NULL, due_at, created_at, id").WithArgs(t1.Userid, 1).WillReturnError(errors.New("Not found"))
 
 	_, err := str.GetTasksByUserIDTask(ctx, t2.Userid)
 	if err == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	rowWithScanErr := mock.SQL.NewRows([]string{"id", "description", "status", "userid"}).AddRow(1, "abc", false, 1).AddRow("dwa", "def", true, "dad")
+	rowWithScanErr := mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0).AddRow("dwa", "def", "done", "dad", 1, stamp, stamp, nil, "P2", nil, nil, nil, 0)
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks where userid =?").
-		WithArgs(t2.Userid).WillReturnRows(rowWithScanErr)
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks where userid =? AND workspace_id = ? AND deleted_at IS NULL ORDER BY priority, due_at IS NULL, due_at, created_at, id").
+		WithArgs(t2.Userid, 1).WillReturnRows(rowWithScanErr)
 
 	_, err = str.GetTasksByUserIDTask(ctx, t2.Userid)
-	if err == nil || !errors.Is(err, ErrScanUser) {
+	if err == nil || !errors.Is(err, ErrScanTask) {
 		t.Error("Got Scan error")
 	}
 
-	rows := mock.SQL.NewRows([]string{"id", "description", "status", "userid"}).AddRow(1, "abc", false, 1).AddRow(2, "def", true, 1)
+	rows := mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0).AddRow(2, "def", "done", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0)
 
-	mock.SQL.ExpectQuery("SELECT id, description, status , userid FROM tasks where userid =?").WithArgs(t2.Userid).WillReturnRows(rows)
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks where userid =? AND workspace_id = ? AND deleted_at IS NULL ORDER BY priority, due_at IS NULL, due_at, created_at, id").WithArgs(t2.Userid, 1).WillReturnRows(rows)
 
 	tasks, err := str.GetTasksByUserIDTask(ctx, t2.Userid)
 	if err != nil {
@@ -278,3 +632,148 @@ func Test_GetTasksByUserIDTask(t *testing.T) {
 		t.Errorf("unmet SQL expectations: %v", err)
 	}
 }
+
+func Test_GetOverdueAndDueSoonTasks(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+	cols := []string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND due_at < ? AND status NOT IN (?, ?)").
+		WithArgs(1, stamp, task.StatusDone, task.StatusCancelled).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(1))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks "+
+		"WHERE workspace_id = ? AND deleted_at IS NULL AND due_at < ? AND status NOT IN (?, ?) ORDER BY id ASC LIMIT ? OFFSET ?").
+		WithArgs(1, stamp, task.StatusDone, task.StatusCancelled, 21, 0).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, stamp.Add(-time.Hour), "P2", nil, nil, nil, 0, nil))
+
+	overdue, err := str.GetAllTask(ctx, task.Filter{Overdue: true, Now: stamp}, q)
+	if err != nil || len(overdue.Items) != 1 || !overdue.Items[0].DueAt.Equal(stamp.Add(-time.Hour)) {
+		t.Errorf("unexpected overdue page: %+v, %v", overdue, err)
+	}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE workspace_id = ? AND deleted_at IS NULL AND due_at >= ? AND due_at < ? AND status NOT IN (?, ?)").
+		WithArgs(1, stamp, stamp.Add(24*time.Hour), task.StatusDone, task.StatusCancelled).
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks "+
+		"WHERE workspace_id = ? AND deleted_at IS NULL AND due_at >= ? AND due_at < ? AND status NOT IN (?, ?) ORDER BY id ASC LIMIT ? OFFSET ?").
+		WithArgs(1, stamp, stamp.Add(24*time.Hour), task.StatusDone, task.StatusCancelled, 21, 0).
+		WillReturnRows(mock.SQL.NewRows(cols))
+
+	soon, err := str.GetAllTask(ctx, task.Filter{DueWithin: 24 * time.Hour, Now: stamp}, q)
+	if err != nil || len(soon.Items) != 0 {
+		t.Errorf("unexpected due soon page: %+v, %v", soon, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetDueTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks " +
+		"WHERE workspace_id = ? AND deleted_at IS NULL AND reminded_at IS NULL AND due_at <= ? AND status NOT IN (?, ?) ORDER BY due_at, id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp, task.StatusDone, task.StatusCancelled).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetDueTask(ctx, stamp); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp, task.StatusDone, task.StatusCancelled).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).
+			AddRow("x", "abc", "todo", 1, 1, stamp, stamp, stamp, "P2", nil, nil, nil, 0))
+
+	if _, err := str.GetDueTask(ctx, stamp); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected ErrScanTask, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, stamp, task.StatusDone, task.StatusCancelled).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).
+			AddRow(1, "abc", "todo", 1, 1, stamp, stamp, stamp.Add(-time.Minute), "P2", nil, nil, nil, 0))
+
+	tasks, err := str.GetDueTask(ctx, stamp)
+	if err != nil || len(tasks) != 1 || tasks[0].ID != 1 || tasks[0].DueAt == nil {
+		t.Errorf("unexpected due tasks: %+v, %v", tasks, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_MarkRemindedTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("UPDATE tasks SET reminded_at = ? WHERE id = ? AND workspace_id = ?").WithArgs(stamp, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.MarkRemindedTask(ctx, 1, stamp); err != nil {
+		t.Errorf("mark reminded fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetNextTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) FROM tasks " +
+		"WHERE userid = ? AND workspace_id = ? AND deleted_at IS NULL AND status NOT IN (?, ?) ORDER BY priority, due_at IS NULL, due_at, created_at, id LIMIT 1"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1, task.StatusDone, task.StatusCancelled).WillReturnError(sql.ErrNoRows)
+
+	_, err := str.GetNextTask(ctx, 3)
+	if err != (errs.NotFound{Entity: "open task for user", ID: 3}) {
+		t.Errorf("expected errs.NotFound when the user has no open task, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1, task.StatusDone, task.StatusCancelled).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments"}).
+			AddRow(8, "Fix outage", "in_progress", 3, 4, stamp, stamp, due, "P0", nil, nil, nil, 0))
+
+	next, err := str.GetNextTask(ctx, 3)
+	if err != nil || next.ID != 8 || next.Priority != task.PriorityP0 {
+		t.Errorf("unexpected next task: %+v, %v", next, err)
+	}
+
+	// the closed statuses are those of the workflow the store was made with
+	custom := NewStore([]task.Status{"shipped"})
+
+	mock.SQL.ExpectQuery(strings.Replace(query, "NOT IN (?, ?)", "NOT IN (?)", 1)).WithArgs(3, 1, task.Status("shipped")).
+		WillReturnError(sql.ErrNoRows)
+
+	if _, err := custom.GetNextTask(ctx, 3); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
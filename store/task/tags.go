@@ -0,0 +1,109 @@
+package task
+
+import (
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"strings"
+)
+
+// AttachTagTask attaches a tag to a task, creating the tag on first use. Attaching it twice is a no-op. Tag names are
+// shared by all workspaces, but a workspace only sees the tags of its own tasks
+func (*Store) AttachTagTask(c *gofr.Context, id int, name string) error {
+	DB := sqlutil.DB(c)
+
+	if _, err := DB.Exec("INSERT IGNORE INTO tags (name) VALUES (?)", name); err != nil {
+		return err
+	}
+
+	_, err := DB.Exec("INSERT IGNORE INTO task_tags (task_id, tag_id) SELECT tk.id, t.id FROM tasks tk JOIN tags t ON t.name = ? "+
+		"WHERE tk.id = ? AND tk.workspace_id = ?", name, id, workspace.ID(c))
+
+	return err
+}
+
+// DetachTagTask removes a tag from a task. Detaching a tag the task does not carry is a no-op
+func (*Store) DetachTagTask(c *gofr.Context, id int, name string) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("DELETE tt FROM task_tags tt JOIN tags t ON t.id = tt.tag_id JOIN tasks tk ON tk.id = tt.task_id "+
+		"WHERE tt.task_id = ? AND tk.workspace_id = ? AND t.name = ?", id, workspace.ID(c), name)
+
+	return err
+}
+
+// GetTagsByTaskIDTask returns the names of the tags of a task, in alphabetical order
+func (*Store) GetTagsByTaskIDTask(c *gofr.Context, id int) ([]string, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT t.name FROM tags t JOIN task_tags tt ON tt.tag_id = t.id JOIN tasks tk ON tk.id = tt.task_id "+
+		"WHERE tt.task_id = ? AND tk.workspace_id = ? ORDER BY t.name", id, workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tags := []string{}
+
+	for rows.Next() {
+		var name string
+
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		tags = append(tags, name)
+	}
+
+	return tags, rows.Err()
+}
+
+// GetAllTagsTask returns every tag carried by a task of the workspace, trashed ones included, with the number of live
+// tasks carrying it, in alphabetical order
+func (*Store) GetAllTagsTask(c *gofr.Context) ([]task.Tag, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT t.name, COUNT(CASE WHEN tk.deleted_at IS NULL THEN 1 END) FROM tags t "+
+		"JOIN task_tags tt ON tt.tag_id = t.id JOIN tasks tk ON tk.id = tt.task_id WHERE tk.workspace_id = ? "+
+		"GROUP BY t.id, t.name ORDER BY t.name", workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tags := []task.Tag{}
+
+	for rows.Next() {
+		var t task.Tag
+
+		if err := rows.Scan(&t.Name, &t.Tasks); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTask, err)
+		}
+
+		tags = append(tags, t)
+	}
+
+	return tags, rows.Err()
+}
+
+// tagFilter is the condition keeping the tasks that carry any of the tags, or all of them
+func tagFilter(tags []string, all bool) (string, []any) {
+	args := make([]any, len(tags), len(tags)+1)
+	for i, t := range tags {
+		args[i] = t
+	}
+
+	cond := "id IN (SELECT tt.task_id FROM task_tags tt JOIN tags t ON t.id = tt.tag_id WHERE t.name IN (?" +
+		strings.Repeat(", ?", len(tags)-1) + ")"
+
+	if all {
+		cond += " GROUP BY tt.task_id HAVING COUNT(*) = ?"
+		args = append(args, len(tags))
+	}
+
+	return cond + ")", args
+}
@@ -0,0 +1,178 @@
+package task
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/page"
+	"github.com/MGajendra22/GoFr/model/task"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+)
+
+func Test_AttachTagTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("INSERT IGNORE INTO tags (name) VALUES (?)").WithArgs("bug").WillReturnError(errors.New("Insert failed"))
+
+	if err := str.AttachTagTask(ctx, 1, "bug"); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec("INSERT IGNORE INTO tags (name) VALUES (?)").WithArgs("bug").WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.SQL.ExpectExec("INSERT IGNORE INTO task_tags (task_id, tag_id) SELECT tk.id, t.id FROM tasks tk JOIN tags t ON t.name = ? "+
+		"WHERE tk.id = ? AND tk.workspace_id = ?").WithArgs("bug", 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.AttachTagTask(ctx, 1, "bug"); err != nil {
+		t.Errorf("attach tag fail: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DetachTagTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	mock.SQL.ExpectExec("DELETE tt FROM task_tags tt JOIN tags t ON t.id = tt.tag_id JOIN tasks tk ON tk.id = tt.task_id "+
+		"WHERE tt.task_id = ? AND tk.workspace_id = ? AND t.name = ?").WithArgs(1, 1, "bug").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DetachTagTask(ctx, 1, "bug"); err != nil {
+		t.Errorf("detaching a missing tag must be a no-op, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetTagsByTaskIDTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT t.name FROM tags t JOIN task_tags tt ON tt.tag_id = t.id JOIN tasks tk ON tk.id = tt.task_id " +
+		"WHERE tt.task_id = ? AND tk.workspace_id = ? ORDER BY t.name"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnError(errors.New("Not found"))
+
+	if _, err := str.GetTagsByTaskIDTask(ctx, 1); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"name"}))
+
+	tags, err := str.GetTagsByTaskIDTask(ctx, 1)
+	if err != nil || tags == nil || len(tags) != 0 {
+		t.Errorf("expected an empty list for an untagged task, got %v, %v", tags, err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"name"}).AddRow("backend").AddRow("bug"))
+
+	tags, err = str.GetTagsByTaskIDTask(ctx, 1)
+	if err != nil || !reflect.DeepEqual(tags, []string{"backend", "bug"}) {
+		t.Errorf("unexpected tags: %v, %v", tags, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllTagsTask(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	query := "SELECT t.name, COUNT(CASE WHEN tk.deleted_at IS NULL THEN 1 END) FROM tags t " +
+		"JOIN task_tags tt ON tt.tag_id = t.id JOIN tasks tk ON tk.id = tt.task_id WHERE tk.workspace_id = ? " +
+		"GROUP BY t.id, t.name ORDER BY t.name"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnError(errors.New("Unable to fetch tags"))
+
+	if _, err := str.GetAllTagsTask(ctx); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(mock.SQL.NewRows([]string{"name", "count"}).AddRow("backend", "x"))
+
+	if _, err := str.GetAllTagsTask(ctx); !errors.Is(err, ErrScanTask) {
+		t.Errorf("expected ErrScanTask, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(mock.SQL.NewRows([]string{"name", "count"}).AddRow("backend", 3).AddRow("bug", 0))
+
+	tags, err := str.GetAllTagsTask(ctx)
+	if err != nil || !reflect.DeepEqual(tags, []task.Tag{{Name: "backend", Tasks: 3}, {Name: "bug", Tasks: 0}}) {
+		t.Errorf("unexpected tags: %v, %v", tags, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllTasksByTags(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore(closed)
+
+	q := page.Query{Limit: 20, Sort: "id", Order: page.OrderAsc}
+	cols := []string{"id", "description", "status", "userid", "version", "created_at", "updated_at", "due_at", "priority", "parent_id", "estimate", "project_id", "comments", "deleted_at"}
+	anyOf := "workspace_id = ? AND deleted_at IS NULL AND id IN (SELECT tt.task_id FROM task_tags tt JOIN tags t ON t.id = tt.tag_id WHERE t.name IN (?, ?))"
+	allOf := "workspace_id = ? AND deleted_at IS NULL AND id IN (SELECT tt.task_id FROM task_tags tt JOIN tags t ON t.id = tt.tag_id WHERE t.name IN (?, ?)" +
+		" GROUP BY tt.task_id HAVING COUNT(*) = ?)"
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE "+anyOf).WithArgs(1, "backend", "bug").
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE "+
+		anyOf+" ORDER BY id ASC LIMIT ? OFFSET ?").WithArgs(1, "backend", "bug", 21, 0).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil).
+			AddRow(2, "def", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil))
+
+	res, err := str.GetAllTask(ctx, task.Filter{Tags: []string{"backend", "bug"}}, q)
+	if err != nil || len(res.Items) != 2 {
+		t.Errorf("unexpected page for any of the tags: %+v, %v", res, err)
+	}
+
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE "+allOf).WithArgs(1, "backend", "bug", 2).
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(1))
+	mock.SQL.ExpectQuery("SELECT id, description, status, COALESCE(userid, 0), version, created_at, updated_at, due_at, priority, parent_id, estimate, project_id, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id), deleted_at FROM tasks WHERE "+
+		allOf+" ORDER BY id ASC LIMIT ? OFFSET ?").WithArgs(1, "backend", "bug", 2, 21, 0).
+		WillReturnRows(mock.SQL.NewRows(cols).AddRow(1, "abc", "todo", 1, 1, stamp, stamp, nil, "P2", nil, nil, nil, 0, nil))
+
+	res, err = str.GetAllTask(ctx, task.Filter{Tags: []string{"backend", "bug"}, AllTags: true}, q)
+	if err != nil || len(res.Items) != 1 {
+		t.Errorf("unexpected page for all of the tags: %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
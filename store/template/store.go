@@ -0,0 +1,239 @@
+package template
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// Store keeps the task templates of the workspace of the request, along with their tasks.
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanTemplate = errors.New("scan template failed")
+
+// templateColumns are the columns read into a template.Template, in the order of templateFields
+const templateColumns = "id, name, version, created_at, updated_at"
+
+// templateFields are the scan destinations of templateColumns
+func templateFields(t *template.Template) []any {
+	return []any{&t.ID, &t.Name, &t.Version, &t.CreatedAt, &t.UpdatedAt}
+}
+
+// CreateTemplate inserts a new template along with its tasks, all in one transaction
+func (*Store) CreateTemplate(c *gofr.Context, t template.Template) (template.Template, error) {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return t, err
+	}
+
+	res, err := tx.Exec("INSERT INTO templates (workspace_id, name, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		workspace.ID(c), t.Name, t.CreatedAt, t.UpdatedAt)
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return t, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return t, err
+	}
+
+	t.ID = int(id)
+	t.Version = 1
+
+	if err := insertItems(tx, t.ID, t.Tasks); err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return t, err
+	}
+
+	return t, tx.Commit()
+}
+
+// GetByIDTemplate fetches a template by its ID, along with its tasks
+func (*Store) GetByIDTemplate(c *gofr.Context, id int) (template.Template, error) {
+	DB := sqlutil.DB(c)
+
+	var t template.Template
+
+	err := DB.QueryRow("SELECT "+templateColumns+" FROM templates WHERE id = ? AND workspace_id = ?", id, workspace.ID(c)).
+		Scan(templateFields(&t)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, errs.NotFound{Entity: "template", ID: id}
+	}
+
+	if err != nil {
+		return t, err
+	}
+
+	items, err := getItems(c, id)
+	if err != nil {
+		return t, err
+	}
+
+	t.Tasks = items[id]
+
+	return t, nil
+}
+
+// GetAllTemplate returns every template, in name order, along with their tasks
+func (*Store) GetAllTemplate(c *gofr.Context) ([]template.Template, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT "+templateColumns+" FROM templates WHERE workspace_id = ? ORDER BY name, id", workspace.ID(c))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	templates := []template.Template{}
+
+	for rows.Next() {
+		var t template.Template
+
+		if err := rows.Scan(templateFields(&t)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTemplate, err)
+		}
+
+		templates = append(templates, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	items, err := getItems(c, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range templates {
+		templates[i].Tasks = items[templates[i].ID]
+	}
+
+	return templates, nil
+}
+
+// UpdateTemplate replaces the name and tasks of a template if it is still at t.Version, and bumps its version
+func (*Store) UpdateTemplate(c *gofr.Context, t template.Template) error {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return err
+	}
+
+	if err := updateTemplate(tx, workspace.ID(c), t); err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func updateTemplate(tx sqlutil.Runner, ws int, t template.Template) error {
+	res, err := tx.Exec("UPDATE templates SET name = ?, updated_at = ?, version = version + 1 "+
+		"WHERE id = ? AND version = ? AND workspace_id = ?", t.Name, t.UpdatedAt, t.ID, t.Version, ws)
+	if err := sqlutil.VersionChecked(res, err); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM template_tasks WHERE template_id = ?", t.ID); err != nil {
+		return err
+	}
+
+	return insertItems(tx, t.ID, t.Tasks)
+}
+
+// DeleteTemplate removes a template along with its tasks, leaving the tasks created from it as they are. Unless ver
+// is version.Any the template is only removed if it is still at that version
+func (*Store) DeleteTemplate(c *gofr.Context, id, ver int) error {
+	DB := sqlutil.DB(c)
+
+	if ver != version.Any {
+		res, err := DB.Exec("DELETE FROM templates WHERE id = ? AND version = ? AND workspace_id = ?", id, ver, workspace.ID(c))
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := DB.Exec("DELETE FROM templates WHERE id = ? AND workspace_id = ?", id, workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "template", ID: id}
+	}
+
+	return nil
+}
+
+// insertItems inserts the tasks of a template in their order
+func insertItems(tx sqlutil.Runner, templateID int, items []template.Item) error {
+	for i, it := range items {
+		_, err := tx.Exec("INSERT INTO template_tasks (template_id, position, description, priority, estimate) VALUES (?, ?, ?, ?, ?)",
+			templateID, i, it.Desc, it.Priority, it.Estimate)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getItems returns the tasks of a template, or of every template of the workspace if templateID is 0, in their
+// order, by template id
+func getItems(c *gofr.Context, templateID int) (map[int][]template.Item, error) {
+	DB := sqlutil.DB(c)
+
+	query, args := "SELECT tt.template_id, tt.description, tt.priority, tt.estimate FROM template_tasks tt "+
+		"JOIN templates t ON t.id = tt.template_id WHERE t.workspace_id = ?", []any{workspace.ID(c)}
+
+	if templateID != 0 {
+		query += " AND tt.template_id = ?"
+		args = append(args, templateID)
+	}
+
+	rows, err := DB.Query(query+" ORDER BY tt.template_id, tt.position", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := make(map[int][]template.Item)
+
+	for rows.Next() {
+		var (
+			templateID int
+			it         template.Item
+		)
+
+		if err := rows.Scan(&templateID, &it.Desc, &it.Priority, &it.Estimate); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanTemplate, err)
+		}
+
+		items[templateID] = append(items[templateID], it)
+	}
+
+	return items, rows.Err()
+}
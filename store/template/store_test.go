@@ -0,0 +1,237 @@
+package template
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/task"
+	"github.com/MGajendra22/GoFr/model/template"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+var (
+	templateCols = []string{"id", "name", "version", "created_at", "updated_at"}
+	itemCols     = []string{"template_id", "description", "priority", "estimate"}
+)
+
+const (
+	insertItem  = "INSERT INTO template_tasks (template_id, position, description, priority, estimate) VALUES (?, ?, ?, ?, ?)"
+	selectItems = "SELECT tt.template_id, tt.description, tt.priority, tt.estimate FROM template_tasks tt " +
+		"JOIN templates t ON t.id = tt.template_id WHERE t.workspace_id = ?"
+)
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 0, fmt.Errorf("RowsAffected failed")
+}
+
+func Test_CreateTemplate(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	tpl := template.Template{Name: "Onboarding", CreatedAt: stamp, UpdatedAt: stamp,
+		Tasks: []template.Item{{Desc: "Set up a laptop for {{user.name}}"}, {Desc: "Read handbook", Priority: task.PriorityP1}}}
+	insert := "INSERT INTO templates (workspace_id, name, created_at, updated_at) VALUES (?, ?, ?, ?)"
+
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+	if _, err := str.CreateTemplate(ctx, tpl); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Onboarding", stamp, stamp).WillReturnResult(badResult{})
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.CreateTemplate(ctx, tpl); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(1, "Onboarding", stamp, stamp).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.SQL.ExpectExec(insertItem).WithArgs(3, 0, "Set up a laptop for {{user.name}}", "", nil).
+		WillReturnError(errors.New("Insert failed"))
+	mock.SQL.ExpectRollback()
+
+	if _, err := str.CreateTemplate(ctx, tpl); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(insert).WithArgs(2, "Onboarding", stamp, stamp).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.SQL.ExpectExec(insertItem).WithArgs(3, 0, "Set up a laptop for {{user.name}}", "", nil).
+		WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.SQL.ExpectExec(insertItem).WithArgs(3, 1, "Read handbook", "P1", nil).WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.SQL.ExpectCommit()
+
+	res, err := str.CreateTemplate(workspace.In(ctx, 2), tpl)
+	if err != nil || res.ID != 3 || res.Version != 1 || len(res.Tasks) != 2 {
+		t.Errorf("expected template 3 with 2 tasks, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDTemplate(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, version, created_at, updated_at FROM templates WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDTemplate(ctx, 3); err != (errs.NotFound{Entity: "template", ID: 3}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(templateCols).AddRow(3, "Onboarding", 2, stamp, stamp))
+	mock.SQL.ExpectQuery(selectItems+" AND tt.template_id = ? ORDER BY tt.template_id, tt.position").WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows(itemCols).AddRow(3, "Set up laptop", "", nil).AddRow(3, "Read handbook", "P1", 2))
+
+	res, err := str.GetByIDTemplate(ctx, 3)
+
+	want := template.Template{ID: 3, Name: "Onboarding", Version: 2, CreatedAt: stamp, UpdatedAt: stamp,
+		Tasks: []template.Item{{Desc: "Set up laptop"}, {Desc: "Read handbook", Priority: task.PriorityP1, Estimate: &[]int{2}[0]}}}
+
+	if err != nil || !reflect.DeepEqual(res, want) {
+		t.Errorf("expected %+v, got %+v, %v", want, res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllTemplate(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, version, created_at, updated_at FROM templates WHERE workspace_id = ? ORDER BY name, id"
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(templateCols).AddRow("x", "Onboarding", 1, stamp, stamp))
+
+	if _, err := str.GetAllTemplate(ctx); !errors.Is(err, ErrScanTemplate) {
+		t.Errorf("expected ErrScanTemplate, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(templateCols).
+		AddRow(4, "Offboarding", 1, stamp, stamp).AddRow(3, "Onboarding", 1, stamp, stamp))
+	mock.SQL.ExpectQuery(selectItems + " ORDER BY tt.template_id, tt.position").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(itemCols).AddRow(3, "Set up laptop", "", nil).AddRow(3, "Read handbook", "", nil).
+			AddRow(4, "Return laptop", "", nil))
+
+	templates, err := str.GetAllTemplate(ctx)
+	if err != nil || len(templates) != 2 || len(templates[0].Tasks) != 1 || len(templates[1].Tasks) != 2 {
+		t.Errorf("unexpected templates: %+v, %v", templates, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_UpdateTemplate(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	tpl := template.Template{ID: 3, Name: "Onboarding", Version: 2, UpdatedAt: stamp, Tasks: []template.Item{{Desc: "Meet the team"}}}
+	update := "UPDATE templates SET name = ?, updated_at = ?, version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(update).WithArgs("Onboarding", stamp, 3, 2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectRollback()
+
+	if err := str.UpdateTemplate(ctx, tpl); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec(update).WithArgs("Onboarding", stamp, 3, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("DELETE FROM template_tasks WHERE template_id = ?").WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.SQL.ExpectExec(insertItem).WithArgs(3, 0, "Meet the team", "", nil).WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.UpdateTemplate(ctx, tpl); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_DeleteTemplate(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	mock.SQL.ExpectExec("DELETE FROM templates WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(3, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteTemplate(ctx, 3, 2); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version mismatch, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM templates WHERE id = ? AND workspace_id = ?").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteTemplate(ctx, 3, version.Any); err != (errs.NotFound{Entity: "template", ID: 3}) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM templates WHERE id = ? AND workspace_id = ?").WithArgs(3, 1).WillReturnResult(badResult{})
+
+	if err := str.DeleteTemplate(ctx, 3, version.Any); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec("DELETE FROM templates WHERE id = ? AND workspace_id = ?").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteTemplate(ctx, 3, version.Any); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
@@ -1,12 +1,22 @@
 package user
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	"github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
 	"gofr.dev/pkg/gofr"
+	"strconv"
+	"time"
 )
 
+// UserStore scopes every query to the workspace of the request, workspace.ID: users of other workspaces are neither
+// seen nor changed.
 type UserStore struct {
 }
 
@@ -16,70 +26,263 @@ func NewUserStore() *UserStore {
 
 var ErrScanUser = errors.New("scan user failed")
 
+// userColumns are the columns read into a user.User, in the order of userFields
+const userColumns = "id, name, email, role, version"
+
+// userFields are the scan destinations of userColumns
+func userFields(u *user.User) []any {
+	return []any{&u.ID, &u.Name, &u.Email, &u.Role, &u.Version}
+}
+
+// CreateUser inserts a user into the workspace of the request, which must exist
 func (*UserStore) CreateUser(c *gofr.Context, user user.User) (user.User, error) {
-	DB := c.SQL
+	DB := sqlutil.DB(c)
+	ws := workspace.ID(c)
 
-	query := "INSERT INTO users (name, email) VALUES (?, ?)"
+	query := "INSERT INTO users (workspace_id, name, email, role, password_hash) SELECT id, ?, ?, ?, ? FROM workspaces WHERE id = ?"
+
+	result, err := DB.Exec(query, user.Name, user.Email, user.Role, passwordHash(user), ws)
+	if err != nil {
+		return user, err
+	}
 
-	result, err := DB.Exec(query, user.Name, user.Email)
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return user, err
 	}
 
+	if affected == 0 {
+		return user, errs.DependencyMissing{Entity: "workspace", ID: ws}
+	}
+
 	id, err := result.LastInsertId()
 	if err != nil {
 		return user, err
 	}
 
 	user.ID = int(id)
+	user.Version = 1
 
 	return user, nil
 }
 
 func (*UserStore) GetByIDUser(c *gofr.Context, id int) (user.User, error) {
-	DB := c.SQL
+	DB := sqlutil.DB(c)
 
 	var user user.User
 
-	query := "SELECT id, name, email FROM users WHERE id = ?"
+	query := "SELECT " + userColumns + " FROM users WHERE id = ? AND workspace_id = ?"
 
-	err := DB.QueryRow(query, id).Scan(&user.ID, &user.Name, &user.Email)
-	if err != nil {
-		return user, err
+	err := DB.QueryRow(query, id, workspace.ID(c)).Scan(userFields(&user)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return user, errs.NotFound{Entity: "user", ID: id}
 	}
 
 	return user, err
 }
 
-func (*UserStore) DeleteUser(c *gofr.Context, id int) error {
-	DB := c.SQL
+// GetByEmailUser returns the user with the given email together with its password hash, which is empty if the user
+// has no password
+func (*UserStore) GetByEmailUser(c *gofr.Context, email string) (user.User, error) {
+	DB := sqlutil.DB(c)
+
+	var u user.User
+
+	query := "SELECT " + userColumns + ", COALESCE(password_hash, '') FROM users WHERE email = ? AND workspace_id = ?"
+
+	err := DB.QueryRow(query, email, workspace.ID(c)).Scan(append(userFields(&u), &u.PasswordHash)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return u, errs.NotFound{Entity: "user"}
+	}
+
+	return u, err
+}
+
+// UpdateUser replaces the name, email and role of a user if it is still at u.Version, and bumps its version. The
+// password hash is only replaced if u carries a new one
+func (*UserStore) UpdateUser(c *gofr.Context, u user.User) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("UPDATE users SET name = ?, email = ?, role = ?, password_hash = COALESCE(?, password_hash), "+
+		"version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?",
+		u.Name, u.Email, u.Role, passwordHash(u), u.ID, u.Version, workspace.ID(c))
+
+	return sqlutil.VersionChecked(res, err)
+}
+
+// passwordHash is the password hash column value of u, NULL if it has none
+func passwordHash(u user.User) sql.NullString {
+	return sql.NullString{String: u.PasswordHash, Valid: u.PasswordHash != ""}
+}
+
+// DeleteUser removes a user by ID and applies p to their tasks and recurrences at the given time, all in one
+// transaction. Unless ver is version.Any the user is only removed if it is still at that version
+func (*UserStore) DeleteUser(c *gofr.Context, id, ver int, p user.DeletePolicy, at time.Time) error {
+	tx, err := sqlutil.Begin(c)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteUser(tx, workspace.ID(c), id, ver, p, at); err != nil {
+		sqlutil.Rollback(c, tx)
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func deleteUser(tx sqlutil.Runner, ws, id, ver int, p user.DeletePolicy, at time.Time) error {
+	switch p.Tasks {
+	case user.OnDeleteCascade:
+		if _, err := tx.Exec("DELETE FROM task_recurrences WHERE userid = ? AND workspace_id = ?", id, ws); err != nil {
+			return err
+		}
+
+		// the tasks go to the trash as deleted tasks do, keeping their subtasks and their content until purged. They
+		// lose their user, so they cannot be restored
+		if _, err := tx.Exec("UPDATE tasks SET deleted_at = COALESCE(deleted_at, ?), userid = NULL, version = version + 1 "+
+			"WHERE userid = ? AND workspace_id = ?", at, id, ws); err != nil {
+			return err
+		}
+	case user.OnDeleteReassign:
+		if err := checkMember(tx, ws, id, p.ReassignTo); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec("UPDATE task_recurrences SET userid = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?",
+			p.ReassignTo, id, ws); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec("UPDATE tasks SET userid = ?, updated_at = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?",
+			p.ReassignTo, at, id, ws); err != nil {
+			return err
+		}
+	default:
+		// the foreign keys reject the delete as well, this only turns it into a readable conflict
+		var tasks, recurrences int
+
+		if err := tx.QueryRow("SELECT COUNT(*) FROM tasks WHERE userid = ? AND workspace_id = ?", id, ws).Scan(&tasks); err != nil {
+			return err
+		}
+
+		if err := tx.QueryRow("SELECT COUNT(*) FROM task_recurrences WHERE userid = ? AND workspace_id = ?", id, ws).
+			Scan(&recurrences); err != nil {
+			return err
+		}
+
+		if tasks > 0 || recurrences > 0 {
+			return errs.Conflict{Entity: "user", ID: id, Reason: fmt.Sprintf("still has %d tasks and %d recurrences, "+
+				"delete them with tasks=cascade or move them with tasks=reassign", tasks, recurrences)}
+		}
+	}
+
+	if ver != version.Any {
+		res, err := tx.Exec("DELETE FROM users WHERE id = ? AND version = ? AND workspace_id = ?", id, ver, ws)
+
+		return sqlutil.VersionChecked(res, err)
+	}
+
+	res, err := tx.Exec("DELETE FROM users WHERE id = ? AND workspace_id = ?", id, ws)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errs.NotFound{Entity: "user", ID: id}
+	}
+
+	return nil
+}
+
+// checkMember checks that the user the live tasks and the recurrences of user id are reassigned to is a member of their
+// projects, as they could not be assigned to them otherwise
+func checkMember(tx sqlutil.Runner, ws, id, to int) error {
+	var project sql.NullInt64
 
-	_, err := DB.Exec("DELETE FROM users WHERE id = ?", id)
+	err := tx.QueryRow("SELECT MIN(project_id) FROM (SELECT project_id FROM tasks WHERE userid = ? AND workspace_id = ? "+
+		"AND deleted_at IS NULL UNION SELECT project_id FROM task_recurrences WHERE userid = ? AND workspace_id = ?) owned "+
+		"WHERE project_id IS NOT NULL AND project_id NOT IN (SELECT project_id FROM project_members WHERE user_id = ?)",
+		id, ws, id, ws, to).Scan(&project)
+	if err != nil {
+		return err
+	}
+
+	if project.Valid {
+		return errs.Validation{Field: "reassign_to", Reason: fmt.Sprintf("must be a member of project %d", project.Int64)}
+	}
 
-	return err
+	return nil
 }
 
-func (*UserStore) GetAllUser(c *gofr.Context) ([]user.User, error) {
-	DB := c.SQL
+// sortColumns maps the sort keys accepted by GetAllUser to their columns
+var sortColumns = map[string]string{"id": "id", "name": "name", "email": "email"}
+
+// GetAllUser returns one page of the users matching the filter, along with the total number of matches
+func (*UserStore) GetAllUser(c *gofr.Context, f user.Filter, q page.Query) (page.Page[user.User], error) {
+	DB := sqlutil.DB(c)
+
+	res := page.Page[user.User]{Items: []user.User{}, Limit: q.Limit, Offset: q.Offset}
+
+	conds, args := []string{"workspace_id = ?"}, []any{workspace.ID(c)}
+
+	if f.Text != "" {
+		pattern := sqlutil.Contains(f.Text)
+		conds = append(conds, "(name LIKE ? OR email LIKE ?)")
+		args = append(args, pattern, pattern)
+	}
+
+	err := DB.QueryRow("SELECT COUNT(*) FROM users"+sqlutil.Where(conds), args...).Scan(&res.Total)
+	if err != nil {
+		return res, err
+	}
 
-	query := "SELECT id, name, email FROM users"
+	order, conds, args := sqlutil.Keyset(q, sortColumns[q.Sort], conds, args)
 
-	rows, err := DB.Query(query)
+	// one row more than the page size tells whether there is a next page
+	args = append(args, q.Limit+1, q.Offset)
+
+	rows, err := DB.Query("SELECT "+userColumns+" FROM users"+sqlutil.Where(conds)+" ORDER BY "+order+" LIMIT ? OFFSET ?", args...)
 	if err != nil {
-		return nil, err
+		return res, err
 	}
 
-	var users []user.User
+	defer rows.Close()
 
 	for rows.Next() {
 		var u user.User
 
-		if err := rows.Scan(&u.ID, &u.Name, &u.Email); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrScanUser, err)
+		if err := rows.Scan(userFields(&u)...); err != nil {
+			return res, fmt.Errorf("%w: %v", ErrScanUser, err)
 		}
 
-		users = append(users, u)
+		res.Items = append(res.Items, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return res, err
 	}
 
-	return users, nil
+	res.Items, res.NextCursor = sqlutil.NextPage(q, res.Items, func(u user.User) (string, int) {
+		return sortValue(u, q.Sort), u.ID
+	})
+
+	return res, nil
+}
+
+func sortValue(u user.User, sort string) string {
+	switch sort {
+	case "name":
+		return u.Name
+	case "email":
+		return u.Email
+	default:
+		return strconv.Itoa(u.ID)
+	}
 }
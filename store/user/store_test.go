@@ -1,13 +1,19 @@
 package user
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/page"
 	user "github.com/MGajendra22/GoFr/model/user"
+	"github.com/MGajendra22/GoFr/model/version"
+	"github.com/MGajendra22/GoFr/model/workspace"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
 	"testing"
+	"time"
 )
 
 type badResult struct{}
@@ -29,33 +35,43 @@ func Test_CreateUser(t *testing.T) {
 
 	str := NewUserStore()
 
-	u1 := user.User{Name: "John", Email: "john@nidevrrtvwn.com"}
+	u1 := user.User{Name: "John", Email: "john@nidevrrtvwn.com", Role: user.RoleMember}
 	u2 := user.User{Name: "Johrvrn", Email: "john@nvrrvn.com"}
 	u3 := user.User{Name: "Jvrohn", Email: "john@rvrvrvnidebiwn.com"}
 
-	mock.SQL.ExpectExec("INSERT INTO users (name, email) VALUES (?, ?)").WithArgs(u2.Name, u2.Email).WillReturnError(errors.New("error"))
+	insertQuery := "INSERT INTO users (workspace_id, name, email, role, password_hash) SELECT id, ?, ?, ?, ? FROM workspaces WHERE id = ?"
+
+	mock.SQL.ExpectExec(insertQuery).WithArgs(u2.Name, u2.Email, u2.Role, nil, 1).WillReturnError(errors.New("error"))
 
 	_, err1 := str.CreateUser(ctx, u2)
 	if err1 == nil {
 		t.Error("expected an error, got nil")
 	}
 
-	mock.SQL.ExpectExec("INSERT INTO users (name, email) VALUES (?, ?)").WithArgs(u3.Name, u3.Email).WillReturnResult(badResult{})
+	mock.SQL.ExpectExec(insertQuery).WithArgs(u3.Name, u3.Email, u3.Role, nil, 1).WillReturnResult(badResult{})
 
 	_, err3 := str.CreateUser(ctx, u3)
 	if err3 == nil || err3.Error() != "LastInsertId failed" {
 		t.Errorf("Expected LastInsertId error, got: %v", err3)
 	}
 
-	mock.SQL.ExpectExec("INSERT INTO users (name, email) VALUES (?, ?)").WithArgs(u1.Name, u1.Email).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.SQL.ExpectExec(insertQuery).WithArgs(u1.Name, u1.Email, u1.Role, nil, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	getUser, err := str.CreateUser(ctx, u1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	if getUser.ID != 1 {
-		t.Error("Expected 1, got ", getUser.ID)
+	if getUser.ID != 1 || getUser.Version != 1 {
+		t.Error("Expected user 1 at version 1, got ", getUser)
+	}
+
+	// the insert selects nothing when the workspace of the request does not exist
+	mock.SQL.ExpectExec(insertQuery).WithArgs(u1.Name, u1.Email, u1.Role, nil, 9).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err = str.CreateUser(workspace.In(ctx, 9), u1)
+	if err != (errs.DependencyMissing{Entity: "workspace", ID: 9}) {
+		t.Errorf("expected errs.DependencyMissing for a missing workspace, got %v", err)
 	}
 
 	if err := mock.SQL.ExpectationsWereMet(); err != nil {
@@ -71,10 +87,12 @@ func Test_GetByIDUser(t *testing.T) {
 	}
 	str := NewUserStore()
 
-	rows := mock.SQL.NewRows([]string{"id", "name", "email"}).AddRow(1, "John Doe", "john@example.com")
-	mock.SQL.ExpectQuery("SELECT id, name, email FROM users WHERE id = ?").WithArgs(2).WillReturnError(errors.New("Id not found"))
+	rows := mock.SQL.NewRows([]string{"id", "name", "email", "role", "version"}).AddRow(1, "John Doe", "john@example.com", "member", 1)
+	query := "SELECT id, name, email, role, version FROM users WHERE id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(2, 1).WillReturnError(errors.New("Id not found"))
 
-	mock.SQL.ExpectQuery("SELECT id, name, email FROM users WHERE id = ?").WithArgs(1).WillReturnRows(rows)
+	mock.SQL.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(rows)
 
 	_, err1 := str.GetByIDUser(ctx, 2)
 	if err1 == nil {
@@ -89,6 +107,103 @@ func Test_GetByIDUser(t *testing.T) {
 	if u.ID != 1 {
 		t.Error("Expected 1, got ", u.ID)
 	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(3, 1).WillReturnError(sql.ErrNoRows)
+
+	_, err = str.GetByIDUser(ctx, 3)
+	if err != (errs.NotFound{Entity: "user", ID: 3}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	// a user of another workspace is not found
+	mock.SQL.ExpectQuery(query).WithArgs(1, 2).WillReturnError(sql.ErrNoRows)
+
+	_, err = str.GetByIDUser(workspace.In(ctx, 2), 1)
+	if err != (errs.NotFound{Entity: "user", ID: 1}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+}
+
+func Test_GetByEmailUser(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+
+	query := "SELECT id, name, email, role, version, COALESCE(password_hash, '') FROM users WHERE email = ? AND workspace_id = ?"
+
+	rows := mock.SQL.NewRows([]string{"id", "name", "email", "role", "version", "password_hash"}).
+		AddRow(1, "John", "john@example.com", "member", 2, "$2a$10$hash")
+	mock.SQL.ExpectQuery(query).WithArgs("john@example.com", 1).WillReturnRows(rows)
+
+	u, err := str.GetByEmailUser(ctx, "john@example.com")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if u != (user.User{ID: 1, Name: "John", Email: "john@example.com", Role: user.RoleMember, Version: 2, PasswordHash: "$2a$10$hash"}) {
+		t.Error("Expected user 1 with its password hash, got ", u)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs("nobody@example.com", 1).WillReturnError(sql.ErrNoRows)
+
+	_, err = str.GetByEmailUser(ctx, "nobody@example.com")
+	if err != (errs.NotFound{Entity: "user"}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
+
+func Test_UpdateUser(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+
+	u1 := user.User{ID: 1, Name: "John", Email: "john@example.com", Role: user.RoleManager, Version: 2}
+
+	query := "UPDATE users SET name = ?, email = ?, role = ?, password_hash = COALESCE(?, password_hash), " +
+		"version = version + 1 WHERE id = ? AND version = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs(u1.Name, u1.Email, u1.Role, nil, u1.ID, u1.Version, 1).WillReturnError(errors.New("Duplicate email"))
+
+	if err := str.UpdateUser(ctx, u1); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(u1.Name, u1.Email, u1.Role, nil, u1.ID, u1.Version, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.UpdateUser(ctx, u1); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch for a stale version, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs(u1.Name, u1.Email, u1.Role, nil, u1.ID, u1.Version, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.UpdateUser(ctx, u1); err != nil {
+		t.Error(err)
+	}
+
+	u1.PasswordHash = "$2a$10$hash"
+
+	mock.SQL.ExpectExec(query).WithArgs(u1.Name, u1.Email, u1.Role, u1.PasswordHash, u1.ID, u1.Version, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.UpdateUser(ctx, u1); err != nil {
+		t.Error(err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
 }
 
 func Test_DeleteUser(t *testing.T) {
@@ -99,23 +214,196 @@ func Test_DeleteUser(t *testing.T) {
 	}
 
 	str := NewUserStore()
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
 
-	u1 := user.User{ID: 1}
-	u2 := user.User{ID: 1}
-	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ?").WithArgs(u2.ID).WillReturnError(errors.New("User with id not found"))
+	reject := user.DeletePolicy{Tasks: user.OnDeleteReject}
+	countQuery := "SELECT COUNT(*) FROM tasks WHERE userid = ? AND workspace_id = ?"
+	recurrenceCountQuery := "SELECT COUNT(*) FROM task_recurrences WHERE userid = ? AND workspace_id = ?"
 
-	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ?").WithArgs(u1.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectBegin().WillReturnError(errors.New("no connection"))
 
-	err := str.DeleteUser(ctx, u2.ID)
-	if err == nil {
+	if err := str.DeleteUser(ctx, 1, version.Any, reject, now); err == nil {
 		t.Error("expected error, got nil")
 	}
 
-	err = str.DeleteUser(ctx, u1.ID)
-	if err != nil {
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery(recurrenceCountQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnError(errors.New("User with id not found"))
+	mock.SQL.ExpectRollback()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, reject, now); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery(recurrenceCountQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, reject, now); err != nil {
 		t.Error(err)
 	}
 
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery(recurrenceCountQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectRollback()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, reject, now); err != (errs.NotFound{Entity: "user", ID: 1}) {
+		t.Errorf("expected errs.NotFound for a missing user, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery(recurrenceCountQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(1, 3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectRollback()
+
+	if err := str.DeleteUser(ctx, 1, 3, reject, now); !errors.Is(err, version.ErrMismatch) {
+		t.Errorf("expected version.ErrMismatch for a stale version, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(recurrenceCountQuery).WithArgs(1, 1).WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectRollback()
+
+	err := str.DeleteUser(ctx, 1, 3, reject, now)
+
+	var conflict errs.Conflict
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected errs.Conflict for a user with tasks, got %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
+
+func Test_DeleteUserWithTasks(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+	// the tasks go to the trash without their user rather than away, so their subtasks keep their parent and the purge
+	// job removes their content
+	trashQuery := "UPDATE tasks SET deleted_at = COALESCE(deleted_at, ?), userid = NULL, version = version + 1 " +
+		"WHERE userid = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec("DELETE FROM task_recurrences WHERE userid = ? AND workspace_id = ?").WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectExec(trashQuery).WithArgs(now, 1, 1).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND version = ? AND workspace_id = ?").WithArgs(1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.DeleteUser(ctx, 1, 2, user.DeletePolicy{Tasks: user.OnDeleteCascade}, now); err != nil {
+		t.Errorf("cascade delete fail: %v", err)
+	}
+
+	reassign := user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 5}
+	memberQuery := "SELECT MIN(project_id) FROM (SELECT project_id FROM tasks WHERE userid = ? AND workspace_id = ? " +
+		"AND deleted_at IS NULL UNION SELECT project_id FROM task_recurrences WHERE userid = ? AND workspace_id = ?) owned " +
+		"WHERE project_id IS NOT NULL AND project_id NOT IN (SELECT project_id FROM project_members WHERE user_id = ?)"
+	reassignQuery := "UPDATE tasks SET userid = ?, updated_at = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?"
+	reassignRecurrencesQuery := "UPDATE task_recurrences SET userid = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(memberQuery).WithArgs(1, 1, 1, 1, 5).WillReturnRows(mock.SQL.NewRows([]string{"project_id"}).AddRow(nil))
+	mock.SQL.ExpectExec(reassignRecurrencesQuery).WithArgs(5, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectExec(reassignQuery).WithArgs(5, now, 1, 1).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, reassign, now); err != nil {
+		t.Errorf("reassign delete fail: %v", err)
+	}
+
+	// user 5 is not a member of project 3 of one of the tasks
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(memberQuery).WithArgs(1, 1, 1, 1, 5).WillReturnRows(mock.SQL.NewRows([]string{"project_id"}).AddRow(3))
+	mock.SQL.ExpectRollback()
+
+	err := str.DeleteUser(ctx, 1, version.Any, reassign, now)
+	if err != (errs.Validation{Field: "reassign_to", Reason: "must be a member of project 3"}) {
+		t.Errorf("expected errs.Validation for a user outside the project of a task, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery(memberQuery).WithArgs(1, 1, 1, 1, 5).WillReturnRows(mock.SQL.NewRows([]string{"project_id"}).AddRow(nil))
+	mock.SQL.ExpectExec(reassignRecurrencesQuery).WithArgs(5, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectExec(reassignQuery).WithArgs(5, now, 1, 1).WillReturnError(errors.New("foreign key violation"))
+	mock.SQL.ExpectRollback()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, reassign, now); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
+
+func Test_DeleteUserWithRecurrences(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+	// the user has no tasks, only a recurrence whose foreign key restricts the delete
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM tasks WHERE userid = ? AND workspace_id = ?").WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(0))
+	mock.SQL.ExpectQuery("SELECT COUNT(*) FROM task_recurrences WHERE userid = ? AND workspace_id = ?").WithArgs(1, 1).
+		WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(1))
+	mock.SQL.ExpectRollback()
+
+	err := str.DeleteUser(ctx, 1, version.Any, user.DeletePolicy{Tasks: user.OnDeleteReject}, now)
+	if err != (errs.Conflict{Entity: "user", ID: 1, Reason: "still has 0 tasks and 1 recurrences, " +
+		"delete them with tasks=cascade or move them with tasks=reassign"}) {
+		t.Errorf("expected errs.Conflict for a user with recurrences, got %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectExec("DELETE FROM task_recurrences WHERE userid = ? AND workspace_id = ?").WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("UPDATE tasks SET deleted_at = COALESCE(deleted_at, ?), userid = NULL, version = version + 1 "+
+		"WHERE userid = ? AND workspace_id = ?").WithArgs(now, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, user.DeletePolicy{Tasks: user.OnDeleteCascade}, now); err != nil {
+		t.Errorf("cascade delete fail: %v", err)
+	}
+
+	mock.SQL.ExpectBegin()
+	mock.SQL.ExpectQuery("SELECT MIN(project_id) FROM (SELECT project_id FROM tasks WHERE userid = ? AND workspace_id = ? "+
+		"AND deleted_at IS NULL UNION SELECT project_id FROM task_recurrences WHERE userid = ? AND workspace_id = ?) owned "+
+		"WHERE project_id IS NOT NULL AND project_id NOT IN (SELECT project_id FROM project_members WHERE user_id = ?)").
+		WithArgs(1, 1, 1, 1, 5).WillReturnRows(mock.SQL.NewRows([]string{"project_id"}).AddRow(nil))
+	mock.SQL.ExpectExec("UPDATE task_recurrences SET userid = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?").
+		WithArgs(5, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectExec("UPDATE tasks SET userid = ?, updated_at = ?, version = version + 1 WHERE userid = ? AND workspace_id = ?").
+		WithArgs(5, now, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.SQL.ExpectExec("DELETE FROM users WHERE id = ? AND workspace_id = ?").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.SQL.ExpectCommit()
+
+	if err := str.DeleteUser(ctx, 1, version.Any, user.DeletePolicy{Tasks: user.OnDeleteReassign, ReassignTo: 5}, now); err != nil {
+		t.Errorf("reassign delete fail: %v", err)
+	}
+
 	if err := mock.SQL.ExpectationsWereMet(); err != nil {
 		t.Errorf("unmet expectations: %s", err)
 	}
@@ -130,33 +418,58 @@ func Test_GetAllUsers(t *testing.T) {
 
 	str := NewUserStore()
 
-	rows := mock.SQL.NewRows([]string{"id", "name", "email"}).
-		AddRow(1, "John Doe", "john@example.com").
-		AddRow(2, "John Doe", "john@example.com")
-	mock.SQL.ExpectQuery("SELECT id, name, email FROM users").WillReturnError(errors.New("Unable to fetch all users"))
+	q := page.Query{Limit: 1, Sort: "name", Order: page.OrderAsc}
 
-	_, err := str.GetAllUser(ctx)
+	countQuery := "SELECT COUNT(*) FROM users WHERE workspace_id = ? AND (name LIKE ? OR email LIKE ?)"
+	listQuery := "SELECT id, name, email, role, version FROM users WHERE workspace_id = ? AND (name LIKE ? OR email LIKE ?) " +
+		"ORDER BY name ASC, id ASC LIMIT ? OFFSET ?"
+
+	rows := mock.SQL.NewRows([]string{"id", "name", "email", "role", "version"}).
+		AddRow(1, "John Doe", "john@example.com", "member", 1).
+		AddRow(2, "John Doe", "john@example.com", "member", 1)
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, "%John%", "%John%").WillReturnError(errors.New("Unable to count users"))
+
+	_, err := str.GetAllUser(ctx, user.Filter{Text: "John"}, q)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
 
-	rowsWithScanErr := mock.SQL.NewRows([]string{"id", "name", "email"}).AddRow("invalid-id", "Jane", "jane@example.com")
-	mock.SQL.ExpectQuery("SELECT id, name, email FROM users").WillReturnRows(rowsWithScanErr)
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, "%John%", "%John%").WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, "%John%", "%John%", 2, 0).WillReturnError(errors.New("Unable to fetch all users"))
 
-	_, err = str.GetAllUser(ctx)
+	_, err = str.GetAllUser(ctx, user.Filter{Text: "John"}, q)
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	rowsWithScanErr := mock.SQL.NewRows([]string{"id", "name", "email", "role", "version"}).AddRow("invalid-id", "Jane", "jane@example.com", "member", 1)
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, "%John%", "%John%").WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, "%John%", "%John%", 2, 0).WillReturnRows(rowsWithScanErr)
+
+	_, err = str.GetAllUser(ctx, user.Filter{Text: "John"}, q)
 	if err == nil || !errors.Is(err, ErrScanUser) {
 		t.Error("expected error, got nil")
 
 	}
 
-	mock.SQL.ExpectQuery("SELECT id, name, email FROM users").WillReturnRows(rows)
+	mock.SQL.ExpectQuery(countQuery).WithArgs(1, "%John%", "%John%").WillReturnRows(mock.SQL.NewRows([]string{"count"}).AddRow(2))
+	mock.SQL.ExpectQuery(listQuery).WithArgs(1, "%John%", "%John%", 2, 0).WillReturnRows(rows)
 
-	users, err := str.GetAllUser(ctx)
+	users, err := str.GetAllUser(ctx, user.Filter{Text: "John"}, q)
 	if err != nil {
 		t.Error(err)
 	}
 
-	if len(users) != 2 {
-		t.Error("Expected 2 users, got ", len(users))
+	if len(users.Items) != 1 || users.Total != 2 || users.NextCursor == "" {
+		t.Error("Expected 1 user and a next cursor, got ", users)
+	}
+
+	next, err := page.NewQuery("1", "", users.NextCursor, "name", "asc", user.SortKeys...)
+	if err != nil {
+		t.Fatalf("next cursor rejected: %v", err)
+	}
+
+	if next.Cursor.Value != "John Doe" || next.Cursor.ID != 1 {
+		t.Errorf("unexpected cursor %+v", next.Cursor)
 	}
 }
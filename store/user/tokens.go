@@ -0,0 +1,58 @@
+package user
+
+import (
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+// CreateRefreshTokenUser keeps a refresh token issued to a user of the workspace of the request, and forgets the
+// tokens of the user that expired meanwhile
+func (*UserStore) CreateRefreshTokenUser(c *gofr.Context, t auth.RefreshToken) error {
+	DB := sqlutil.DB(c)
+	ws := workspace.ID(c)
+
+	if _, err := DB.Exec("DELETE FROM refresh_tokens WHERE user_id = ? AND workspace_id = ? AND expires_at < ?", t.UserID, ws,
+		t.CreatedAt); err != nil {
+		return err
+	}
+
+	_, err := DB.Exec("INSERT INTO refresh_tokens (id, workspace_id, user_id, expires_at, created_at) VALUES (?, ?, ?, ?, ?)",
+		t.ID, ws, t.UserID, t.ExpiresAt, t.CreatedAt)
+
+	return err
+}
+
+// DeleteRefreshTokenUser forgets a refresh token of a user, so that it is accepted once at most. It returns
+// errs.NotFound if the token is not kept, having been used or revoked already
+func (*UserStore) DeleteRefreshTokenUser(c *gofr.Context, userID int, id string) error {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("DELETE FROM refresh_tokens WHERE id = ? AND user_id = ? AND workspace_id = ?", id, userID,
+		workspace.ID(c))
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return errs.NotFound{Entity: "refresh token"}
+	}
+
+	return nil
+}
+
+// DeleteRefreshTokensUser forgets every refresh token of a user
+func (*UserStore) DeleteRefreshTokensUser(c *gofr.Context, userID int) error {
+	DB := sqlutil.DB(c)
+
+	_, err := DB.Exec("DELETE FROM refresh_tokens WHERE user_id = ? AND workspace_id = ?", userID, workspace.ID(c))
+
+	return err
+}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/auth"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+func Test_CreateRefreshTokenUser(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	rt := auth.RefreshToken{ID: "0123456789abcdef0123456789abcdef", UserID: 3, ExpiresAt: now.Add(time.Hour), CreatedAt: now}
+
+	prune := "DELETE FROM refresh_tokens WHERE user_id = ? AND workspace_id = ? AND expires_at < ?"
+	insert := "INSERT INTO refresh_tokens (id, workspace_id, user_id, expires_at, created_at) VALUES (?, ?, ?, ?, ?)"
+
+	mock.SQL.ExpectExec(prune).WithArgs(3, 1, now).WillReturnError(errors.New("db down"))
+
+	if err := str.CreateRefreshTokenUser(ctx, rt); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(prune).WithArgs(3, 2, now).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.SQL.ExpectExec(insert).WithArgs(rt.ID, 2, 3, rt.ExpiresAt, now).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.CreateRefreshTokenUser(workspace.In(ctx, 2), rt); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
+
+func Test_DeleteRefreshTokenUser(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+
+	query := "DELETE FROM refresh_tokens WHERE id = ? AND user_id = ? AND workspace_id = ?"
+
+	mock.SQL.ExpectExec(query).WithArgs("used", 3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := str.DeleteRefreshTokenUser(ctx, 3, "used"); !errors.As(err, &errs.NotFound{}) {
+		t.Errorf("expected errs.NotFound for a token used already, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs("kept", 3, 1).WillReturnError(errors.New("db down"))
+
+	if err := str.DeleteRefreshTokenUser(ctx, 3, "kept"); err == nil || err.Error() != "db down" {
+		t.Errorf("expected db down, got %v", err)
+	}
+
+	mock.SQL.ExpectExec(query).WithArgs("kept", 3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := str.DeleteRefreshTokenUser(ctx, 3, "kept"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
+
+func Test_DeleteRefreshTokensUser(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewUserStore()
+
+	mock.SQL.ExpectExec("DELETE FROM refresh_tokens WHERE user_id = ? AND workspace_id = ?").WithArgs(3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	if err := str.DeleteRefreshTokensUser(ctx, 3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
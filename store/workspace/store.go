@@ -0,0 +1,79 @@
+package workspace
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/MGajendra22/GoFr/internal/sqlutil"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+)
+
+type Store struct {
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+var ErrScanWorkspace = errors.New("scan workspace failed")
+
+// CreateWorkspace inserts a new workspace into the database
+func (*Store) CreateWorkspace(c *gofr.Context, w workspace.Workspace) (workspace.Workspace, error) {
+	DB := sqlutil.DB(c)
+
+	res, err := DB.Exec("INSERT INTO workspaces (name, created_at) VALUES (?, ?)", w.Name, w.CreatedAt)
+	if err != nil {
+		return w, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return w, err
+	}
+
+	w.ID = int(id)
+
+	return w, nil
+}
+
+// GetByIDWorkspace fetches a workspace by its ID
+func (*Store) GetByIDWorkspace(c *gofr.Context, id int) (workspace.Workspace, error) {
+	DB := sqlutil.DB(c)
+
+	var w workspace.Workspace
+
+	err := DB.QueryRow("SELECT id, name, created_at FROM workspaces WHERE id = ?", id).Scan(&w.ID, &w.Name, &w.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return w, errs.NotFound{Entity: "workspace", ID: id}
+	}
+
+	return w, err
+}
+
+// GetAllWorkspace returns every workspace, in id order
+func (*Store) GetAllWorkspace(c *gofr.Context) ([]workspace.Workspace, error) {
+	DB := sqlutil.DB(c)
+
+	rows, err := DB.Query("SELECT id, name, created_at FROM workspaces ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	workspaces := []workspace.Workspace{}
+
+	for rows.Next() {
+		var w workspace.Workspace
+
+		if err := rows.Scan(&w.ID, &w.Name, &w.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrScanWorkspace, err)
+		}
+
+		workspaces = append(workspaces, w)
+	}
+
+	return workspaces, rows.Err()
+}
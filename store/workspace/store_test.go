@@ -0,0 +1,128 @@
+package workspace
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MGajendra22/GoFr/model/errs"
+	"github.com/MGajendra22/GoFr/model/workspace"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"testing"
+	"time"
+)
+
+var stamp = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+type badResult struct{}
+
+func (badResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId failed")
+}
+
+func (badResult) RowsAffected() (int64, error) {
+	return 1, nil
+}
+
+func Test_CreateWorkspace(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	w := workspace.Workspace{Name: "Acme", CreatedAt: stamp}
+	insert := "INSERT INTO workspaces (name, created_at) VALUES (?, ?)"
+
+	mock.SQL.ExpectExec(insert).WithArgs("Acme", stamp).WillReturnError(errors.New("Insert failed"))
+
+	if _, err := str.CreateWorkspace(ctx, w); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs("Acme", stamp).WillReturnResult(badResult{})
+
+	if _, err := str.CreateWorkspace(ctx, w); err == nil || err.Error() != "LastInsertId failed" {
+		t.Errorf("expected LastInsertId error, got: %v", err)
+	}
+
+	mock.SQL.ExpectExec(insert).WithArgs("Acme", stamp).WillReturnResult(sqlmock.NewResult(2, 1))
+
+	res, err := str.CreateWorkspace(ctx, w)
+	if err != nil || res.ID != 2 || res.Name != "Acme" {
+		t.Errorf("expected workspace 2, got %+v, %v", res, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetByIDWorkspace(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, created_at FROM workspaces WHERE id = ?"
+
+	mock.SQL.ExpectQuery(query).WithArgs(9).WillReturnError(sql.ErrNoRows)
+
+	if _, err := str.GetByIDWorkspace(ctx, 9); err != (errs.NotFound{Entity: "workspace", ID: 9}) {
+		t.Errorf("expected errs.NotFound, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WithArgs(2).
+		WillReturnRows(mock.SQL.NewRows([]string{"id", "name", "created_at"}).AddRow(2, "Acme", stamp))
+
+	w, err := str.GetByIDWorkspace(ctx, 2)
+	if err != nil || w.ID != 2 || w.Name != "Acme" || !w.CreatedAt.Equal(stamp) {
+		t.Errorf("unexpected workspace: %+v, %v", w, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}
+
+func Test_GetAllWorkspace(t *testing.T) {
+	mockContainer, mock := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{
+		Container: mockContainer,
+	}
+
+	str := NewStore()
+
+	query := "SELECT id, name, created_at FROM workspaces ORDER BY id"
+	cols := []string{"id", "name", "created_at"}
+
+	mock.SQL.ExpectQuery(query).WillReturnError(errors.New("db down"))
+
+	if _, err := str.GetAllWorkspace(ctx); err == nil {
+		t.Error("expected an error, got nil")
+	}
+
+	mock.SQL.ExpectQuery(query).WillReturnRows(mock.SQL.NewRows(cols).AddRow("x", "Default", stamp))
+
+	if _, err := str.GetAllWorkspace(ctx); !errors.Is(err, ErrScanWorkspace) {
+		t.Errorf("expected ErrScanWorkspace, got %v", err)
+	}
+
+	mock.SQL.ExpectQuery(query).WillReturnRows(mock.SQL.NewRows(cols).AddRow(1, "Default", stamp).AddRow(2, "Acme", stamp))
+
+	all, err := str.GetAllWorkspace(ctx)
+	if err != nil || len(all) != 2 || all[1].Name != "Acme" {
+		t.Errorf("unexpected workspaces: %+v, %v", all, err)
+	}
+
+	if err := mock.SQL.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet SQL expectations: %v", err)
+	}
+}